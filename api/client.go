@@ -1,7 +1,10 @@
 package api
 
+//go:generate go run ../cmd/gen-enums --schema schema/enums.graphql --output enums.go
+
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -98,6 +101,19 @@ func ReplaceTripper(tr http.RoundTripper) ClientOption {
 	}
 }
 
+// InheritContext attaches ctx to outgoing requests that do not carry their own context, so that
+// canceling ctx aborts any in-flight request made through this client.
+func InheritContext(ctx context.Context) ClientOption {
+	return func(tr http.RoundTripper) http.RoundTripper {
+		return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+			if req.Context() == context.Background() {
+				req = req.Clone(ctx)
+			}
+			return tr.RoundTrip(req)
+		}}
+	}
+}
+
 // ExtractHeader extracts a named header from any response received by this client and, if non-blank, saves
 // it to dest.
 func ExtractHeader(name string, dest *string) ClientOption {
@@ -189,10 +205,26 @@ func matchPath(p, expect string) bool {
 	return p == expect
 }
 
+// PartialDataError reports whether every error in the response is scoped to a specific field path,
+// which means that the rest of the response data is intact and usable. This happens, for example,
+// when a SAML-protected organization blocks access to a single field of an otherwise successful query.
+func (gr GraphQLErrorResponse) PartialDataError() bool {
+	if len(gr.Errors) == 0 {
+		return false
+	}
+	for _, e := range gr.Errors {
+		if len(e.Path) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // HTTPError is an error returned by a failed API call
 type HTTPError struct {
 	StatusCode int
 	RequestURL *url.URL
+	RequestID  string
 	Message    string
 	Errors     []HTTPErrorItem
 
@@ -270,15 +302,22 @@ func EndpointNeedsScopes(resp *http.Response, s string) *http.Response {
 	return resp
 }
 
-// GraphQL performs a GraphQL request and parses the response. If there are errors in the response,
-// *GraphQLErrorResponse will be returned, but the data will also be parsed into the receiver.
+// GraphQL performs a GraphQL request and parses the response the same way that
+// GraphQLWithContext does, but is not interruptible.
 func (c Client) GraphQL(hostname string, query string, variables map[string]interface{}, data interface{}) error {
+	return c.GraphQLWithContext(context.Background(), hostname, query, variables, data)
+}
+
+// GraphQLWithContext performs a GraphQL request bound to ctx and parses the response. If there are
+// errors in the response, *GraphQLErrorResponse will be returned, but the data will also be parsed
+// into the receiver. Canceling ctx aborts an in-flight request.
+func (c Client) GraphQLWithContext(ctx context.Context, hostname string, query string, variables map[string]interface{}, data interface{}) error {
 	reqBody, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest("POST", ghinstance.GraphQLEndpoint(hostname), bytes.NewBuffer(reqBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", ghinstance.GraphQLEndpoint(hostname), bytes.NewBuffer(reqBody))
 	if err != nil {
 		return err
 	}
@@ -298,9 +337,16 @@ func graphQLClient(h *http.Client, hostname string) *graphql.Client {
 	return graphql.NewClient(ghinstance.GraphQLEndpoint(hostname), h)
 }
 
-// REST performs a REST request and parses the response.
+// REST performs a REST request and parses the response the same way that RESTWithContext does,
+// but is not interruptible.
 func (c Client) REST(hostname string, method string, p string, body io.Reader, data interface{}) error {
-	req, err := http.NewRequest(method, restURL(hostname, p), body)
+	return c.RESTWithContext(context.Background(), hostname, method, p, body, data)
+}
+
+// RESTWithContext performs a REST request bound to ctx and parses the response. Canceling ctx
+// aborts an in-flight request.
+func (c Client) RESTWithContext(ctx context.Context, hostname string, method string, p string, body io.Reader, data interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, restURL(hostname, p), body)
 	if err != nil {
 		return err
 	}
@@ -370,6 +416,7 @@ func HandleHTTPError(resp *http.Response) error {
 	httpError := HTTPError{
 		StatusCode:       resp.StatusCode,
 		RequestURL:       resp.Request.URL,
+		RequestID:        resp.Header.Get("X-Github-Request-Id"),
 		scopesSuggestion: ScopesSuggestion(resp),
 	}
 
@@ -2,9 +2,11 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/cli/cli/v2/pkg/httpmock"
@@ -40,6 +42,45 @@ func TestGraphQL(t *testing.T) {
 	assert.Equal(t, "token OTOKEN", req.Header.Get("Authorization"))
 }
 
+func TestRESTWithContext_canceled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	client := NewClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := client.RESTWithContext(ctx, "github.com", "GET", ts.URL+"/repos/OWNER/REPO", nil, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestInheritContext(t *testing.T) {
+	type key string
+
+	var gotCtx context.Context
+	tr := InheritContext(context.WithValue(context.Background(), key("inherited"), "yes"))(
+		funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+			gotCtx = req.Context()
+			return &http.Response{StatusCode: 204, Request: req, Body: ioutil.NopCloser(&bytes.Buffer{})}, nil
+		}})
+
+	req, err := http.NewRequest("GET", "https://api.github.com/user", nil)
+	assert.NoError(t, err)
+	res, err := tr.RoundTrip(req)
+	assert.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, "yes", gotCtx.Value(key("inherited")))
+
+	ownCtx := context.WithValue(context.Background(), key("own"), "yes")
+	req, err = http.NewRequestWithContext(ownCtx, "GET", "https://api.github.com/user", nil)
+	assert.NoError(t, err)
+	res, err = tr.RoundTrip(req)
+	assert.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, "yes", gotCtx.Value(key("own")))
+	assert.Nil(t, gotCtx.Value(key("inherited")))
+}
+
 func TestGraphQLError(t *testing.T) {
 	http := &httpmock.Registry{}
 	client := NewClient(ReplaceTripper(http))
@@ -71,6 +112,51 @@ func TestGraphQLError(t *testing.T) {
 	}
 }
 
+func TestGraphQLErrorResponse_PartialDataError(t *testing.T) {
+	tests := []struct {
+		name   string
+		errors []GraphQLError
+		want   bool
+	}{
+		{
+			name:   "no errors",
+			errors: nil,
+			want:   false,
+		},
+		{
+			name: "all errors scoped to fields",
+			errors: []GraphQLError{
+				{Type: "FORBIDDEN", Message: "forbidden", Path: []interface{}{"repository", "issue"}},
+				{Type: "NOT_FOUND", Message: "not found", Path: []interface{}{"repository", "issues", 0, "comments"}},
+			},
+			want: true,
+		},
+		{
+			name: "query-level error",
+			errors: []GraphQLError{
+				{Message: "Something went wrong"},
+			},
+			want: false,
+		},
+		{
+			name: "mixed errors",
+			errors: []GraphQLError{
+				{Type: "FORBIDDEN", Message: "forbidden", Path: []interface{}{"repository", "issue"}},
+				{Message: "Something went wrong"},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := GraphQLErrorResponse{Errors: tt.errors}
+			if got := gr.PartialDataError(); got != tt.want {
+				t.Errorf("PartialDataError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestRESTGetDelete(t *testing.T) {
 	http := &httpmock.Registry{}
 
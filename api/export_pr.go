@@ -13,6 +13,8 @@ func (issue *Issue) ExportData(fields []string) map[string]interface{} {
 		switch f {
 		case "comments":
 			data[f] = issue.Comments.Nodes
+		case "closedByPullRequestsReferences":
+			data[f] = issue.ClosedByPullRequestsReferences.Nodes
 		case "assignees":
 			data[f] = issue.Assignees.Nodes
 		case "labels":
@@ -67,6 +69,8 @@ func (pr *PullRequest) ExportData(fields []string) map[string]interface{} {
 			data[f] = commits
 		case "comments":
 			data[f] = pr.Comments.Nodes
+		case "closingIssuesReferences":
+			data[f] = pr.ClosingIssuesReferences.Nodes
 		case "assignees":
 			data[f] = pr.Assignees.Nodes
 		case "labels":
@@ -85,15 +89,17 @@ func (pr *PullRequest) ExportData(fields []string) map[string]interface{} {
 				r := req.RequestedReviewer
 				switch r.TypeName {
 				case "User":
-					requests = append(requests, map[string]string{
-						"__typename": r.TypeName,
-						"login":      r.Login,
+					requests = append(requests, map[string]interface{}{
+						"__typename":  r.TypeName,
+						"login":       r.Login,
+						"asCodeOwner": req.AsCodeOwner,
 					})
 				case "Team":
-					requests = append(requests, map[string]string{
-						"__typename": r.TypeName,
-						"name":       r.Name,
-						"slug":       r.LoginOrSlug(),
+					requests = append(requests, map[string]interface{}{
+						"__typename":  r.TypeName,
+						"name":        r.Name,
+						"slug":        r.LoginOrSlug(),
+						"asCodeOwner": req.AsCodeOwner,
 					})
 				}
 			}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sync"
+)
+
+// concurrentPageFetchers caps how many pages of a list result are requested
+// in parallel.
+const concurrentPageFetchers = 4
+
+// OffsetCursor fabricates the opaque cursor with which the GitHub GraphQL API
+// addresses a connection offset: the position of the last item on the
+// preceding page. It lets pages after the first be requested directly instead
+// of chaining end cursors.
+func OffsetCursor(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("cursor:%d", offset)))
+}
+
+// PaginateConcurrently fetches the pages of a list result. The first page is
+// requested alone to learn the total count of the connection; the remaining
+// pages up to limit are then requested concurrently, bounded by
+// concurrentPageFetchers, and reassembled in order. fetch receives the offset
+// of the first item of its page along with the page size, and returns the
+// nodes of that page and the connection's total count. The returned slice
+// contains one result per page, in page order, followed by the total count
+// reported by the first page.
+func PaginateConcurrently(pageSize, limit int, fetch func(offset, pageSize int) (interface{}, int, error)) ([]interface{}, int, error) {
+	if pageSize > limit {
+		pageSize = limit
+	}
+
+	firstPage, totalCount, err := fetch(0, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	want := limit
+	if totalCount < want {
+		want = totalCount
+	}
+	numPages := 1
+	if want > pageSize {
+		numPages = (want + pageSize - 1) / pageSize
+	}
+
+	pages := make([]interface{}, numPages)
+	pages[0] = firstPage
+	if numPages == 1 {
+		return pages, totalCount, nil
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var fetchErr error
+	semaphore := make(chan struct{}, concurrentPageFetchers)
+
+	for p := 1; p < numPages; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			offset := p * pageSize
+			size := pageSize
+			if remaining := want - offset; remaining < size {
+				size = remaining
+			}
+
+			page, _, err := fetch(offset, size)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if fetchErr == nil {
+					fetchErr = err
+				}
+				return
+			}
+			pages[p] = page
+		}(p)
+	}
+	wg.Wait()
+
+	if fetchErr != nil {
+		return nil, 0, fetchErr
+	}
+	return pages, totalCount, nil
+}
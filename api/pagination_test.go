@@ -0,0 +1,94 @@
+package api
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestOffsetCursor(t *testing.T) {
+	if got := OffsetCursor(100); got != "Y3Vyc29yOjEwMA==" {
+		t.Errorf("OffsetCursor(100) = %q", got)
+	}
+}
+
+func TestPaginateConcurrently(t *testing.T) {
+	var mu sync.Mutex
+	var calls [][2]int
+
+	fetch := func(offset, pageSize int) (interface{}, int, error) {
+		mu.Lock()
+		calls = append(calls, [2]int{offset, pageSize})
+		mu.Unlock()
+
+		page := make([]int, pageSize)
+		for i := range page {
+			page[i] = offset + i
+		}
+		return page, 9, nil
+	}
+
+	pages, totalCount, err := PaginateConcurrently(2, 5, fetch)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if totalCount != 9 {
+		t.Errorf("totalCount = %d, want 9", totalCount)
+	}
+	if len(pages) != 3 {
+		t.Fatalf("got %d pages, want 3", len(pages))
+	}
+
+	var results []int
+	for _, page := range pages {
+		results = append(results, page.([]int)...)
+	}
+	for i, r := range results {
+		if r != i {
+			t.Fatalf("results out of order: %v", results)
+		}
+	}
+
+	sort.Slice(calls, func(i, j int) bool { return calls[i][0] < calls[j][0] })
+	wantCalls := [][2]int{{0, 2}, {2, 2}, {4, 1}}
+	if len(calls) != len(wantCalls) {
+		t.Fatalf("got %d fetches, want %d", len(calls), len(wantCalls))
+	}
+	for i, c := range calls {
+		if c != wantCalls[i] {
+			t.Errorf("fetch %d = %v, want %v", i, c, wantCalls[i])
+		}
+	}
+}
+
+func TestPaginateConcurrently_limitBelowTotal(t *testing.T) {
+	pages, totalCount, err := PaginateConcurrently(100, 30, func(offset, pageSize int) (interface{}, int, error) {
+		if offset != 0 || pageSize != 30 {
+			t.Errorf("fetch(%d, %d), want fetch(0, 30)", offset, pageSize)
+		}
+		return nil, 1000, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if totalCount != 1000 {
+		t.Errorf("totalCount = %d, want 1000", totalCount)
+	}
+	if len(pages) != 1 {
+		t.Errorf("got %d pages, want 1", len(pages))
+	}
+}
+
+func TestPaginateConcurrently_error(t *testing.T) {
+	fetchErr := errors.New("boom")
+	_, _, err := PaginateConcurrently(1, 3, func(offset, pageSize int) (interface{}, int, error) {
+		if offset == 2 {
+			return nil, 0, fetchErr
+		}
+		return nil, 3, nil
+	})
+	if !errors.Is(err, fetchErr) {
+		t.Errorf("got error %v, want %v", err, fetchErr)
+	}
+}
@@ -41,3 +41,20 @@ func TestPullRequest_ChecksStatus(t *testing.T) {
 	assert.Equal(t, 3, checks.Failing)
 	assert.Equal(t, 2, checks.Passing)
 }
+
+func TestPullRequest_ChecksStatus_rollupStateOnly(t *testing.T) {
+	pr := PullRequest{}
+	payload := `
+	{ "statusCheckRollup": { "nodes": [{ "commit": {
+		"statusCheckRollup": { "state": "FAILURE" }
+	} }] } }
+	`
+	err := json.Unmarshal([]byte(payload), &pr)
+	assert.NoError(t, err)
+
+	checks := pr.ChecksStatus()
+	assert.Equal(t, 1, checks.Total)
+	assert.Equal(t, 0, checks.Pending)
+	assert.Equal(t, 1, checks.Failing)
+	assert.Equal(t, 0, checks.Passing)
+}
@@ -1,9 +1,13 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
+	"github.com/cli/cli/v2/internal/ghrepo"
 	graphql "github.com/cli/shurcooL-graphql"
 	"github.com/shurcooL/githubv4"
 )
@@ -60,6 +64,24 @@ func CommentCreate(client *Client, repoHost string, params CommentCreateInput) (
 	return mutation.AddComment.CommentEdge.Node.URL, nil
 }
 
+// CommentReplyCreate posts a threaded reply to a pull request review comment.
+func CommentReplyCreate(client *Client, repo ghrepo.Interface, prNumber int, commentID int, body string) (string, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/comments/%d/replies", ghrepo.FullName(repo), prNumber, commentID)
+	requestBody, err := json.Marshal(map[string]string{"body": body})
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		HTMLURL string `json:"html_url"`
+	}
+	err = client.REST(repo.RepoHost(), "POST", path, bytes.NewReader(requestBody), &result)
+	if err != nil {
+		return "", err
+	}
+	return result.HTMLURL, nil
+}
+
 func (c Comment) AuthorLogin() string {
 	return c.Author.Login
 }
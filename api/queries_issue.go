@@ -2,6 +2,7 @@ package api
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cli/cli/v2/internal/ghrepo"
@@ -26,6 +27,7 @@ type Issue struct {
 	Title          string
 	URL            string
 	State          string
+	StateReason    string
 	Closed         bool
 	Body           string
 	CreatedAt      time.Time
@@ -38,6 +40,32 @@ type Issue struct {
 	ProjectCards   ProjectCards
 	Milestone      *Milestone
 	ReactionGroups ReactionGroups
+
+	ClosedByPullRequestsReferences struct {
+		Nodes      []LinkedPullRequest
+		TotalCount int
+	}
+}
+
+// LinkedIssue is an issue that will be closed when a pull request merges
+type LinkedIssue struct {
+	Number     int
+	Title      string
+	URL        string
+	Repository struct {
+		NameWithOwner string
+	}
+}
+
+// LinkedPullRequest is a pull request that closes an issue when it merges
+type LinkedPullRequest struct {
+	Number     int
+	Title      string
+	URL        string
+	State      string
+	Repository struct {
+		NameWithOwner string
+	}
 }
 
 func (i Issue) IsPullRequest() bool {
@@ -122,6 +150,7 @@ func IssueCreate(client *Client, repo *Repository, params map[string]interface{}
 	mutation IssueCreate($input: CreateIssueInput!) {
 		createIssue(input: $input) {
 			issue {
+				id
 				url
 			}
 		}
@@ -131,6 +160,11 @@ func IssueCreate(client *Client, repo *Repository, params map[string]interface{}
 		"repositoryId": repo.ID,
 	}
 	for key, val := range params {
+		// projects created under the new Projects experience are not valid
+		// input to `createIssue` and use a separate mutation below
+		if key == "projectV2Ids" {
+			continue
+		}
 		inputParams[key] = val
 	}
 	variables := map[string]interface{}{
@@ -147,8 +181,59 @@ func IssueCreate(client *Client, repo *Repository, params map[string]interface{}
 	if err != nil {
 		return nil, err
 	}
+	issue := &result.CreateIssue.Issue
+
+	if v2IDs, ok := params["projectV2Ids"].([]string); ok {
+		for _, projectV2ID := range v2IDs {
+			if err := AddProjectV2ItemById(client, repo, projectV2ID, issue.ID); err != nil {
+				return issue, err
+			}
+		}
+	}
+
+	return issue, nil
+}
+
+type IssueType struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// RepoIssueTypes fetches the issue types configured for a repository. It
+// returns an empty list for hosts that do not support issue types, such as
+// GitHub Enterprise Server.
+func RepoIssueTypes(client *Client, repo ghrepo.Interface) ([]IssueType, error) {
+	query := `
+	query RepositoryIssueTypes($owner: String!, $name: String!) {
+		repository(owner: $owner, name: $name) {
+			issueTypes(first: 25) {
+				nodes { id, name }
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"name":  repo.RepoName(),
+	}
+
+	var result struct {
+		Repository struct {
+			IssueTypes struct {
+				Nodes []IssueType
+			}
+		}
+	}
+
+	err := client.GraphQL(repo.RepoHost(), query, variables, &result)
+	if err != nil {
+		if strings.Contains(err.Error(), "Field 'issueTypes' doesn't exist") {
+			return nil, nil
+		}
+		return nil, err
+	}
 
-	return &result.CreateIssue.Issue, nil
+	return result.Repository.IssueTypes.Nodes, nil
 }
 
 type IssueStatusOptions struct {
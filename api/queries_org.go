@@ -47,8 +47,35 @@ func OrganizationProjects(client *Client, repo ghrepo.Interface) ([]RepoProject,
 }
 
 type OrgTeam struct {
-	ID   string
-	Slug string
+	ID          string
+	Slug        string
+	Name        string
+	Description string
+	Privacy     string
+	Members     struct {
+		TotalCount int
+	}
+}
+
+func (t OrgTeam) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "id":
+			data[f] = t.ID
+		case "slug":
+			data[f] = t.Slug
+		case "name":
+			data[f] = t.Name
+		case "description":
+			data[f] = t.Description
+		case "privacy":
+			data[f] = t.Privacy
+		case "membersCount":
+			data[f] = t.Members.TotalCount
+		}
+	}
+	return data
 }
 
 // OrganizationTeams fetches all the teams in an organization
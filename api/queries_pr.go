@@ -19,6 +19,7 @@ type PullRequestsPayload struct {
 	ReviewRequested PullRequestAndTotalCount
 	CurrentPR       *PullRequest
 	DefaultBranch   string
+	Warnings        []string
 }
 
 type PullRequestAndTotalCount struct {
@@ -31,7 +32,7 @@ type PullRequest struct {
 	ID               string
 	Number           int
 	Title            string
-	State            string
+	State            PullRequestState
 	Closed           bool
 	URL              string
 	BaseRefName      string
@@ -41,7 +42,7 @@ type PullRequest struct {
 	Additions        int
 	Deletions        int
 	ChangedFiles     int
-	MergeStateStatus string
+	MergeStateStatus MergeStateStatus
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 	ClosedAt         *time.Time
@@ -62,14 +63,28 @@ type PullRequest struct {
 	IsDraft             bool
 	MaintainerCanModify bool
 
+	BaseRepository struct {
+		MergeCommitTitle         string
+		MergeCommitMessage       string
+		SquashMergeCommitTitle   string
+		SquashMergeCommitMessage string
+	}
+
+	ClosingIssuesReferences struct {
+		Nodes      []LinkedIssue
+		TotalCount int
+	}
+
 	BaseRef struct {
 		BranchProtectionRule struct {
 			RequiresStrictStatusChecks   bool
 			RequiredApprovingReviewCount int
+			RequiresCodeOwnerReviews     bool
+			RequiredStatusCheckContexts  []string
 		}
 	}
 
-	ReviewDecision string
+	ReviewDecision PullRequestReviewDecision
 
 	Commits struct {
 		TotalCount int
@@ -79,6 +94,7 @@ type PullRequest struct {
 		Nodes []struct {
 			Commit struct {
 				StatusCheckRollup struct {
+					State    string
 					Contexts struct {
 						Nodes    []CheckContext
 						PageInfo struct {
@@ -105,6 +121,8 @@ type PullRequest struct {
 type CheckContext struct {
 	TypeName    string    `json:"__typename"`
 	Name        string    `json:"name"`
+	DatabaseID  int64     `json:"databaseId,omitempty"`
+	IsRequired  bool      `json:"isRequired,omitempty"`
 	Context     string    `json:"context,omitempty"`
 	State       string    `json:"state,omitempty"`
 	Status      string    `json:"status"`
@@ -153,6 +171,7 @@ type PullRequestFile struct {
 
 type ReviewRequests struct {
 	Nodes []struct {
+		AsCodeOwner       bool `json:"asCodeOwner"`
 		RequestedReviewer RequestedReviewer
 	}
 }
@@ -234,6 +253,18 @@ func (pr *PullRequest) ChecksStatus() (summary PullRequestChecksStatus) {
 		return
 	}
 	commit := pr.StatusCheckRollup.Nodes[0].Commit
+	if len(commit.StatusCheckRollup.Contexts.Nodes) == 0 {
+		// only the combined rollup state was requested, not the individual contexts
+		switch commit.StatusCheckRollup.State {
+		case "SUCCESS":
+			summary.Passing, summary.Total = 1, 1
+		case "ERROR", "FAILURE":
+			summary.Failing, summary.Total = 1, 1
+		case "PENDING", "EXPECTED":
+			summary.Pending, summary.Total = 1, 1
+		}
+		return
+	}
 	for _, c := range commit.StatusCheckRollup.Contexts.Nodes {
 		state := c.State // StatusContext
 		if state == "" {
@@ -276,13 +307,14 @@ type pullRequestFeature struct {
 }
 
 func determinePullRequestFeatures(httpClient *http.Client, hostname string) (prFeatures pullRequestFeature, err error) {
-	if !ghinstance.IsEnterprise(hostname) {
-		prFeatures.HasReviewDecision = true
-		prFeatures.HasStatusCheckRollup = true
-		prFeatures.HasBranchProtectionRule = true
+	if caps, capsErr := ghinstance.DetectCapabilities(httpClient, hostname); capsErr == nil {
+		prFeatures.HasReviewDecision = caps.ReviewDecision
+		prFeatures.HasStatusCheckRollup = caps.CheckRollup
+		prFeatures.HasBranchProtectionRule = caps.BranchProtectionRule
 		return
 	}
 
+	// older GHES builds don't report their installed version; fall back to schema introspection
 	var featureDetection struct {
 		PullRequest struct {
 			Fields []struct {
@@ -346,6 +378,8 @@ type StatusOptions struct {
 	HeadRef   string
 	Username  string
 	Fields    []string
+	// SkipChecks leaves out CI check status to speed up the queries on busy repositories
+	SkipChecks bool
 }
 
 func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOptions) (*PullRequestsPayload, error) {
@@ -356,36 +390,24 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 		}
 	}
 
-	type response struct {
-		Repository struct {
-			DefaultBranchRef struct {
-				Name string
-			}
-			PullRequests edges
-			PullRequest  *PullRequest
-		}
-		ViewerCreated   edges
-		ReviewRequested edges
-	}
-
-	var fragments string
+	var prFragment, reviewsFragment string
 	if len(options.Fields) > 0 {
 		fields := set.NewStringSet()
 		fields.AddValues(options.Fields)
 		// these are always necessary to find the PR for the current branch
 		fields.AddValues([]string{"isCrossRepository", "headRepositoryOwner", "headRefName"})
-		gr := PullRequestGraphQL(fields.ToSlice())
-		fragments = fmt.Sprintf("fragment pr on PullRequest{%s}fragment prWithReviews on PullRequest{...pr}", gr)
+		prFragment = fmt.Sprintf("fragment pr on PullRequest{%s}", PullRequestGraphQL(fields.ToSlice()))
+		reviewsFragment = "fragment prWithReviews on PullRequest{...pr}"
 	} else {
 		var err error
-		fragments, err = pullRequestFragment(client.http, repo.RepoHost())
+		prFragment, reviewsFragment, err = pullRequestFragments(client.http, repo.RepoHost(), options.SkipChecks)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	queryPrefix := `
-	query PullRequestStatus($owner: String!, $repo: String!, $headRefName: String!, $viewerQuery: String!, $reviewerQuery: String!, $per_page: Int = 10) {
+	repoQuery := prFragment + reviewsFragment + `
+	query PullRequestStatus($owner: String!, $repo: String!, $headRefName: String!, $per_page: Int = 10) {
 		repository(owner: $owner, name: $repo) {
 			defaultBranchRef {
 				name
@@ -399,10 +421,11 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 				}
 			}
 		}
+	}
 	`
 	if options.CurrentPR > 0 {
-		queryPrefix = `
-		query PullRequestStatus($owner: String!, $repo: String!, $number: Int!, $viewerQuery: String!, $reviewerQuery: String!, $per_page: Int = 10) {
+		repoQuery = prFragment + reviewsFragment + `
+		query PullRequestStatus($owner: String!, $repo: String!, $number: Int!) {
 			repository(owner: $owner, name: $repo) {
 				defaultBranchRef {
 					name
@@ -416,27 +439,34 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 					}
 				}
 			}
+		}
 		`
 	}
 
-	query := fragments + queryPrefix + `
-      viewerCreated: search(query: $viewerQuery, type: ISSUE, first: $per_page) {
-       totalCount: issueCount
-        edges {
-          node {
-            ...prWithReviews
-          }
-        }
-      }
-      reviewRequested: search(query: $reviewerQuery, type: ISSUE, first: $per_page) {
-        totalCount: issueCount
-        edges {
-          node {
-            ...pr
-          }
-        }
-      }
-    }
+	viewerQuery := prFragment + reviewsFragment + `
+	query PullRequestStatusViewerCreated($q: String!, $per_page: Int = 10) {
+		results: search(query: $q, type: ISSUE, first: $per_page) {
+			totalCount: issueCount
+			edges {
+				node {
+					...prWithReviews
+				}
+			}
+		}
+	}
+	`
+
+	reviewerQuery := prFragment + `
+	query PullRequestStatusReviewRequested($q: String!, $per_page: Int = 10) {
+		results: search(query: $q, type: ISSUE, first: $per_page) {
+			totalCount: issueCount
+			edges {
+				node {
+					...pr
+				}
+			}
+		}
+	}
 	`
 
 	currentUsername := options.Username
@@ -448,43 +478,87 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 		}
 	}
 
-	viewerQuery := fmt.Sprintf("repo:%s state:open is:pr author:%s", ghrepo.FullName(repo), currentUsername)
-	reviewerQuery := fmt.Sprintf("repo:%s state:open review-requested:%s", ghrepo.FullName(repo), currentUsername)
-
 	currentPRHeadRef := options.HeadRef
 	branchWithoutOwner := currentPRHeadRef
 	if idx := strings.Index(currentPRHeadRef, ":"); idx >= 0 {
 		branchWithoutOwner = currentPRHeadRef[idx+1:]
 	}
 
-	variables := map[string]interface{}{
-		"viewerQuery":   viewerQuery,
-		"reviewerQuery": reviewerQuery,
-		"owner":         repo.RepoOwner(),
-		"repo":          repo.RepoName(),
-		"headRefName":   branchWithoutOwner,
-		"number":        options.CurrentPR,
+	repoVariables := map[string]interface{}{
+		"owner":       repo.RepoOwner(),
+		"repo":        repo.RepoName(),
+		"headRefName": branchWithoutOwner,
+		"number":      options.CurrentPR,
 	}
 
-	var resp response
-	err := client.GraphQL(repo.RepoHost(), query, variables, &resp)
-	if err != nil {
+	var repoResp struct {
+		Repository struct {
+			DefaultBranchRef struct {
+				Name string
+			}
+			PullRequests edges
+			PullRequest  *PullRequest
+		}
+	}
+	var viewerResp struct {
+		Results edges
+	}
+	var reviewerResp struct {
+		Results edges
+	}
+
+	// when errors are all scoped to individual fields, e.g. when a SAML-protected organization
+	// blocks part of a query, continue with the partial response and surface a warning instead
+	// of failing the whole lookup
+	var repoErrors, viewerErrors, reviewerErrors *GraphQLErrorResponse
+	tolerated := func(err error, dest **GraphQLErrorResponse) error {
+		if graphqlError, ok := err.(*GraphQLErrorResponse); ok && graphqlError.PartialDataError() {
+			*dest = graphqlError
+			return nil
+		}
+		return err
+	}
+
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		err := client.GraphQL(repo.RepoHost(), repoQuery, repoVariables, &repoResp)
+		return tolerated(err, &repoErrors)
+	})
+	g.Go(func() error {
+		q := fmt.Sprintf("repo:%s state:open is:pr author:%s", ghrepo.FullName(repo), currentUsername)
+		err := client.GraphQL(repo.RepoHost(), viewerQuery, map[string]interface{}{"q": q}, &viewerResp)
+		return tolerated(err, &viewerErrors)
+	})
+	g.Go(func() error {
+		q := fmt.Sprintf("repo:%s state:open review-requested:%s", ghrepo.FullName(repo), currentUsername)
+		err := client.GraphQL(repo.RepoHost(), reviewerQuery, map[string]interface{}{"q": q}, &reviewerResp)
+		return tolerated(err, &reviewerErrors)
+	})
+
+	if err := g.Wait(); err != nil {
 		return nil, err
 	}
 
+	var warnings []string
+	for _, graphqlError := range []*GraphQLErrorResponse{repoErrors, viewerErrors, reviewerErrors} {
+		if graphqlError != nil {
+			warnings = append(warnings, graphqlError.Error())
+		}
+	}
+
 	var viewerCreated []PullRequest
-	for _, edge := range resp.ViewerCreated.Edges {
+	for _, edge := range viewerResp.Results.Edges {
 		viewerCreated = append(viewerCreated, edge.Node)
 	}
 
 	var reviewRequested []PullRequest
-	for _, edge := range resp.ReviewRequested.Edges {
+	for _, edge := range reviewerResp.Results.Edges {
 		reviewRequested = append(reviewRequested, edge.Node)
 	}
 
-	var currentPR = resp.Repository.PullRequest
+	var currentPR = repoResp.Repository.PullRequest
 	if currentPR == nil {
-		for _, edge := range resp.Repository.PullRequests.Edges {
+		for _, edge := range repoResp.Repository.PullRequests.Edges {
 			if edge.Node.HeadLabel() == currentPRHeadRef {
 				currentPR = &edge.Node
 				break // Take the most recent PR for the current branch
@@ -495,32 +569,33 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 	payload := PullRequestsPayload{
 		ViewerCreated: PullRequestAndTotalCount{
 			PullRequests: viewerCreated,
-			TotalCount:   resp.ViewerCreated.TotalCount,
+			TotalCount:   viewerResp.Results.TotalCount,
 		},
 		ReviewRequested: PullRequestAndTotalCount{
 			PullRequests: reviewRequested,
-			TotalCount:   resp.ReviewRequested.TotalCount,
+			TotalCount:   reviewerResp.Results.TotalCount,
 		},
 		CurrentPR:     currentPR,
-		DefaultBranch: resp.Repository.DefaultBranchRef.Name,
+		DefaultBranch: repoResp.Repository.DefaultBranchRef.Name,
+		Warnings:      warnings,
 	}
 
 	return &payload, nil
 }
 
-func pullRequestFragment(httpClient *http.Client, hostname string) (string, error) {
+func pullRequestFragments(httpClient *http.Client, hostname string, skipChecks bool) (prFragment, reviewsFragment string, err error) {
 	cachedClient := NewCachedClient(httpClient, time.Hour*24)
 	prFeatures, err := determinePullRequestFeatures(cachedClient, hostname)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 
 	fields := []string{
 		"number", "title", "state", "url", "isDraft", "isCrossRepository",
 		"headRefName", "headRepositoryOwner", "mergeStateStatus",
 	}
-	if prFeatures.HasStatusCheckRollup {
-		fields = append(fields, "statusCheckRollup")
+	if prFeatures.HasStatusCheckRollup && !skipChecks {
+		fields = append(fields, "statusCheckRollupState")
 	}
 	if prFeatures.HasBranchProtectionRule {
 		fields = append(fields, "requiresStrictStatusChecks")
@@ -531,11 +606,13 @@ func pullRequestFragment(httpClient *http.Client, hostname string) (string, erro
 		reviewFields = append(reviewFields, "reviewDecision", "latestReviews")
 	}
 
-	fragments := fmt.Sprintf(`
+	prFragment = fmt.Sprintf(`
 	fragment pr on PullRequest {%s}
+	`, PullRequestGraphQL(fields))
+	reviewsFragment = fmt.Sprintf(`
 	fragment prWithReviews on PullRequest {...pr,%s}
-	`, PullRequestGraphQL(fields), PullRequestGraphQL(reviewFields))
-	return fragments, nil
+	`, PullRequestGraphQL(reviewFields))
+	return
 }
 
 // CreatePullRequest creates a pull request in a GitHub repository
@@ -601,6 +678,15 @@ func CreatePullRequest(client *Client, repo *Repository, params map[string]inter
 		}
 	}
 
+	// projects created under the new Projects experience use a separate mutation
+	if v2IDs, ok := params["projectV2Ids"].([]string); ok {
+		for _, projectV2ID := range v2IDs {
+			if err := AddProjectV2ItemById(client, repo, projectV2ID, pr.ID); err != nil {
+				return pr, err
+			}
+		}
+	}
+
 	// reviewers are requested in yet another additional mutation
 	reviewParams := make(map[string]interface{})
 	if ids, ok := params["userReviewerIds"]; ok && !isBlank(ids) {
@@ -111,3 +111,39 @@ func (prr PullRequestReview) Reactions() ReactionGroups {
 func (prr PullRequestReview) Status() string {
 	return prr.State
 }
+
+// ResolveReviewThread marks a pull request review thread as resolved or
+// unresolved.
+func ResolveReviewThread(client *Client, repoHost string, threadID string, resolved bool) error {
+	gql := graphQLClient(client.http, repoHost)
+
+	if resolved {
+		var mutation struct {
+			ResolveReviewThread struct {
+				Thread struct {
+					ID string
+				}
+			} `graphql:"resolveReviewThread(input: $input)"`
+		}
+		variables := map[string]interface{}{
+			"input": githubv4.ResolveReviewThreadInput{
+				ThreadID: githubv4.ID(threadID),
+			},
+		}
+		return gql.MutateNamed(context.Background(), "ResolveReviewThread", &mutation, variables)
+	}
+
+	var mutation struct {
+		UnresolveReviewThread struct {
+			Thread struct {
+				ID string
+			}
+		} `graphql:"unresolveReviewThread(input: $input)"`
+	}
+	variables := map[string]interface{}{
+		"input": githubv4.UnresolveReviewThreadInput{
+			ThreadID: githubv4.ID(threadID),
+		},
+	}
+	return gql.MutateNamed(context.Background(), "UnresolveReviewThread", &mutation, variables)
+}
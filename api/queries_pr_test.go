@@ -53,10 +53,22 @@ func Test_determinePullRequestFeatures(t *testing.T) {
 	tests := []struct {
 		name           string
 		hostname       string
+		metaResponse   string
 		queryResponse  map[string]string
 		wantPrFeatures pullRequestFeature
 		wantErr        bool
 	}{
+		{
+			name:         "GHE with version detection",
+			hostname:     "ghe-versioned.my.org",
+			metaResponse: `{"installed_version": "3.0.0"}`,
+			wantPrFeatures: pullRequestFeature{
+				HasReviewDecision:       true,
+				HasStatusCheckRollup:    true,
+				HasBranchProtectionRule: true,
+			},
+			wantErr: false,
+		},
 		{
 			name:     "github.com",
 			hostname: "github.com",
@@ -144,6 +156,9 @@ func Test_determinePullRequestFeatures(t *testing.T) {
 			fakeHTTP := &httpmock.Registry{}
 			httpClient := NewHTTPClient(ReplaceTripper(fakeHTTP))
 
+			if tt.metaResponse != "" {
+				fakeHTTP.Register(httpmock.REST("GET", "api/v3/meta"), httpmock.StringResponse(tt.metaResponse))
+			}
 			for query, resp := range tt.queryResponse {
 				fakeHTTP.Register(httpmock.GraphQL(query), httpmock.StringResponse(resp))
 			}
@@ -240,3 +255,49 @@ func Test_Logins(t *testing.T) {
 		})
 	}
 }
+
+func TestPullRequestStatus_partialData(t *testing.T) {
+	http := &httpmock.Registry{}
+	http.Register(
+		httpmock.GraphQL(`query PullRequestStatus\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"defaultBranchRef": { "name": "main" },
+			"pullRequests": { "totalCount": 0, "edges": [] }
+		} },
+		"errors": [
+			{
+				"type": "FORBIDDEN",
+				"message": "Resource protected by organization SAML enforcement",
+				"path": ["repository", "pullRequests", "edges", 0, "node", "headRepositoryOwner"]
+			}
+		] }
+		`))
+	http.Register(
+		httpmock.GraphQL(`query PullRequestStatusViewerCreated\b`),
+		httpmock.StringResponse(`
+		{ "data": { "results": { "totalCount": 1, "edges": [
+			{ "node": { "number": 12, "title": "hello" } }
+		] } } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`query PullRequestStatusReviewRequested\b`),
+		httpmock.StringResponse(`
+		{ "data": { "results": { "totalCount": 0, "edges": [] } } }
+		`))
+
+	client := NewClient(ReplaceTripper(http))
+	repo, _ := ghrepo.FromFullName("OWNER/REPO")
+
+	payload, err := PullRequestStatus(client, repo, StatusOptions{
+		Username: "@me",
+		HeadRef:  "feature",
+		Fields:   []string{"number", "title"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "main", payload.DefaultBranch)
+	assert.Equal(t, 1, payload.ViewerCreated.TotalCount)
+	assert.Equal(t, []string{
+		"GraphQL: Resource protected by organization SAML enforcement (repository.pullRequests.edges.0.node.headRepositoryOwner)",
+	}, payload.Warnings)
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// ProjectV2 is a new-style project that issues and pull requests can be
+// added to through the addProjectV2ItemById mutation.
+type ProjectV2 struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Closed bool   `json:"closed"`
+}
+
+// ProjectsV2IgnorableError reports whether the error comes from a host that
+// does not have the ProjectsV2 schema, such as older GitHub Enterprise Server
+// releases, in which case callers can proceed with classic projects only.
+func ProjectsV2IgnorableError(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "Field 'projectsV2' doesn't exist") ||
+		strings.Contains(msg, "Field 'projectV2' doesn't exist")
+}
+
+// RepoProjectsV2 fetches all open new-style projects for a repository
+func RepoProjectsV2(client *Client, repo ghrepo.Interface) ([]ProjectV2, error) {
+	query := `
+	query RepositoryProjectV2List($owner: String!, $name: String!, $endCursor: String) {
+		repository(owner: $owner, name: $name) {
+			projectsV2(first: 100, after: $endCursor) {
+				nodes { id, title, closed }
+				pageInfo { hasNextPage, endCursor }
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"name":  repo.RepoName(),
+	}
+
+	var projects []ProjectV2
+	for {
+		var result struct {
+			Repository struct {
+				ProjectsV2 struct {
+					Nodes    []ProjectV2
+					PageInfo struct {
+						HasNextPage bool
+						EndCursor   string
+					}
+				}
+			}
+		}
+		err := client.GraphQL(repo.RepoHost(), query, variables, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, project := range result.Repository.ProjectsV2.Nodes {
+			if !project.Closed {
+				projects = append(projects, project)
+			}
+		}
+		if !result.Repository.ProjectsV2.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = result.Repository.ProjectsV2.PageInfo.EndCursor
+	}
+
+	return projects, nil
+}
+
+// OrganizationProjectsV2 fetches all open new-style projects for an organization
+func OrganizationProjectsV2(client *Client, repo ghrepo.Interface) ([]ProjectV2, error) {
+	query := `
+	query OrganizationProjectV2List($owner: String!, $endCursor: String) {
+		organization(login: $owner) {
+			projectsV2(first: 100, after: $endCursor) {
+				nodes { id, title, closed }
+				pageInfo { hasNextPage, endCursor }
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+	}
+
+	var projects []ProjectV2
+	for {
+		var result struct {
+			Organization struct {
+				ProjectsV2 struct {
+					Nodes    []ProjectV2
+					PageInfo struct {
+						HasNextPage bool
+						EndCursor   string
+					}
+				}
+			}
+		}
+		err := client.GraphQL(repo.RepoHost(), query, variables, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, project := range result.Organization.ProjectsV2.Nodes {
+			if !project.Closed {
+				projects = append(projects, project)
+			}
+		}
+		if !result.Organization.ProjectsV2.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = result.Organization.ProjectsV2.PageInfo.EndCursor
+	}
+
+	return projects, nil
+}
+
+// RepoAndOrgProjectsV2 fetches all open new-style projects for a repository
+// and its org. Hosts without the ProjectsV2 schema yield an empty list.
+func RepoAndOrgProjectsV2(client *Client, repo ghrepo.Interface) ([]ProjectV2, error) {
+	projects, err := RepoProjectsV2(client, repo)
+	if err != nil {
+		if ProjectsV2IgnorableError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error fetching projects: %w", err)
+	}
+
+	orgProjects, err := OrganizationProjectsV2(client, repo)
+	// TODO: better detection of non-org repos
+	if err != nil && !strings.Contains(err.Error(), "Could not resolve to an Organization") {
+		return projects, fmt.Errorf("error fetching organization projects: %w", err)
+	}
+	projects = append(projects, orgProjects...)
+
+	return projects, nil
+}
+
+// AddProjectV2ItemById adds an issue or a pull request to a new-style project
+func AddProjectV2ItemById(client *Client, repo ghrepo.Interface, projectID, contentID string) error {
+	query := `
+	mutation AddProjectV2ItemById($input: AddProjectV2ItemByIdInput!) {
+		addProjectV2ItemById(input: $input) {
+			item { id }
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"projectId": projectID,
+			"contentId": contentID,
+		},
+	}
+
+	return client.GraphQL(repo.RepoHost(), query, variables, &struct{}{})
+}
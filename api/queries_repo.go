@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cli/cli/v2/internal/ghrepo"
@@ -628,8 +629,10 @@ type RepoMetadataResult struct {
 	AssignableUsers []RepoAssignee
 	Labels          []RepoLabel
 	Projects        []RepoProject
+	ProjectsV2      []ProjectV2
 	Milestones      []RepoMilestone
 	Teams           []OrgTeam
+	Warnings        []string
 }
 
 func (m *RepoMetadataResult) MembersToIDs(names []string) ([]string, error) {
@@ -687,8 +690,13 @@ func (m *RepoMetadataResult) LabelsToIDs(names []string) ([]string, error) {
 	return ids, nil
 }
 
-func (m *RepoMetadataResult) ProjectsToIDs(names []string) ([]string, error) {
+// ProjectsToIDs resolves project names to their node IDs, matching classic
+// projects by name and new-style projects by title. The two kinds are
+// returned separately because items are added to new-style projects through
+// a different mutation.
+func (m *RepoMetadataResult) ProjectsToIDs(names []string) ([]string, []string, error) {
 	var ids []string
+	var v2IDs []string
 	for _, projectName := range names {
 		found := false
 		for _, p := range m.Projects {
@@ -698,11 +706,21 @@ func (m *RepoMetadataResult) ProjectsToIDs(names []string) ([]string, error) {
 				break
 			}
 		}
+		if found {
+			continue
+		}
+		for _, p := range m.ProjectsV2 {
+			if strings.EqualFold(projectName, p.Title) {
+				v2IDs = append(v2IDs, p.ID)
+				found = true
+				break
+			}
+		}
 		if !found {
-			return nil, fmt.Errorf("'%s' not found", projectName)
+			return nil, nil, fmt.Errorf("'%s' not found", projectName)
 		}
 	}
-	return ids, nil
+	return ids, v2IDs, nil
 }
 
 func ProjectsToPaths(projects []RepoProject, names []string) ([]string, error) {
@@ -758,9 +776,21 @@ func (m *RepoMetadataResult) Merge(m2 *RepoMetadataResult) {
 		m.Projects = m2.Projects
 	}
 
+	if len(m2.ProjectsV2) > 0 || len(m.ProjectsV2) == 0 {
+		m.ProjectsV2 = m2.ProjectsV2
+	}
+
 	if len(m2.Milestones) > 0 || len(m.Milestones) == 0 {
 		m.Milestones = m2.Milestones
 	}
+
+	m.Warnings = append(m.Warnings, m2.Warnings...)
+}
+
+// IsEmpty reports whether the result carries no metadata of any kind
+func (m *RepoMetadataResult) IsEmpty() bool {
+	return len(m.AssignableUsers) == 0 && len(m.Labels) == 0 && len(m.Projects) == 0 &&
+		len(m.ProjectsV2) == 0 && len(m.Milestones) == 0 && len(m.Teams) == 0
 }
 
 type RepoMetadataInput struct {
@@ -769,6 +799,13 @@ type RepoMetadataInput struct {
 	Labels     bool
 	Projects   bool
 	Milestones bool
+
+	// SearchLimit caps the assignee, label, and project listings to a single
+	// page of this size instead of paginating through every item, and Query
+	// filters those listings server-side. This keeps interactive use fast in
+	// repositories where a full listing would take many requests.
+	SearchLimit int
+	Query       string
 }
 
 // RepoMetadata pre-fetches the metadata for attaching to issues and pull requests
@@ -777,12 +814,37 @@ func RepoMetadata(client *Client, repo ghrepo.Interface, input RepoMetadataInput
 	errc := make(chan error)
 	count := 0
 
+	// individual lookups can fail with errors scoped to specific fields, e.g. when a
+	// SAML-protected organization blocks part of a query; record a warning and carry on
+	// with the metadata that did come back instead of failing the whole pre-fetch
+	var warnMu sync.Mutex
+	tolerated := func(err error, context string) bool {
+		graphqlError, ok := err.(*GraphQLErrorResponse)
+		if !ok || !graphqlError.PartialDataError() {
+			return false
+		}
+		warnMu.Lock()
+		result.Warnings = append(result.Warnings, fmt.Sprintf("%s: %s", context, graphqlError.Error()))
+		warnMu.Unlock()
+		return true
+	}
+
 	if input.Assignees || input.Reviewers {
 		count++
 		go func() {
-			users, err := RepoAssignableUsers(client, repo)
+			var users []RepoAssignee
+			var err error
+			if input.SearchLimit > 0 {
+				users, err = RepoAssignableUsersSearch(client, repo, input.Query, input.SearchLimit)
+			} else {
+				users, err = RepoAssignableUsers(client, repo)
+			}
 			if err != nil {
-				err = fmt.Errorf("error fetching assignees: %w", err)
+				if tolerated(err, "error fetching assignees") {
+					err = nil
+				} else {
+					err = fmt.Errorf("error fetching assignees: %w", err)
+				}
 			}
 			result.AssignableUsers = users
 			errc <- err
@@ -793,7 +855,8 @@ func RepoMetadata(client *Client, repo ghrepo.Interface, input RepoMetadataInput
 		go func() {
 			teams, err := OrganizationTeams(client, repo)
 			// TODO: better detection of non-org repos
-			if err != nil && !strings.Contains(err.Error(), "Could not resolve to an Organization") {
+			if err != nil && !strings.Contains(err.Error(), "Could not resolve to an Organization") &&
+				!tolerated(err, "error fetching organization teams") {
 				errc <- fmt.Errorf("error fetching organization teams: %w", err)
 				return
 			}
@@ -804,9 +867,19 @@ func RepoMetadata(client *Client, repo ghrepo.Interface, input RepoMetadataInput
 	if input.Labels {
 		count++
 		go func() {
-			labels, err := RepoLabels(client, repo)
+			var labels []RepoLabel
+			var err error
+			if input.SearchLimit > 0 {
+				labels, err = RepoLabelsSearch(client, repo, input.Query, input.SearchLimit)
+			} else {
+				labels, err = RepoLabels(client, repo)
+			}
 			if err != nil {
-				err = fmt.Errorf("error fetching labels: %w", err)
+				if tolerated(err, "error fetching labels") {
+					err = nil
+				} else {
+					err = fmt.Errorf("error fetching labels: %w", err)
+				}
 			}
 			result.Labels = labels
 			errc <- err
@@ -815,21 +888,41 @@ func RepoMetadata(client *Client, repo ghrepo.Interface, input RepoMetadataInput
 	if input.Projects {
 		count++
 		go func() {
-			projects, err := RepoAndOrgProjects(client, repo)
-			if err != nil {
+			var projects []RepoProject
+			var err error
+			if input.SearchLimit > 0 {
+				projects, err = RepoAndOrgProjectsSearch(client, repo, input.Query, input.SearchLimit)
+			} else {
+				projects, err = RepoAndOrgProjects(client, repo)
+			}
+			if err != nil && !tolerated(err, "error fetching projects") {
 				errc <- err
 				return
 			}
 			result.Projects = projects
 			errc <- nil
 		}()
+		count++
+		go func() {
+			projects, err := RepoAndOrgProjectsV2(client, repo)
+			if err != nil && !tolerated(err, "error fetching projects") {
+				errc <- err
+				return
+			}
+			result.ProjectsV2 = projects
+			errc <- nil
+		}()
 	}
 	if input.Milestones {
 		count++
 		go func() {
 			milestones, err := RepoMilestones(client, repo, "open")
 			if err != nil {
-				err = fmt.Errorf("error fetching milestones: %w", err)
+				if tolerated(err, "error fetching milestones") {
+					err = nil
+				} else {
+					err = fmt.Errorf("error fetching milestones: %w", err)
+				}
 			}
 			result.Milestones = milestones
 			errc <- err
@@ -1012,6 +1105,57 @@ func RepoAndOrgProjects(client *Client, repo ghrepo.Interface) ([]RepoProject, e
 	return projects, nil
 }
 
+// RepoAndOrgProjectsSearch fetches up to limit open projects matching query
+// from a repository and its org, in a single request per scope
+func RepoAndOrgProjectsSearch(client *Client, repo ghrepo.Interface, query string, limit int) ([]RepoProject, error) {
+	type repoResponseData struct {
+		Repository struct {
+			Projects struct {
+				Nodes []RepoProject
+			} `graphql:"projects(states: [OPEN], first: $limit, search: $query, orderBy: {field: NAME, direction: ASC})"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": githubv4.String(repo.RepoOwner()),
+		"name":  githubv4.String(repo.RepoName()),
+		"query": githubv4.String(query),
+		"limit": githubv4.Int(limit),
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+
+	var repoQuery repoResponseData
+	if err := gql.QueryNamed(context.Background(), "RepositoryProjectSearch", &repoQuery, variables); err != nil {
+		return nil, fmt.Errorf("error fetching projects: %w", err)
+	}
+	projects := repoQuery.Repository.Projects.Nodes
+
+	type orgResponseData struct {
+		Organization struct {
+			Projects struct {
+				Nodes []RepoProject
+			} `graphql:"projects(states: [OPEN], first: $limit, search: $query, orderBy: {field: NAME, direction: ASC})"`
+		} `graphql:"organization(login: $owner)"`
+	}
+
+	orgVariables := map[string]interface{}{
+		"owner": githubv4.String(repo.RepoOwner()),
+		"query": githubv4.String(query),
+		"limit": githubv4.Int(limit),
+	}
+
+	var orgQuery orgResponseData
+	err := gql.QueryNamed(context.Background(), "OrganizationProjectSearch", &orgQuery, orgVariables)
+	// TODO: better detection of non-org repos
+	if err != nil && !strings.Contains(err.Error(), "Could not resolve to an Organization") {
+		return projects, fmt.Errorf("error fetching organization projects: %w", err)
+	}
+	projects = append(projects, orgQuery.Organization.Projects.Nodes...)
+
+	return projects, nil
+}
+
 type RepoAssignee struct {
 	ID    string
 	Login string
@@ -1066,6 +1210,34 @@ func RepoAssignableUsers(client *Client, repo ghrepo.Interface) ([]RepoAssignee,
 	return users, nil
 }
 
+// RepoAssignableUsersSearch fetches up to limit assignable users whose login or
+// name matches query, in a single request
+func RepoAssignableUsersSearch(client *Client, repo ghrepo.Interface, query string, limit int) ([]RepoAssignee, error) {
+	type responseData struct {
+		Repository struct {
+			AssignableUsers struct {
+				Nodes []RepoAssignee
+			} `graphql:"assignableUsers(first: $limit, query: $query)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": githubv4.String(repo.RepoOwner()),
+		"name":  githubv4.String(repo.RepoName()),
+		"query": githubv4.String(query),
+		"limit": githubv4.Int(limit),
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+
+	var resp responseData
+	if err := gql.QueryNamed(context.Background(), "RepositoryAssignableUsersSearch", &resp, variables); err != nil {
+		return nil, err
+	}
+
+	return resp.Repository.AssignableUsers.Nodes, nil
+}
+
 type RepoLabel struct {
 	ID   string
 	Name string
@@ -1111,6 +1283,34 @@ func RepoLabels(client *Client, repo ghrepo.Interface) ([]RepoLabel, error) {
 	return labels, nil
 }
 
+// RepoLabelsSearch fetches up to limit labels whose names match query, in a
+// single request
+func RepoLabelsSearch(client *Client, repo ghrepo.Interface, query string, limit int) ([]RepoLabel, error) {
+	type responseData struct {
+		Repository struct {
+			Labels struct {
+				Nodes []RepoLabel
+			} `graphql:"labels(first: $limit, query: $query, orderBy: {field: NAME, direction: ASC})"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": githubv4.String(repo.RepoOwner()),
+		"name":  githubv4.String(repo.RepoName()),
+		"query": githubv4.String(query),
+		"limit": githubv4.Int(limit),
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+
+	var resp responseData
+	if err := gql.QueryNamed(context.Background(), "RepositoryLabelSearch", &resp, variables); err != nil {
+		return nil, err
+	}
+
+	return resp.Repository.Labels.Nodes, nil
+}
+
 type RepoMilestone struct {
 	ID    string
 	Title string
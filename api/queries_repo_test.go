@@ -31,6 +31,31 @@ func TestGitHubRepo_notFound(t *testing.T) {
 	}
 }
 
+func TestGitHubRepo_cassette(t *testing.T) {
+	// re-record the cassette against the live API with GH_RECORD_MODE=record
+	recorder, err := httpmock.NewRecorder("./fixtures/gitHubRepo.json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer recorder.Verify(t)
+
+	client := NewClient(ReplaceTripper(recorder))
+	repo, err := GitHubRepo(client, ghrepo.New("OWNER", "REPO"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if repo.ID != "REPOID" {
+		t.Errorf("got repo ID %q", repo.ID)
+	}
+	if branch := repo.DefaultBranchRef.Name; branch != "trunk" {
+		t.Errorf("got default branch %q", branch)
+	}
+}
+
 func Test_RepoMetadata(t *testing.T) {
 	http := &httpmock.Registry{}
 	client := NewClient(ReplaceTripper(http))
@@ -99,6 +124,25 @@ func Test_RepoMetadata(t *testing.T) {
 			"pageInfo": { "hasNextPage": false }
 		} } } }
 		`))
+	http.Register(
+		httpmock.GraphQL(`query RepositoryProjectV2List\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "projectsV2": {
+			"nodes": [
+				{ "title": "Launch", "id": "LAUNCHV2ID", "closed": false },
+				{ "title": "Done", "id": "DONEV2ID", "closed": true }
+			],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`query OrganizationProjectV2List\b`),
+		httpmock.StringResponse(`
+		{ "data": { "organization": { "projectsV2": {
+			"nodes": [],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
 	http.Register(
 		httpmock.GraphQL(`query OrganizationTeamList\b`),
 		httpmock.StringResponse(`
@@ -144,13 +188,17 @@ func Test_RepoMetadata(t *testing.T) {
 	}
 
 	expectedProjectIDs := []string{"TRIAGEID", "ROADMAPID"}
-	projectIDs, err := result.ProjectsToIDs([]string{"triage", "roadmap"})
+	expectedProjectV2IDs := []string{"LAUNCHV2ID"}
+	projectIDs, projectV2IDs, err := result.ProjectsToIDs([]string{"triage", "roadmap", "launch"})
 	if err != nil {
 		t.Errorf("error resolving projects: %v", err)
 	}
 	if !sliceEqual(projectIDs, expectedProjectIDs) {
 		t.Errorf("expected projects %v, got %v", expectedProjectIDs, projectIDs)
 	}
+	if !sliceEqual(projectV2IDs, expectedProjectV2IDs) {
+		t.Errorf("expected projectsV2 %v, got %v", expectedProjectV2IDs, projectV2IDs)
+	}
 
 	expectedMilestoneID := "BIGONEID"
 	milestoneID, err := result.MilestoneToID("big one.oh")
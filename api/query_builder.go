@@ -54,6 +54,7 @@ var issueCommentLast = shortenQuery(`
 var prReviewRequests = shortenQuery(`
 	reviewRequests(first: 100) {
 		nodes {
+			asCodeOwner,
 			requestedReviewer {
 				__typename,
 				...on User{login},
@@ -126,25 +127,43 @@ var prCommits = shortenQuery(`
 `)
 
 func StatusCheckRollupGraphQL(after string) string {
+	return statusCheckRollupGraphQL(after, "")
+}
+
+// RequiredStatusCheckRollupGraphQL is like StatusCheckRollupGraphQL, but additionally resolves
+// whether each status check is required for the pull request identified by prID. The prID
+// argument names a GraphQL variable that the embedding query must declare.
+func RequiredStatusCheckRollupGraphQL(prID, after string) string {
+	return statusCheckRollupGraphQL(after, prID)
+}
+
+func statusCheckRollupGraphQL(after, prID string) string {
 	var afterClause string
 	if after != "" {
 		afterClause = ",after:" + after
 	}
+	var requiredField string
+	if prID != "" {
+		requiredField = fmt.Sprintf("isRequired(pullRequestId: %s),", prID)
+	}
 	return fmt.Sprintf(shortenQuery(`
 	statusCheckRollup: commits(last: 1) {
 		nodes {
 			commit {
 				statusCheckRollup {
-					contexts(first:100%s) {
+					contexts(first:100%[1]s) {
 						nodes {
 							__typename
 							...on StatusContext {
+								%[2]s
 								context,
 								state,
 								targetUrl
 							},
 							...on CheckRun {
+								%[2]s
 								name,
+								databaseId,
 								status,
 								conclusion,
 								startedAt,
@@ -157,10 +176,10 @@ func StatusCheckRollupGraphQL(after string) string {
 				}
 			}
 		}
-	}`), afterClause)
+	}`), afterClause, requiredField)
 }
 
-var IssueFields = []string{
+var sharedIssuePRFields = []string{
 	"assignees",
 	"author",
 	"body",
@@ -180,10 +199,16 @@ var IssueFields = []string{
 	"url",
 }
 
-var PullRequestFields = append(IssueFields,
+var IssueFields = append(sharedIssuePRFields,
+	"closedByPullRequestsReferences",
+	"stateReason",
+)
+
+var PullRequestFields = append(sharedIssuePRFields,
 	"additions",
 	"baseRefName",
 	"changedFiles",
+	"closingIssuesReferences",
 	"commits",
 	"deletions",
 	"files",
@@ -250,12 +275,22 @@ func PullRequestGraphQL(fields []string) string {
 			q = append(q, prCommits)
 		case "lastCommit": // pseudo-field
 			q = append(q, `commits(last:1){nodes{commit{oid}}}`)
+		case "mergeCommitSettings": // pseudo-field
+			q = append(q, `baseRepository{mergeCommitTitle,mergeCommitMessage,squashMergeCommitTitle,squashMergeCommitMessage}`)
 		case "commitsCount": // pseudo-field
 			q = append(q, `commits{totalCount}`)
+		case "closingIssuesReferences":
+			q = append(q, `closingIssuesReferences(first:10){nodes{number,title,url,repository{nameWithOwner}},totalCount}`)
+		case "closedByPullRequestsReferences":
+			q = append(q, `closedByPullRequestsReferences(first:10){nodes{number,title,url,state,repository{nameWithOwner}},totalCount}`)
 		case "requiresStrictStatusChecks": // pseudo-field
 			q = append(q, `baseRef{branchProtectionRule{requiresStrictStatusChecks}}`)
+		case "branchProtectionRule": // pseudo-field
+			q = append(q, `baseRef{branchProtectionRule{requiresStrictStatusChecks,requiredApprovingReviewCount,requiresCodeOwnerReviews,requiredStatusCheckContexts}}`)
 		case "statusCheckRollup":
 			q = append(q, StatusCheckRollupGraphQL(""))
+		case "statusCheckRollupState": // pseudo-field
+			q = append(q, `statusCheckRollup: commits(last:1){nodes{commit{statusCheckRollup{state}}}}`)
 		default:
 			q = append(q, field)
 		}
@@ -1,12 +1,16 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
 	"strings"
 	"time"
@@ -19,16 +23,20 @@ import (
 	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/internal/telemetry"
 	"github.com/cli/cli/v2/internal/update"
 	"github.com/cli/cli/v2/pkg/cmd/alias/expand"
 	"github.com/cli/cli/v2/pkg/cmd/factory"
 	"github.com/cli/cli/v2/pkg/cmd/root"
 	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/utils"
 	"github.com/cli/safeexec"
+	"github.com/google/shlex"
 	"github.com/mattn/go-colorable"
 	"github.com/mgutz/ansi"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 var updaterEnabled = ""
@@ -59,7 +67,34 @@ func mainRun() exitCode {
 
 	hasDebug := os.Getenv("DEBUG") != ""
 
+	// cancel the command context on the first interrupt so that long-running
+	// operations can clean up after themselves; a second interrupt aborts the
+	// process with the default signal handling
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	go func() {
+		<-interrupts
+		cancel()
+		signal.Stop(interrupts)
+	}()
+
 	cmdFactory := factory.New(buildVersion)
+
+	// bind outgoing API requests to the command context so that canceling it also
+	// aborts any in-flight HTTP requests
+	innerHTTPClient := cmdFactory.HttpClient
+	cmdFactory.HttpClient = func() (*http.Client, error) {
+		httpClient, err := innerHTTPClient()
+		if err != nil {
+			return nil, err
+		}
+		boundClient := *httpClient
+		boundClient.Transport = api.InheritContext(ctx)(boundClient.Transport)
+		return &boundClient, nil
+	}
+
 	stderr := cmdFactory.IOStreams.ErrOut
 
 	if spec := os.Getenv("GH_FORCE_TTY"); spec != "" {
@@ -165,6 +200,18 @@ func mainRun() exitCode {
 		}
 	}
 
+	if mergedArgs, err := applyDefaultFlags(rootCmd, cfg, expandedArgs); err != nil {
+		fmt.Fprintf(stderr, "failed to process default flags:  %s\n", err)
+		return exitError
+	} else if mergedArgs != nil {
+		if hasDebug {
+			fmt.Fprintf(stderr, "%v -> %v\n", expandedArgs, mergedArgs)
+		}
+		expandedArgs = mergedArgs
+	}
+
+	applyCommandPager(rootCmd, cfg, cmdFactory.IOStreams, expandedArgs)
+
 	// provide completions for aliases and extensions
 	rootCmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		var results []string
@@ -187,6 +234,12 @@ func mainRun() exitCode {
 
 	authError := errors.New("authError")
 	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		// --examples renders a trimmed-down help view, which does not require
+		// authentication
+		if showExamples, _ := cmd.Flags().GetBool("examples"); showExamples {
+			return pflag.ErrHelp
+		}
+
 		// require that the user is authenticated before running most commands
 		if cmdutil.IsAuthCheckEnabled(cmd) && !cmdutil.CheckAuth(cfg) {
 			fmt.Fprintln(stderr, cs.Bold("Welcome to GitHub CLI!"))
@@ -195,12 +248,28 @@ func mainRun() exitCode {
 			return authError
 		}
 
+		// verify up front that the token covers scopes the command depends on,
+		// so that the user gets a precise remedy rather than a generic HTTP 403
+		if scopes := cmdutil.RequiredScopes(cmd); len(scopes) > 0 && cmdutil.IsAuthCheckEnabled(cmd) {
+			if httpClient, err := cmdFactory.HttpClient(); err == nil {
+				hostname, _ := cfg.DefaultHost()
+				if missing, err := cmdutil.MissingScopes(httpClient, hostname, scopes); err == nil && len(missing) > 0 {
+					fmt.Fprintf(stderr, "This command requires the %s OAuth scope.\n", strings.Join(missing, ", "))
+					fmt.Fprintf(stderr, "To request it, run:  gh auth refresh -h %s -s %s\n", hostname, strings.Join(missing, ","))
+					return authError
+				}
+			}
+		}
+
 		return nil
 	}
 
 	rootCmd.SetArgs(expandedArgs)
 
-	if cmd, err := rootCmd.ExecuteC(); err != nil {
+	startTime := time.Now()
+	cmd, err := rootCmd.ExecuteContextC(ctx)
+	recordTelemetry(cfg, rootCmd, cmd, time.Since(startTime), err, authError)
+	if err != nil {
 		if err == cmdutil.SilentError {
 			return exitError
 		} else if cmdutil.IsUserCancellation(err) {
@@ -213,6 +282,11 @@ func mainRun() exitCode {
 			return exitAuth
 		}
 
+		if jsonErrorsEnabled(cmd, cmdFactory.IOStreams) {
+			printJSONError(stderr, err)
+			return exitError
+		}
+
 		printError(stderr, err, cmd, hasDebug)
 
 		if strings.Contains(err.Error(), "Incorrect function") {
@@ -259,6 +333,98 @@ func mainRun() exitCode {
 }
 
 // hasCommand returns true if args resolve to a built-in command
+// applyDefaultFlags inserts configured default flags for the invoked command right after the
+// command name and before user-supplied arguments, so that explicitly passed flags win. It
+// returns nil when there are no defaults to apply.
+func applyDefaultFlags(rootCmd *cobra.Command, cfg config.Config, args []string) ([]string, error) {
+	defaults, err := cfg.Defaults()
+	if err != nil {
+		return nil, err
+	}
+	if defaults == nil || defaults.Empty() {
+		return nil, nil
+	}
+
+	cmd, restArgs, err := rootCmd.Find(args)
+	if err != nil || cmd == rootCmd {
+		return nil, nil
+	}
+
+	commandPath := strings.TrimPrefix(cmd.CommandPath(), rootCmd.Name()+" ")
+	flags, ok := defaults.Get(commandPath)
+	if !ok {
+		return nil, nil
+	}
+
+	defaultArgs, err := shlex.Split(flags)
+	if err != nil {
+		return nil, fmt.Errorf("invalid default flags for %q: %w", commandPath, err)
+	}
+	if len(defaultArgs) == 0 {
+		return nil, nil
+	}
+
+	merged := strings.Split(commandPath, " ")
+	merged = append(merged, defaultArgs...)
+	merged = append(merged, restArgs...)
+	return merged, nil
+}
+
+// applyCommandPager switches the pager program when one is configured for the invoked
+// command under the "pagers" section, e.g. "pr diff" => "delta". Overrides configured
+// for a parent command apply to its subcommands, and GH_PAGER always wins over
+// per-command configuration.
+func applyCommandPager(rootCmd *cobra.Command, cfg config.Config, io *iostreams.IOStreams, args []string) {
+	if _, ghPagerExists := os.LookupEnv("GH_PAGER"); ghPagerExists {
+		return
+	}
+
+	pagers, err := cfg.Pagers()
+	if err != nil || pagers == nil {
+		return
+	}
+
+	cmd, _, err := rootCmd.Find(args)
+	if err != nil {
+		return
+	}
+
+	for c := cmd; c != nil && c != rootCmd; c = c.Parent() {
+		commandPath := strings.TrimPrefix(c.CommandPath(), rootCmd.Name()+" ")
+		if pager, ok := pagers.Get(commandPath); ok {
+			io.SetPager(pager)
+			return
+		}
+	}
+}
+
+// recordTelemetry appends a local usage entry for the finished invocation when
+// the user has opted in via the telemetry setting. Recording failures are
+// deliberately ignored so that instrumentation can never break a command.
+func recordTelemetry(cfg config.Config, rootCmd, cmd *cobra.Command, duration time.Duration, err, authError error) {
+	if enabled, _ := cfg.Get("", "telemetry"); enabled != "enabled" {
+		return
+	}
+
+	commandPath := rootCmd.Name()
+	if cmd != nil && cmd != rootCmd {
+		commandPath = strings.TrimPrefix(cmd.CommandPath(), rootCmd.Name()+" ")
+	}
+
+	result := "ok"
+	switch {
+	case err == nil:
+	case cmdutil.IsUserCancellation(err):
+		result = "cancel"
+	case errors.Is(err, authError):
+		result = "auth"
+	default:
+		result = "error"
+	}
+
+	_ = telemetry.Record(config.StateDir(), commandPath, duration, result)
+}
+
 func hasCommand(rootCmd *cobra.Command, args []string) bool {
 	c, _, err := rootCmd.Traverse(args)
 	return err == nil && c != rootCmd
@@ -286,6 +452,54 @@ func printError(out io.Writer, err error, cmd *cobra.Command, debug bool) {
 	}
 }
 
+// jsonErrorsEnabled reports whether failures should be emitted as a structured JSON
+// envelope on standard error. The mode is opt-in via `--json-errors` or GH_JSON_ERRORS
+// and only applies when stdout is not attached to a terminal.
+func jsonErrorsEnabled(cmd *cobra.Command, io *iostreams.IOStreams) bool {
+	if io.IsStdoutTTY() {
+		return false
+	}
+	if os.Getenv("GH_JSON_ERRORS") != "" {
+		return true
+	}
+	if cmd != nil {
+		if enabled, err := cmd.Flags().GetBool("json-errors"); err == nil && enabled {
+			return true
+		}
+	}
+	return false
+}
+
+// printJSONError writes err to out as a single-line JSON envelope so that wrapping
+// tools do not need to parse free-form error text.
+func printJSONError(out io.Writer, err error) {
+	envelope := struct {
+		Type       string `json:"type"`
+		Message    string `json:"message"`
+		HTTPStatus int    `json:"http_status,omitempty"`
+		RequestID  string `json:"request_id,omitempty"`
+	}{
+		Type:    "error",
+		Message: err.Error(),
+	}
+
+	var httpErr api.HTTPError
+	var dnsError *net.DNSError
+	var flagError *cmdutil.FlagError
+	switch {
+	case errors.As(err, &httpErr):
+		envelope.Type = "http_error"
+		envelope.HTTPStatus = httpErr.StatusCode
+		envelope.RequestID = httpErr.RequestID
+	case errors.As(err, &dnsError):
+		envelope.Type = "network_error"
+	case errors.As(err, &flagError), strings.HasPrefix(err.Error(), "unknown command "):
+		envelope.Type = "usage_error"
+	}
+
+	_ = json.NewEncoder(out).Encode(envelope)
+}
+
 func shouldCheckForUpdate() bool {
 	if os.Getenv("GH_NO_UPDATE_NOTIFIER") != "" {
 		return false
@@ -5,12 +5,150 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"testing"
 
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+func Test_applyDefaultFlags(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "gh"}
+	prCmd := &cobra.Command{Use: "pr"}
+	prCmd.AddCommand(&cobra.Command{Use: "list"})
+	rootCmd.AddCommand(prCmd)
+
+	tests := []struct {
+		name   string
+		config string
+		args   []string
+		want   []string
+	}{
+		{
+			name:   "no defaults configured",
+			config: "",
+			args:   []string{"pr", "list"},
+			want:   nil,
+		},
+		{
+			name: "defaults inserted before user args",
+			config: heredoc.Doc(`
+				defaults:
+				  pr list: --limit 50
+			`),
+			args: []string{"pr", "list", "--state", "open"},
+			want: []string{"pr", "list", "--limit", "50", "--state", "open"},
+		},
+		{
+			name: "no defaults for command",
+			config: heredoc.Doc(`
+				defaults:
+				  issue list: --state all
+			`),
+			args: []string{"pr", "list"},
+			want: nil,
+		},
+		{
+			name: "unknown command",
+			config: heredoc.Doc(`
+				defaults:
+				  pr list: --limit 50
+			`),
+			args: []string{"frobnicate"},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.NewFromString(tt.config)
+			got, err := applyDefaultFlags(rootCmd, cfg, tt.args)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_applyCommandPager(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "gh"}
+	prCmd := &cobra.Command{Use: "pr"}
+	prCmd.AddCommand(&cobra.Command{Use: "diff"})
+	rootCmd.AddCommand(prCmd)
+	rootCmd.AddCommand(&cobra.Command{Use: "api"})
+
+	tests := []struct {
+		name   string
+		config string
+		args   []string
+		want   string
+	}{
+		{
+			name:   "no pagers configured",
+			config: "pager: less",
+			args:   []string{"pr", "diff"},
+			want:   "less",
+		},
+		{
+			name: "pager for command",
+			config: heredoc.Doc(`
+				pager: less
+				pagers:
+				  pr diff: delta
+			`),
+			args: []string{"pr", "diff"},
+			want: "delta",
+		},
+		{
+			name: "pager disabled for command",
+			config: heredoc.Doc(`
+				pager: less
+				pagers:
+				  api: cat
+			`),
+			args: []string{"api", "repos/OWNER/REPO"},
+			want: "cat",
+		},
+		{
+			name: "pager for parent command",
+			config: heredoc.Doc(`
+				pager: less
+				pagers:
+				  pr: delta
+			`),
+			args: []string{"pr", "diff"},
+			want: "delta",
+		},
+		{
+			name: "no pager for command",
+			config: heredoc.Doc(`
+				pager: less
+				pagers:
+				  pr diff: delta
+			`),
+			args: []string{"api", "repos/OWNER/REPO"},
+			want: "less",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.NewFromString(tt.config)
+			io, _, _, _ := iostreams.Test()
+			if pager, err := cfg.Get("", "pager"); err == nil {
+				io.SetPager(pager)
+			}
+			applyCommandPager(rootCmd, cfg, io, tt.args)
+			assert.Equal(t, tt.want, io.GetPager())
+		})
+	}
+}
+
 func Test_printError(t *testing.T) {
 	cmd := &cobra.Command{}
 
@@ -76,3 +214,87 @@ check your internet connection or https://githubstatus.com
 		})
 	}
 }
+
+func Test_printJSONError(t *testing.T) {
+	requestURL, err := url.Parse("https://api.github.com/repos/OWNER/REPO")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		err     error
+		wantOut string
+	}{
+		{
+			name:    "generic error",
+			err:     errors.New("the app exploded"),
+			wantOut: `{"type":"error","message":"the app exploded"}` + "\n",
+		},
+		{
+			name: "HTTP error",
+			err: api.HTTPError{
+				StatusCode: 404,
+				RequestURL: requestURL,
+				RequestID:  "1234:5678",
+				Message:    "Not Found",
+			},
+			wantOut: `{"type":"http_error","message":"HTTP 404: Not Found (https://api.github.com/repos/OWNER/REPO)","http_status":404,"request_id":"1234:5678"}` + "\n",
+		},
+		{
+			name: "DNS error",
+			err: fmt.Errorf("DNS oopsie: %w", &net.DNSError{
+				Name: "api.github.com",
+			}),
+			wantOut: `{"type":"network_error","message":"DNS oopsie: lookup api.github.com: "}` + "\n",
+		},
+		{
+			name:    "Cobra flag error",
+			err:     cmdutil.FlagErrorf("unknown flag --foo"),
+			wantOut: `{"type":"usage_error","message":"unknown flag --foo"}` + "\n",
+		},
+		{
+			name:    "unknown Cobra command error",
+			err:     errors.New("unknown command foo"),
+			wantOut: `{"type":"usage_error","message":"unknown command foo"}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			printJSONError(out, tt.err)
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
+
+func Test_jsonErrorsEnabled(t *testing.T) {
+	newCmd := func(t *testing.T, args ...string) *cobra.Command {
+		cmd := &cobra.Command{Use: "gh"}
+		cmd.PersistentFlags().Bool("json-errors", false, "")
+		require.NoError(t, cmd.ParseFlags(args))
+		return cmd
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		io, _, _, _ := iostreams.Test()
+		assert.False(t, jsonErrorsEnabled(newCmd(t), io))
+	})
+
+	t.Run("enabled by flag", func(t *testing.T) {
+		io, _, _, _ := iostreams.Test()
+		assert.True(t, jsonErrorsEnabled(newCmd(t, "--json-errors"), io))
+	})
+
+	t.Run("enabled by environment", func(t *testing.T) {
+		io, _, _, _ := iostreams.Test()
+		t.Setenv("GH_JSON_ERRORS", "1")
+		assert.True(t, jsonErrorsEnabled(newCmd(t), io))
+	})
+
+	t.Run("disabled when stdout is a terminal", func(t *testing.T) {
+		io, _, _, _ := iostreams.Test()
+		io.SetStdoutTTY(true)
+		t.Setenv("GH_JSON_ERRORS", "1")
+		assert.False(t, jsonErrorsEnabled(newCmd(t), io))
+	})
+}
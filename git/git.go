@@ -2,6 +2,7 @@ package git
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -143,6 +144,15 @@ func GitCommand(args ...string) (*exec.Cmd, error) {
 	return exec.Command(gitExe, args...), nil
 }
 
+// GitCommandContext is like GitCommand, but the process is killed when ctx is done
+func GitCommandContext(ctx context.Context, args ...string) (*exec.Cmd, error) {
+	cmd, err := GitCommand(args...)
+	if err != nil {
+		return nil, err
+	}
+	return exec.CommandContext(ctx, cmd.Path, args...), nil
+}
+
 func UncommittedChangeCount() (int, error) {
 	statusCmd, err := GitCommand("status", "--porcelain")
 	if err != nil {
@@ -290,6 +300,16 @@ func DeleteLocalBranch(branch string) error {
 	return run.PrepareCmd(branchCmd).Run()
 }
 
+// DeleteRemoteTrackingRef removes the remote-tracking ref that remains after
+// the upstream branch was deleted
+func DeleteRemoteTrackingRef(remote, branch string) error {
+	refCmd, err := GitCommand("update-ref", "-d", fmt.Sprintf("refs/remotes/%s/%s", remote, branch))
+	if err != nil {
+		return err
+	}
+	return run.PrepareCmd(refCmd).Run()
+}
+
 func HasLocalBranch(branch string) bool {
 	configCmd, err := GitCommand("rev-parse", "--verify", "refs/heads/"+branch)
 	if err != nil {
@@ -307,6 +327,77 @@ func CheckoutBranch(branch string) error {
 	return run.PrepareCmd(configCmd).Run()
 }
 
+// Worktree represents a linked git worktree
+type Worktree struct {
+	Path   string
+	Branch string
+}
+
+// Worktrees lists the worktrees attached to the current repository
+func Worktrees() ([]Worktree, error) {
+	listCmd, err := GitCommand("worktree", "list", "--porcelain")
+	if err != nil {
+		return nil, err
+	}
+	output, err := run.PrepareCmd(listCmd).Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var worktrees []Worktree
+	var current Worktree
+	for _, line := range outputLines(output) {
+		switch {
+		case strings.HasPrefix(line, "worktree "):
+			current = Worktree{Path: strings.TrimPrefix(line, "worktree ")}
+		case strings.HasPrefix(line, "branch "):
+			current.Branch = strings.TrimPrefix(strings.TrimPrefix(line, "branch "), "refs/heads/")
+		case line == "":
+			if current.Path != "" {
+				worktrees = append(worktrees, current)
+			}
+			current = Worktree{}
+		}
+	}
+	if current.Path != "" {
+		worktrees = append(worktrees, current)
+	}
+	return worktrees, nil
+}
+
+// AddWorktree creates a linked worktree at dir checked out to an existing branch
+func AddWorktree(dir, branch string) error {
+	addCmd, err := GitCommand("worktree", "add", dir, branch)
+	if err != nil {
+		return err
+	}
+	addCmd.Stdout = os.Stdout
+	addCmd.Stderr = os.Stderr
+	return run.PrepareCmd(addCmd).Run()
+}
+
+// AddTrackedWorktree creates a linked worktree at dir on a new branch that
+// tracks remoteBranch
+func AddTrackedWorktree(dir, branch, remoteBranch string) error {
+	addCmd, err := GitCommand("worktree", "add", "--track", "-b", branch, dir, remoteBranch)
+	if err != nil {
+		return err
+	}
+	addCmd.Stdout = os.Stdout
+	addCmd.Stderr = os.Stderr
+	return run.PrepareCmd(addCmd).Run()
+}
+
+// RemoveWorktree removes the linked worktree at dir
+func RemoveWorktree(dir string) error {
+	removeCmd, err := GitCommand("worktree", "remove", dir)
+	if err != nil {
+		return err
+	}
+	removeCmd.Stderr = os.Stderr
+	return run.PrepareCmd(removeCmd).Run()
+}
+
 // pull changes from remote branch without version history
 func Pull(remote, branch string) error {
 	pullCmd, err := GitCommand("pull", "--ff-only", remote, branch)
@@ -332,6 +423,12 @@ func parseCloneArgs(extraArgs []string) (args []string, target string) {
 }
 
 func RunClone(cloneURL string, args []string) (target string, err error) {
+	return RunCloneContext(context.Background(), cloneURL, args)
+}
+
+// RunCloneContext clones a repository and removes the partial clone if the
+// operation was cancelled before it could finish
+func RunCloneContext(ctx context.Context, cloneURL string, args []string) (target string, err error) {
 	cloneArgs, target := parseCloneArgs(args)
 
 	cloneArgs = append(cloneArgs, cloneURL)
@@ -346,7 +443,12 @@ func RunClone(cloneURL string, args []string) (target string, err error) {
 
 	cloneArgs = append([]string{"clone"}, cloneArgs...)
 
-	cloneCmd, err := GitCommand(cloneArgs...)
+	targetExisted := true
+	if _, statErr := os.Stat(target); os.IsNotExist(statErr) {
+		targetExisted = false
+	}
+
+	cloneCmd, err := GitCommandContext(ctx, cloneArgs...)
 	if err != nil {
 		return "", err
 	}
@@ -355,15 +457,25 @@ func RunClone(cloneURL string, args []string) (target string, err error) {
 	cloneCmd.Stderr = os.Stderr
 
 	err = run.PrepareCmd(cloneCmd).Run()
+	if err != nil && ctx.Err() != nil {
+		if !targetExisted {
+			_ = os.RemoveAll(target)
+		}
+		err = ctx.Err()
+	}
 	return
 }
 
 func AddUpstreamRemote(upstreamURL, cloneDir string, branches []string) error {
-	args := []string{"-C", cloneDir, "remote", "add"}
+	return AddNamedRemote(upstreamURL, "upstream", cloneDir, branches)
+}
+
+func AddNamedRemote(url, name, repoDir string, branches []string) error {
+	args := []string{"-C", repoDir, "remote", "add"}
 	for _, branch := range branches {
 		args = append(args, "-t", branch)
 	}
-	args = append(args, "-f", "upstream", upstreamURL)
+	args = append(args, "-f", name, url)
 	cloneCmd, err := GitCommand(args...)
 	if err != nil {
 		return err
@@ -373,6 +485,27 @@ func AddUpstreamRemote(upstreamURL, cloneDir string, branches []string) error {
 	return run.PrepareCmd(cloneCmd).Run()
 }
 
+// UpdateSubmodules initializes and recursively updates all submodules of the
+// repository at repoDir.
+func UpdateSubmodules(repoDir string) error {
+	updateCmd, err := GitCommand("-C", repoDir, "submodule", "update", "--init", "--recursive")
+	if err != nil {
+		return err
+	}
+	updateCmd.Stdout = os.Stdout
+	updateCmd.Stderr = os.Stderr
+	return run.PrepareCmd(updateCmd).Run()
+}
+
+// SetConfig sets a git configuration value in the repository at repoDir.
+func SetConfig(repoDir, name, value string) error {
+	configCmd, err := GitCommand("-C", repoDir, "config", name, value)
+	if err != nil {
+		return err
+	}
+	return run.PrepareCmd(configCmd).Run()
+}
+
 func isFilesystemPath(p string) bool {
 	return p == "." || strings.HasPrefix(p, "./") || strings.HasPrefix(p, "/")
 }
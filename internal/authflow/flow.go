@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"runtime"
 	"strings"
 
 	"github.com/cli/cli/v2/api"
@@ -13,6 +14,7 @@ import (
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/oauth"
+	"github.com/cli/safeexec"
 )
 
 var (
@@ -79,7 +81,7 @@ func authFlow(oauthHost string, IO *iostreams.IOStreams, notice string, addition
 			return nil
 		},
 		BrowseURL: func(url string) error {
-			if !isInteractive {
+			if !isInteractive || !hasBrowserLauncher() {
 				fmt.Fprintf(w, "%s to continue in your web browser: %s\n", cs.Bold("Open this URL"), url)
 				return nil
 			}
@@ -119,6 +121,25 @@ func authFlow(oauthHost string, IO *iostreams.IOStreams, notice string, addition
 	return token.Token, userLogin, nil
 }
 
+// hasBrowserLauncher reports whether this environment has any means of opening
+// a web browser; without one, e.g. over SSH, the verification URL is only
+// printed for the user to open elsewhere
+func hasBrowserLauncher() bool {
+	if os.Getenv("BROWSER") != "" {
+		return true
+	}
+	switch runtime.GOOS {
+	case "darwin", "windows":
+		return true
+	default:
+		if os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "" {
+			return false
+		}
+		_, err := safeexec.LookPath("xdg-open")
+		return err == nil
+	}
+}
+
 func getViewer(hostname, token string) (string, error) {
 	http := api.NewClient(api.AddHeader("Authorization", fmt.Sprintf("token %s", token)))
 	return api.CurrentLoginName(http, hostname)
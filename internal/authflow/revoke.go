@@ -0,0 +1,44 @@
+package authflow
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+// RevokeToken revokes an OAuth access token that was created by gh's own
+// OAuth app by calling the token revocation endpoint of the host. Tokens that
+// the host no longer knows about are treated as already revoked.
+func RevokeToken(httpClient *http.Client, hostname, token string) error {
+	url := fmt.Sprintf("%sapplications/%s/token", ghinstance.RESTPrefix(hostname), oauthClientID)
+
+	body, err := json.Marshal(map[string]string{"access_token": token})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("DELETE", url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(oauthClientID, oauthClientSecret)
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode > 299 && res.StatusCode != 404 {
+		return api.HandleHTTPError(res)
+	}
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+	return nil
+}
@@ -49,22 +49,26 @@ func ConfigDir() string {
 }
 
 // State path precedence
-// 1. XDG_STATE_HOME
-// 2. LocalAppData (windows only)
-// 3. HOME
+// 1. GH_CONFIG_DIR (so that the config dir stays fully relocatable)
+// 2. XDG_STATE_HOME
+// 3. LocalAppData (windows only)
+// 4. HOME
 func StateDir() string {
 	var path string
-	if a := os.Getenv(XDG_STATE_HOME); a != "" {
-		path = filepath.Join(a, "gh")
-	} else if b := os.Getenv(LOCAL_APP_DATA); runtime.GOOS == "windows" && b != "" {
-		path = filepath.Join(b, "GitHub CLI")
+	if a := os.Getenv(GH_CONFIG_DIR); a != "" {
+		path = a
+	} else if b := os.Getenv(XDG_STATE_HOME); b != "" {
+		path = filepath.Join(b, "gh")
+	} else if c := os.Getenv(LOCAL_APP_DATA); runtime.GOOS == "windows" && c != "" {
+		path = filepath.Join(c, "GitHub CLI")
 	} else {
-		c, _ := os.UserHomeDir()
-		path = filepath.Join(c, ".local", "state", "gh")
+		d, _ := os.UserHomeDir()
+		path = filepath.Join(d, ".local", "state", "gh")
 	}
 
-	// If the path does not exist try migrating state from default paths
-	if !dirExists(path) {
+	// If the path does not exist and the GH_CONFIG_DIR flag is not set try
+	// migrating state from default paths.
+	if !dirExists(path) && os.Getenv(GH_CONFIG_DIR) == "" {
 		_ = autoMigrateStateDir(path)
 	}
 
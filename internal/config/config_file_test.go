@@ -370,6 +370,14 @@ func Test_StateDir(t *testing.T) {
 			},
 			output: filepath.Join(tempDir, "gh"),
 		},
+		{
+			name: "GH_CONFIG_DIR specified",
+			env: map[string]string{
+				"GH_CONFIG_DIR":  filepath.Join(tempDir, "gh_config_dir"),
+				"XDG_STATE_HOME": tempDir,
+			},
+			output: filepath.Join(tempDir, "gh_config_dir"),
+		},
 		{
 			name:        "LocalAppData specified",
 			onlyWindows: true,
@@ -14,11 +14,14 @@ type Config interface {
 	GetOrDefaultWithSource(string, string) (string, string, error)
 	Default(string) string
 	Set(string, string, string) error
+	Unset(string, string) error
 	UnsetHost(string)
 	Hosts() ([]string, error)
 	DefaultHost() (string, error)
 	DefaultHostWithSource() (string, string, error)
 	Aliases() (*AliasConfig, error)
+	Defaults() (*DefaultsConfig, error)
+	Pagers() (*PagersConfig, error)
 	CheckWriteable(string, string) error
 	Write() error
 }
@@ -63,6 +66,33 @@ var configOptions = []ConfigOption{
 		Description:  "the web browser to use for opening URLs",
 		DefaultValue: "",
 	},
+	{
+		Key:          "request_timeout",
+		Description:  "the maximum time to wait for an API request to complete, e.g. \"10s\"",
+		DefaultValue: "",
+	},
+	{
+		Key:           "accessible",
+		Description:   "toggle screen-reader friendly output: plain-text prompts and no spinners",
+		DefaultValue:  "disabled",
+		AllowedValues: []string{"enabled", "disabled"},
+	},
+	{
+		Key:           "telemetry",
+		Description:   "toggle local-only collection of command usage statistics",
+		DefaultValue:  "disabled",
+		AllowedValues: []string{"enabled", "disabled"},
+	},
+	{
+		Key:          "pr_merge_commit_subject",
+		Description:  "the template for the commit headline when merging pull requests",
+		DefaultValue: "",
+	},
+	{
+		Key:          "pr_merge_commit_body",
+		Description:  "the template for the commit body when merging pull requests",
+		DefaultValue: "",
+	},
 }
 
 func ConfigOptions() []ConfigOption {
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"fmt"
+)
+
+// DefaultsConfig stores per-command default flags, e.g. "pr list" => "--limit 50".
+type DefaultsConfig struct {
+	ConfigMap
+	Parent Config
+}
+
+func (d *DefaultsConfig) Get(command string) (string, bool) {
+	if d.Empty() {
+		return "", false
+	}
+	value, _ := d.GetStringValue(command)
+
+	return value, value != ""
+}
+
+func (d *DefaultsConfig) Add(command, flags string) error {
+	err := d.SetStringValue(command, flags)
+	if err != nil {
+		return fmt.Errorf("failed to update config: %w", err)
+	}
+
+	err = d.Parent.Write()
+	if err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+
+	return nil
+}
+
+func (d *DefaultsConfig) Delete(command string) error {
+	d.RemoveEntry(command)
+
+	err := d.Parent.Write()
+	if err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+
+	return nil
+}
+
+func (d *DefaultsConfig) All() map[string]string {
+	out := map[string]string{}
+
+	if d.Empty() {
+		return out
+	}
+
+	for i := 0; i < len(d.Root.Content)-1; i += 2 {
+		key := d.Root.Content[i].Value
+		value := d.Root.Content[i+1].Value
+		out[key] = value
+	}
+
+	return out
+}
@@ -100,6 +100,23 @@ func (c *fileConfig) Set(hostname, key, value string) error {
 	}
 }
 
+func (c *fileConfig) Unset(hostname, key string) error {
+	if hostname == "" {
+		c.RemoveEntry(key)
+		return nil
+	}
+
+	hostCfg, err := c.configForHost(hostname)
+	var notFound *NotFoundError
+	if errors.As(err, &notFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	hostCfg.RemoveEntry(key)
+	return nil
+}
+
 func (c *fileConfig) UnsetHost(hostname string) {
 	if hostname == "" {
 		return
@@ -166,9 +183,44 @@ func (c *fileConfig) Write() error {
 }
 
 func (c *fileConfig) Aliases() (*AliasConfig, error) {
-	// The complexity here is for dealing with either a missing or empty aliases key. It's something
-	// we'll likely want for other config sections at some point.
-	entry, err := c.FindEntry("aliases")
+	valueNode, err := c.sectionMap("aliases")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AliasConfig{
+		Parent:    c,
+		ConfigMap: ConfigMap{Root: valueNode},
+	}, nil
+}
+
+func (c *fileConfig) Defaults() (*DefaultsConfig, error) {
+	valueNode, err := c.sectionMap("defaults")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DefaultsConfig{
+		Parent:    c,
+		ConfigMap: ConfigMap{Root: valueNode},
+	}, nil
+}
+
+func (c *fileConfig) Pagers() (*PagersConfig, error) {
+	valueNode, err := c.sectionMap("pagers")
+	if err != nil {
+		return nil, err
+	}
+
+	return &PagersConfig{
+		Parent:    c,
+		ConfigMap: ConfigMap{Root: valueNode},
+	}, nil
+}
+
+// sectionMap locates a top-level mapping in the config file, creating it if it's missing or empty.
+func (c *fileConfig) sectionMap(section string) (*yaml.Node, error) {
+	entry, err := c.FindEntry(section)
 	var nfe *NotFoundError
 	notFound := errors.As(err, &nfe)
 	if err != nil && !notFound {
@@ -183,7 +235,7 @@ func (c *fileConfig) Aliases() (*AliasConfig, error) {
 	if keyNode == nil {
 		keyNode = &yaml.Node{
 			Kind:  yaml.ScalarNode,
-			Value: "aliases",
+			Value: section,
 		}
 		toInsert = append(toInsert, keyNode)
 	}
@@ -213,10 +265,7 @@ func (c *fileConfig) Aliases() (*AliasConfig, error) {
 		c.Root().Content = newContent
 	}
 
-	return &AliasConfig{
-		Parent:    c,
-		ConfigMap: ConfigMap{Root: valueNode},
-	}, nil
+	return valueNode, nil
 }
 
 func (c *fileConfig) hostEntries() ([]*HostConfig, error) {
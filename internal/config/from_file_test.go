@@ -13,3 +13,22 @@ func Test_fileConfig_Hosts(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, []string{}, hosts)
 }
+
+func Test_fileConfig_Unset(t *testing.T) {
+	c := NewBlankConfig()
+	require.NoError(t, c.Set("", "editor", "vim"))
+	require.NoError(t, c.Set("github.com", "git_protocol", "ssh"))
+
+	require.NoError(t, c.Unset("", "editor"))
+	val, err := c.Get("", "editor")
+	require.NoError(t, err)
+	assert.Equal(t, "", val)
+
+	require.NoError(t, c.Unset("github.com", "git_protocol"))
+	val, err = c.Get("github.com", "git_protocol")
+	require.NoError(t, err)
+	assert.Equal(t, "https", val)
+
+	// unsetting a key for an unknown host is a no-op
+	require.NoError(t, c.Unset("example.com", "git_protocol"))
+}
@@ -0,0 +1,17 @@
+package config
+
+// PagersConfig stores per-command pager overrides, e.g. "pr diff" => "delta".
+// Setting a command's pager to "cat" disables paging for just that command.
+type PagersConfig struct {
+	ConfigMap
+	Parent Config
+}
+
+func (p *PagersConfig) Get(command string) (string, bool) {
+	if p.Empty() {
+		return "", false
+	}
+	value, _ := p.GetStringValue(command)
+
+	return value, value != ""
+}
@@ -51,10 +51,23 @@ func (c ConfigStub) Aliases() (*AliasConfig, error) {
 	return nil, nil
 }
 
+func (c ConfigStub) Defaults() (*DefaultsConfig, error) {
+	return nil, nil
+}
+
+func (c ConfigStub) Pagers() (*PagersConfig, error) {
+	return nil, nil
+}
+
 func (c ConfigStub) Hosts() ([]string, error) {
 	return nil, nil
 }
 
+func (c ConfigStub) Unset(host, key string) error {
+	delete(c, genKey(host, key))
+	return nil
+}
+
 func (c ConfigStub) UnsetHost(hostname string) {
 }
 
@@ -0,0 +1,34 @@
+package ghinstance
+
+import "strings"
+
+// hostAliases maps user-defined host aliases to the canonical host names they stand for.
+var hostAliases map[string]string
+
+// SetHostAliases registers host aliases declared in configuration. It is meant to be
+// invoked once during initialization, before any hostname resolution takes place.
+func SetHostAliases(aliases map[string]string) {
+	if len(aliases) == 0 {
+		hostAliases = nil
+		return
+	}
+	hostAliases = make(map[string]string, len(aliases))
+	for alias, hostname := range aliases {
+		hostAliases[strings.ToLower(alias)] = strings.ToLower(hostname)
+	}
+}
+
+// IsHostAlias reports whether h is a configured host alias.
+func IsHostAlias(h string) bool {
+	_, ok := hostAliases[strings.ToLower(h)]
+	return ok
+}
+
+// ResolveHostname expands a configured host alias into the host name it points at.
+// Host names that are not aliases are returned unchanged.
+func ResolveHostname(h string) string {
+	if hostname, ok := hostAliases[strings.ToLower(h)]; ok {
+		return hostname
+	}
+	return h
+}
@@ -0,0 +1,30 @@
+package ghinstance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveHostname(t *testing.T) {
+	SetHostAliases(map[string]string{"github-work": "GHE.Company.com"})
+	defer SetHostAliases(nil)
+
+	assert.Equal(t, "ghe.company.com", ResolveHostname("github-work"))
+	assert.Equal(t, "ghe.company.com", ResolveHostname("GitHub-Work"))
+	assert.Equal(t, "example.com", ResolveHostname("example.com"))
+
+	assert.True(t, IsHostAlias("github-work"))
+	assert.False(t, IsHostAlias("ghe.company.com"))
+}
+
+func TestHostAliasResolution(t *testing.T) {
+	SetHostAliases(map[string]string{"github-work": "ghe.company.com"})
+	defer SetHostAliases(nil)
+
+	assert.Equal(t, "ghe.company.com", NormalizeHostname("github-work"))
+	assert.True(t, IsEnterprise("github-work"))
+	assert.Equal(t, "https://ghe.company.com/api/graphql", GraphQLEndpoint("github-work"))
+	assert.Equal(t, "https://ghe.company.com/api/v3/", RESTPrefix("github-work"))
+	assert.Equal(t, "https://ghe.company.com/", HostPrefix("github-work"))
+}
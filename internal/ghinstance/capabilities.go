@@ -0,0 +1,123 @@
+package ghinstance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Capabilities describes which GitHub features a host supports. All features
+// are available on github.com; on GitHub Enterprise Server, availability
+// depends on the installed version.
+type Capabilities struct {
+	AutoMerge            bool
+	ReviewDecision       bool
+	CheckRollup          bool
+	BranchProtectionRule bool
+	YAMLForms            bool
+}
+
+// The GHES versions that introduced each feature.
+var (
+	autoMergeSince            = ghesVersion{3, 1}
+	reviewDecisionSince       = ghesVersion{2, 21}
+	checkRollupSince          = ghesVersion{2, 22}
+	branchProtectionRuleSince = ghesVersion{2, 21}
+	yamlFormsSince            = ghesVersion{3, 4}
+)
+
+var (
+	capabilitiesCache   = map[string]Capabilities{}
+	capabilitiesCacheMu sync.Mutex
+)
+
+// DetectCapabilities determines the feature set supported by a GitHub host.
+// For GHES, the installed version is read from the meta endpoint and mapped
+// onto the capability matrix above; results are cached per hostname so that
+// repeated lookups do not re-query the API.
+func DetectCapabilities(httpClient *http.Client, hostname string) (Capabilities, error) {
+	if !IsEnterprise(hostname) {
+		return Capabilities{
+			AutoMerge:            true,
+			ReviewDecision:       true,
+			CheckRollup:          true,
+			BranchProtectionRule: true,
+			YAMLForms:            true,
+		}, nil
+	}
+
+	normalized := NormalizeHostname(hostname)
+
+	capabilitiesCacheMu.Lock()
+	defer capabilitiesCacheMu.Unlock()
+	if caps, found := capabilitiesCache[normalized]; found {
+		return caps, nil
+	}
+
+	version, err := fetchInstalledVersion(httpClient, hostname)
+	if err != nil {
+		return Capabilities{}, err
+	}
+
+	caps := Capabilities{
+		AutoMerge:            !version.before(autoMergeSince),
+		ReviewDecision:       !version.before(reviewDecisionSince),
+		CheckRollup:          !version.before(checkRollupSince),
+		BranchProtectionRule: !version.before(branchProtectionRuleSince),
+		YAMLForms:            !version.before(yamlFormsSince),
+	}
+	capabilitiesCache[normalized] = caps
+	return caps, nil
+}
+
+type ghesVersion struct {
+	major int
+	minor int
+}
+
+func (v ghesVersion) before(other ghesVersion) bool {
+	if v.major != other.major {
+		return v.major < other.major
+	}
+	return v.minor < other.minor
+}
+
+func fetchInstalledVersion(httpClient *http.Client, hostname string) (ghesVersion, error) {
+	resp, err := httpClient.Get(RESTPrefix(hostname) + "meta")
+	if err != nil {
+		return ghesVersion{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return ghesVersion{}, fmt.Errorf("failed to determine GHES version for %s: HTTP %d", hostname, resp.StatusCode)
+	}
+
+	var meta struct {
+		InstalledVersion string `json:"installed_version"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&meta); err != nil {
+		return ghesVersion{}, err
+	}
+
+	return parseGHESVersion(meta.InstalledVersion)
+}
+
+func parseGHESVersion(s string) (ghesVersion, error) {
+	parts := strings.SplitN(s, ".", 3)
+	if len(parts) < 2 {
+		return ghesVersion{}, fmt.Errorf("invalid GHES version: %q", s)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return ghesVersion{}, fmt.Errorf("invalid GHES version: %q", s)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return ghesVersion{}, fmt.Errorf("invalid GHES version: %q", s)
+	}
+	return ghesVersion{major, minor}, nil
+}
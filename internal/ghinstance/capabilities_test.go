@@ -0,0 +1,131 @@
+package ghinstance
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectCapabilities(t *testing.T) {
+	tests := []struct {
+		name             string
+		hostname         string
+		installedVersion string
+		want             Capabilities
+	}{
+		{
+			name:     "github.com",
+			hostname: "github.com",
+			want: Capabilities{
+				AutoMerge:            true,
+				ReviewDecision:       true,
+				CheckRollup:          true,
+				BranchProtectionRule: true,
+				YAMLForms:            true,
+			},
+		},
+		{
+			name:             "GHES 3.4",
+			hostname:         "ghe34.example.com",
+			installedVersion: "3.4.1",
+			want: Capabilities{
+				AutoMerge:            true,
+				ReviewDecision:       true,
+				CheckRollup:          true,
+				BranchProtectionRule: true,
+				YAMLForms:            true,
+			},
+		},
+		{
+			name:             "GHES 3.1",
+			hostname:         "ghe31.example.com",
+			installedVersion: "3.1.0",
+			want: Capabilities{
+				AutoMerge:            true,
+				ReviewDecision:       true,
+				CheckRollup:          true,
+				BranchProtectionRule: true,
+				YAMLForms:            false,
+			},
+		},
+		{
+			name:             "GHES 2.21",
+			hostname:         "ghe221.example.com",
+			installedVersion: "2.21.14",
+			want: Capabilities{
+				AutoMerge:            false,
+				ReviewDecision:       true,
+				CheckRollup:          false,
+				BranchProtectionRule: true,
+				YAMLForms:            false,
+			},
+		},
+		{
+			name:             "GHES 2.20",
+			hostname:         "ghe220.example.com",
+			installedVersion: "2.20.0",
+			want:             Capabilities{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.installedVersion != "" {
+				reg.Register(
+					httpmock.REST("GET", "api/v3/meta"),
+					httpmock.StringResponse(`{"installed_version": "`+tt.installedVersion+`"}`))
+			}
+
+			caps, err := DetectCapabilities(&http.Client{Transport: reg}, tt.hostname)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, caps)
+		})
+	}
+}
+
+func TestDetectCapabilities_cached(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "api/v3/meta"),
+		httpmock.StringResponse(`{"installed_version": "3.2.0"}`))
+
+	client := &http.Client{Transport: reg}
+	first, err := DetectCapabilities(client, "ghe-cached.example.com")
+	assert.NoError(t, err)
+
+	// a second lookup must not hit the meta endpoint again
+	second, err := DetectCapabilities(client, "ghe-cached.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, len(reg.Requests))
+}
+
+func TestParseGHESVersion(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    ghesVersion
+		wantErr bool
+	}{
+		{input: "3.4.1", want: ghesVersion{3, 4}},
+		{input: "2.22", want: ghesVersion{2, 22}},
+		{input: "GitHub AE", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseGHESVersion(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -24,7 +24,7 @@ func IsEnterprise(h string) bool {
 
 // NormalizeHostname returns the canonical host name of a GitHub instance
 func NormalizeHostname(h string) string {
-	hostname := strings.ToLower(h)
+	hostname := ResolveHostname(strings.ToLower(h))
 	if strings.HasSuffix(hostname, "."+defaultHostname) {
 		return defaultHostname
 	}
@@ -52,6 +52,7 @@ func HostnameValidator(v interface{}) error {
 }
 
 func GraphQLEndpoint(hostname string) string {
+	hostname = ResolveHostname(hostname)
 	if IsEnterprise(hostname) {
 		return fmt.Sprintf("https://%s/api/graphql", hostname)
 	}
@@ -62,6 +63,7 @@ func GraphQLEndpoint(hostname string) string {
 }
 
 func RESTPrefix(hostname string) string {
+	hostname = ResolveHostname(hostname)
 	if IsEnterprise(hostname) {
 		return fmt.Sprintf("https://%s/api/v3/", hostname)
 	}
@@ -72,6 +74,7 @@ func RESTPrefix(hostname string) string {
 }
 
 func GistPrefix(hostname string) string {
+	hostname = ResolveHostname(hostname)
 	prefix := "https://"
 
 	if strings.EqualFold(hostname, localhost) {
@@ -82,6 +85,7 @@ func GistPrefix(hostname string) string {
 }
 
 func GistHost(hostname string) string {
+	hostname = ResolveHostname(hostname)
 	if IsEnterprise(hostname) {
 		return fmt.Sprintf("%s/gist/", hostname)
 	}
@@ -92,6 +96,7 @@ func GistHost(hostname string) string {
 }
 
 func HostPrefix(hostname string) string {
+	hostname = ResolveHostname(hostname)
 	if strings.EqualFold(hostname, localhost) {
 		return fmt.Sprintf("http://%s/", hostname)
 	}
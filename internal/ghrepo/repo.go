@@ -59,6 +59,11 @@ func FromFullName(nwo string) (Interface, error) {
 // FromFullNameWithHost is like FromFullName that defaults to a specific host for values that don't
 // explicitly include a hostname.
 func FromFullNameWithHost(nwo, fallbackHost string) (Interface, error) {
+	// expand the "ALIAS:OWNER/REPO" shorthand for configured host aliases
+	if i := strings.IndexByte(nwo, ':'); i > 0 && ghinstance.IsHostAlias(nwo[:i]) {
+		nwo = nwo[:i] + "/" + nwo[i+1:]
+	}
+
 	if git.IsURL(nwo) {
 		u, err := git.ParseURL(nwo)
 		if err != nil {
@@ -105,7 +110,7 @@ func normalizeHostname(h string) string {
 func IsSame(a, b Interface) bool {
 	return strings.EqualFold(a.RepoOwner(), b.RepoOwner()) &&
 		strings.EqualFold(a.RepoName(), b.RepoName()) &&
-		normalizeHostname(a.RepoHost()) == normalizeHostname(b.RepoHost())
+		ghinstance.ResolveHostname(normalizeHostname(a.RepoHost())) == ghinstance.ResolveHostname(normalizeHostname(b.RepoHost()))
 }
 
 func GenerateRepoURL(repo Interface, p string, args ...interface{}) string {
@@ -121,7 +126,7 @@ func GenerateRepoURL(repo Interface, p string, args ...interface{}) string {
 // TODO there is a parallel implementation for non-isolated commands
 func FormatRemoteURL(repo Interface, protocol string) string {
 	if protocol == "ssh" {
-		return fmt.Sprintf("git@%s:%s/%s.git", repo.RepoHost(), repo.RepoOwner(), repo.RepoName())
+		return fmt.Sprintf("git@%s:%s/%s.git", ghinstance.ResolveHostname(repo.RepoHost()), repo.RepoOwner(), repo.RepoName())
 	}
 
 	return fmt.Sprintf("%s%s/%s.git", ghinstance.HostPrefix(repo.RepoHost()), repo.RepoOwner(), repo.RepoName())
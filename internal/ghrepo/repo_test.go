@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/url"
 	"testing"
+
+	"github.com/cli/cli/v2/internal/ghinstance"
 )
 
 func Test_repoFromURL(t *testing.T) {
@@ -220,3 +222,29 @@ func TestFromFullName(t *testing.T) {
 		})
 	}
 }
+
+func TestFromFullName_hostAlias(t *testing.T) {
+	ghinstance.SetHostAliases(map[string]string{"github-work": "ghe.company.com"})
+	defer ghinstance.SetHostAliases(nil)
+
+	r, err := FromFullName("github-work:OWNER/REPO")
+	if err != nil {
+		t.Fatalf("got error %v", err)
+	}
+	if r.RepoHost() != "github-work" {
+		t.Errorf("expected host %q, got %q", "github-work", r.RepoHost())
+	}
+	if r.RepoOwner() != "OWNER" {
+		t.Errorf("expected owner %q, got %q", "OWNER", r.RepoOwner())
+	}
+	if r.RepoName() != "REPO" {
+		t.Errorf("expected name %q, got %q", "REPO", r.RepoName())
+	}
+
+	if url := FormatRemoteURL(r, "ssh"); url != "git@ghe.company.com:OWNER/REPO.git" {
+		t.Errorf("expected remote URL %q, got %q", "git@ghe.company.com:OWNER/REPO.git", url)
+	}
+	if !IsSame(r, NewWithHost("OWNER", "REPO", "ghe.company.com")) {
+		t.Errorf("expected %q to be the same repository as its aliased host equivalent", FullName(r))
+	}
+}
@@ -0,0 +1,87 @@
+// Package i18n provides a translation layer for user-facing strings.
+// Messages are looked up in a per-language catalog keyed by their English
+// text; when no translation exists, the English text is used as-is, so
+// untranslated output is always identical to the default.
+//
+// Catalogs are JSON files embedded from the catalogs directory, named after
+// the ISO 639-1 code of the language they translate into. Adding a language
+// means adding a catalog file; no code changes are needed.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+//go:embed catalogs/*.json
+var catalogFS embed.FS
+
+var (
+	catalogMu sync.Mutex
+	catalogs  = map[string]map[string]string{}
+)
+
+var currentLanguage = DetectLanguage()
+
+// DetectLanguage determines the preferred language from the environment,
+// honoring GH_LANG over the conventional LC_ALL and LANG variables.
+func DetectLanguage() string {
+	for _, name := range []string{"GH_LANG", "LC_ALL", "LANG"} {
+		if value := os.Getenv(name); value != "" {
+			return normalizeLanguage(value)
+		}
+	}
+	return ""
+}
+
+// SetLanguage overrides the detected language; it primarily exists for tests.
+func SetLanguage(lang string) {
+	currentLanguage = normalizeLanguage(lang)
+}
+
+// normalizeLanguage reduces a locale name such as "es_ES.UTF-8" to its
+// language code.
+func normalizeLanguage(locale string) string {
+	lang := locale
+	if idx := strings.IndexAny(lang, "_."); idx >= 0 {
+		lang = lang[:idx]
+	}
+	return strings.ToLower(lang)
+}
+
+// loadCatalog parses the embedded catalog for lang, caching the result. A
+// missing or malformed catalog yields an empty one, so every lookup falls
+// back to the English source text.
+func loadCatalog(lang string) map[string]string {
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+	if catalog, loaded := catalogs[lang]; loaded {
+		return catalog
+	}
+	catalog := map[string]string{}
+	if data, err := catalogFS.ReadFile("catalogs/" + lang + ".json"); err == nil {
+		_ = json.Unmarshal(data, &catalog)
+	}
+	catalogs[lang] = catalog
+	return catalog
+}
+
+// T translates a message into the current language, falling back to the
+// English text when no translation is available. Any extra arguments are
+// interpolated with the fmt package.
+func T(message string, args ...interface{}) string {
+	translated := message
+	if currentLanguage != "" {
+		if t, found := loadCatalog(currentLanguage)[message]; found {
+			translated = t
+		}
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(translated, args...)
+	}
+	return translated
+}
@@ -0,0 +1,76 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestT(t *testing.T) {
+	tests := []struct {
+		name    string
+		lang    string
+		message string
+		args    []interface{}
+		want    string
+	}{
+		{
+			name:    "no language detected",
+			lang:    "",
+			message: "Manage issues",
+			want:    "Manage issues",
+		},
+		{
+			name:    "translated message",
+			lang:    "es",
+			message: "Manage issues",
+			want:    "Administra issues",
+		},
+		{
+			name:    "locale with region and encoding",
+			lang:    "es_ES.UTF-8",
+			message: "Manage pull requests",
+			want:    "Administra pull requests",
+		},
+		{
+			name:    "untranslated message passes through",
+			lang:    "es",
+			message: "Manage gists",
+			want:    "Manage gists",
+		},
+		{
+			name:    "unknown language passes through",
+			lang:    "fr",
+			message: "Manage issues",
+			want:    "Manage issues",
+		},
+		{
+			name:    "interpolates arguments",
+			lang:    "",
+			message: "Deleted %d items",
+			args:    []interface{}{3},
+			want:    "Deleted 3 items",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetLanguage(tt.lang)
+			defer SetLanguage("")
+			assert.Equal(t, tt.want, T(tt.message, tt.args...))
+		})
+	}
+}
+
+func TestDetectLanguage(t *testing.T) {
+	t.Setenv("GH_LANG", "")
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LANG", "en_US.UTF-8")
+	assert.Equal(t, "en", DetectLanguage())
+
+	t.Setenv("LC_ALL", "de_DE.UTF-8")
+	assert.Equal(t, "de", DetectLanguage())
+
+	t.Setenv("GH_LANG", "es")
+	assert.Equal(t, "es", DetectLanguage())
+}
@@ -0,0 +1,125 @@
+// Package telemetry records local, opt-in statistics about command usage.
+// Entries are appended to a file under the state directory and never leave
+// the machine; the `gh stats` command summarizes them.
+package telemetry
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const fileName = "telemetry.jsonl"
+
+// Entry describes a single command invocation.
+type Entry struct {
+	Command    string    `json:"command"`
+	Timestamp  time.Time `json:"timestamp"`
+	DurationMS int64     `json:"duration_ms"`
+	Result     string    `json:"result"`
+}
+
+// Record appends an entry for a finished command invocation to the telemetry
+// file in stateDir. Failures to record are reported but should never abort
+// the command that was being measured.
+func Record(stateDir, command string, duration time.Duration, result string) error {
+	if command == "" {
+		return nil
+	}
+
+	entry := Entry{
+		Command:    command,
+		Timestamp:  time.Now(),
+		DurationMS: duration.Milliseconds(),
+		Result:     result,
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(stateDir, 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(filepath.Join(stateDir, fileName), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+// Read loads all recorded entries from stateDir. A missing telemetry file
+// yields no entries and no error; malformed lines are skipped.
+func Read(stateDir string) ([]Entry, error) {
+	f, err := os.Open(filepath.Join(stateDir, fileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+// CommandStats aggregates the entries recorded for a single command.
+type CommandStats struct {
+	Command       string
+	Runs          int
+	Errors        int
+	TotalDuration time.Duration
+}
+
+// AverageDuration returns the mean wall-clock time per invocation.
+func (s CommandStats) AverageDuration() time.Duration {
+	if s.Runs == 0 {
+		return 0
+	}
+	return s.TotalDuration / time.Duration(s.Runs)
+}
+
+// Summarize groups entries by command, ordered by run count with the most
+// frequently used commands first.
+func Summarize(entries []Entry) []CommandStats {
+	byCommand := map[string]*CommandStats{}
+	for _, entry := range entries {
+		stats := byCommand[entry.Command]
+		if stats == nil {
+			stats = &CommandStats{Command: entry.Command}
+			byCommand[entry.Command] = stats
+		}
+		stats.Runs++
+		stats.TotalDuration += time.Duration(entry.DurationMS) * time.Millisecond
+		if entry.Result != "ok" {
+			stats.Errors++
+		}
+	}
+
+	summary := make([]CommandStats, 0, len(byCommand))
+	for _, stats := range byCommand {
+		summary = append(summary, *stats)
+	}
+	sort.Slice(summary, func(i, j int) bool {
+		if summary[i].Runs != summary[j].Runs {
+			return summary[i].Runs > summary[j].Runs
+		}
+		return summary[i].Command < summary[j].Command
+	})
+	return summary
+}
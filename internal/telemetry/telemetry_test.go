@@ -0,0 +1,53 @@
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndRead(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, Record(dir, "pr list", 1200*time.Millisecond, "ok"))
+	require.NoError(t, Record(dir, "pr list", 800*time.Millisecond, "error"))
+	require.NoError(t, Record(dir, "issue view", 300*time.Millisecond, "ok"))
+	require.NoError(t, Record(dir, "", time.Second, "ok"))
+
+	entries, err := Read(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "pr list", entries[0].Command)
+	assert.Equal(t, int64(1200), entries[0].DurationMS)
+	assert.Equal(t, "error", entries[1].Result)
+}
+
+func TestRead_missingFile(t *testing.T) {
+	entries, err := Read(t.TempDir())
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestSummarize(t *testing.T) {
+	entries := []Entry{
+		{Command: "pr list", DurationMS: 1000, Result: "ok"},
+		{Command: "pr list", DurationMS: 2000, Result: "error"},
+		{Command: "api", DurationMS: 100, Result: "ok"},
+		{Command: "issue view", DurationMS: 400, Result: "cancel"},
+	}
+
+	summary := Summarize(entries)
+	require.Len(t, summary, 3)
+
+	assert.Equal(t, "pr list", summary[0].Command)
+	assert.Equal(t, 2, summary[0].Runs)
+	assert.Equal(t, 1, summary[0].Errors)
+	assert.Equal(t, 1500*time.Millisecond, summary[0].AverageDuration())
+
+	// ties on run count break alphabetically
+	assert.Equal(t, "api", summary[1].Command)
+	assert.Equal(t, "issue view", summary[2].Command)
+	assert.Equal(t, 1, summary[2].Errors)
+}
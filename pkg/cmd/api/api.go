@@ -26,6 +26,7 @@ import (
 	"github.com/cli/cli/v2/pkg/export"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/pkg/jsoncolor"
+	"github.com/cli/cli/v2/pkg/pagination"
 	"github.com/spf13/cobra"
 )
 
@@ -47,6 +48,8 @@ type ApiOptions struct {
 	Template            string
 	CacheTTL            time.Duration
 	FilterOutput        string
+	IdempotencyKey      string
+	DryRun              bool
 
 	Config     func() (config.Config, error)
 	HttpClient func() (*http.Client, error)
@@ -79,6 +82,12 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 			any value that contains "{...}" in quotes to prevent the shell from
 			applying special meaning to curly braces.
 
+			The "{hostname}" placeholder gets replaced with the hostname targeted by
+			the request: the value of %[1]s--hostname%[1]s if given, or the default
+			authenticated host otherwise. This also works inside full URLs, so the
+			same invocation can be pointed at a GitHub Enterprise instance; the
+			matching authentication token is resolved from the configuration per host.
+
 			The default HTTP request method is "GET" normally and "POST" if any parameters
 			were added. Override the method with %[1]s--method%[1]s.
 
@@ -108,6 +117,13 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 			there are no more pages of results. For GraphQL requests, this requires that the
 			original query accepts an %[1]s$endCursor: String%[1]s variable and that it fetches the
 			%[1]spageInfo{ hasNextPage, endCursor }%[1]s set of fields from a collection.
+
+			With %[1]s--dry-run%[1]s, the fully resolved request method, URL, headers, and body are
+			printed instead of sending the request. Values of sensitive headers are redacted.
+
+			The %[1]s--idempotency-key%[1]s option adds an "Idempotency-Key" header to a POST or PATCH
+			request and retries it on network errors. This is safe as long as the server
+			deduplicates requests by that key.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# list releases in the current repository
@@ -195,6 +211,22 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 				return err
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"the `--idempotency-key` option is not supported with `--input`",
+				opts.IdempotencyKey != "",
+				opts.RequestInputFile != "",
+			); err != nil {
+				return err
+			}
+
+			if err := cmdutil.MutuallyExclusive(
+				"the `--dry-run` option is not supported with `--paginate`",
+				opts.DryRun,
+				opts.Paginate,
+			); err != nil {
+				return err
+			}
+
 			if err := cmdutil.MutuallyExclusive(
 				"only one of `--template`, `--jq`, or `--silent` may be used",
 				opts.Silent,
@@ -224,6 +256,8 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 	cmd.Flags().StringVarP(&opts.Template, "template", "t", "", "Format the response using a Go template")
 	cmd.Flags().StringVarP(&opts.FilterOutput, "jq", "q", "", "Query to select values from the response using jq syntax")
 	cmd.Flags().DurationVar(&opts.CacheTTL, "cache", 0, "Cache the response, e.g. \"3600s\", \"60m\", \"1h\"")
+	cmd.Flags().StringVar(&opts.IdempotencyKey, "idempotency-key", "", "Send the `key` in an \"Idempotency-Key\" header and retry POST/PATCH requests on network errors")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print the resolved request instead of sending it")
 	return cmd
 }
 
@@ -246,6 +280,13 @@ func apiRun(opts *ApiOptions) error {
 		method = "POST"
 	}
 
+	if opts.IdempotencyKey != "" {
+		if !strings.EqualFold(method, "POST") && !strings.EqualFold(method, "PATCH") {
+			return fmt.Errorf("the `--idempotency-key` option is only supported for POST and PATCH requests")
+		}
+		requestHeaders = append(requestHeaders, "Idempotency-Key: "+opts.IdempotencyKey)
+	}
+
 	if opts.Paginate && !isGraphQL {
 		requestPath = addPerPage(requestPath, 100, params)
 	}
@@ -286,18 +327,17 @@ func apiRun(opts *ApiOptions) error {
 		defer opts.IO.StopPager()
 	}
 
-	cfg, err := opts.Config()
-	if err != nil {
-		return err
-	}
-
-	host, err := cfg.DefaultHost()
+	host, err := requestHost(opts)
 	if err != nil {
 		return err
 	}
 
-	if opts.Hostname != "" {
-		host = opts.Hostname
+	if opts.DryRun {
+		req, err := buildRequest(host, method, requestPath, requestBody, requestHeaders)
+		if err != nil {
+			return err
+		}
+		return printRequestDryRun(opts.IO.Out, req, opts.IO.ColorEnabled())
 	}
 
 	template := export.NewTemplate(opts.IO, opts.Template)
@@ -305,6 +345,10 @@ func apiRun(opts *ApiOptions) error {
 	hasNextPage := true
 	for hasNextPage {
 		resp, err := httpRequest(httpClient, host, method, requestPath, requestBody, requestHeaders)
+		for attempt := 1; err != nil && opts.IdempotencyKey != "" && attempt < maxIdempotentAttempts; attempt++ {
+			fmt.Fprintf(opts.IO.ErrOut, "gh: request failed: %v; retrying\n", err)
+			resp, err = httpRequest(httpClient, host, method, requestPath, requestBody, requestHeaders)
+		}
 		if err != nil {
 			return err
 		}
@@ -324,7 +368,7 @@ func apiRun(opts *ApiOptions) error {
 				params["endCursor"] = endCursor
 			}
 		} else {
-			requestPath, hasNextPage = findNextPage(resp)
+			requestPath, hasNextPage = pagination.FindNextPage(resp)
 			requestBody = nil // prevent repeating GET parameters
 		}
 
@@ -336,6 +380,36 @@ func apiRun(opts *ApiOptions) error {
 	return template.End()
 }
 
+// maxIdempotentAttempts caps how many times a request carrying an
+// Idempotency-Key header is sent when the transport reports an error.
+const maxIdempotentAttempts = 3
+
+// printRequestDryRun prints the fully resolved request without sending it.
+// Values of sensitive headers are redacted.
+func printRequestDryRun(w io.Writer, req *http.Request, colorize bool) error {
+	fmt.Fprintf(w, "%s %s\n", req.Method, req.URL)
+
+	headers := make(http.Header, len(req.Header))
+	for name, values := range req.Header {
+		if strings.EqualFold(name, "Authorization") {
+			values = []string{"************"}
+		}
+		headers[name] = values
+	}
+	printHeaders(w, headers, colorize)
+
+	if req.Body == nil {
+		return nil
+	}
+	defer req.Body.Close()
+	fmt.Fprint(w, "\r\n")
+	if _, err := io.Copy(w, req.Body); err != nil {
+		return err
+	}
+	fmt.Fprint(w, "\n")
+	return nil
+}
+
 func processResponse(resp *http.Response, opts *ApiOptions, headersOutputStream io.Writer, template *export.Template) (endCursor string, err error) {
 	if opts.ShowResponseHeaders {
 		fmt.Fprintln(headersOutputStream, resp.Proto, resp.Status)
@@ -407,12 +481,25 @@ func processResponse(resp *http.Response, opts *ApiOptions, headersOutputStream
 	}
 
 	if isGraphQLPaginate {
-		endCursor = findEndCursor(bodyCopy)
+		endCursor = pagination.FindEndCursor(bodyCopy)
 	}
 
 	return
 }
 
+// requestHost returns the hostname targeted by the request: the value of
+// `--hostname` if given, the default authenticated host otherwise.
+func requestHost(opts *ApiOptions) (string, error) {
+	if opts.Hostname != "" {
+		return opts.Hostname, nil
+	}
+	cfg, err := opts.Config()
+	if err != nil {
+		return "", err
+	}
+	return cfg.DefaultHost()
+}
+
 var placeholderRE = regexp.MustCompile(`(\:(owner|repo|branch)\b|\{[a-z]+\})`)
 
 // fillPlaceholders replaces placeholders with values from the current repository
@@ -445,6 +532,12 @@ func fillPlaceholders(value string, opts *ApiOptions) (string, error) {
 			} else {
 				err = e
 			}
+		case "hostname":
+			if host, e := requestHost(opts); e == nil {
+				return host
+			} else {
+				err = e
+			}
 		}
 		return m
 	}), err
@@ -315,6 +315,16 @@ func Test_NewCmdApi(t *testing.T) {
 			cli:      "user --jq .foo -t '{{.foo}}'",
 			wantsErr: true,
 		},
+		{
+			name:     "--idempotency-key with --input",
+			cli:      "user --idempotency-key abc --input foo.json",
+			wantsErr: true,
+		},
+		{
+			name:     "--dry-run with --paginate",
+			cli:      "user --dry-run --paginate",
+			wantsErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -889,6 +899,95 @@ func Test_apiRun_cache(t *testing.T) {
 	assert.Equal(t, "", stderr.String(), "stderr")
 }
 
+func Test_apiRun_dryRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+
+	options := ApiOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			var tr roundTripper = func(req *http.Request) (*http.Response, error) {
+				t.Fatal("unexpected HTTP request")
+				return nil, nil
+			}
+			return &http.Client{Transport: tr}, nil
+		},
+
+		RequestMethod:       "POST",
+		RequestMethodPassed: true,
+		RequestPath:         "repos/octocat/spoon-knife/issues",
+		RawFields:           []string{"title=hello"},
+		RequestHeaders:      []string{"Authorization: token SECRET", "X-Foo: bar"},
+		DryRun:              true,
+	}
+
+	err := apiRun(&options)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "POST https://api.github.com/repos/octocat/spoon-knife/issues\n"+
+		"Authorization: ************\r\n"+
+		"Content-Type: application/json; charset=utf-8\r\n"+
+		"X-Foo: bar\r\n"+
+		"\r\n"+
+		"{\"title\":\"hello\"}\n", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
+func Test_apiRun_idempotencyRetry(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+
+	requestCount := 0
+	options := ApiOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			var tr roundTripper = func(req *http.Request) (*http.Response, error) {
+				requestCount++
+				if requestCount == 1 {
+					return nil, fmt.Errorf("connection reset")
+				}
+				assert.Equal(t, "KEY-123", req.Header.Get("Idempotency-Key"))
+				return &http.Response{
+					StatusCode: 201,
+					Request:    req,
+					Body:       ioutil.NopCloser(bytes.NewBufferString(`{}`)),
+				}, nil
+			}
+			return &http.Client{Transport: tr}, nil
+		},
+
+		RequestMethod:       "POST",
+		RequestMethodPassed: true,
+		RequestPath:         "repos/octocat/spoon-knife/issues",
+		IdempotencyKey:      "KEY-123",
+	}
+
+	err := apiRun(&options)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, requestCount)
+	assert.Equal(t, `{}`, stdout.String())
+	assert.Contains(t, stderr.String(), "retrying")
+}
+
+func Test_apiRun_idempotencyKeyMethod(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+
+	options := ApiOptions{
+		IO:             io,
+		RequestMethod:  "GET",
+		RequestPath:    "user",
+		IdempotencyKey: "KEY-123",
+	}
+
+	err := apiRun(&options)
+	assert.EqualError(t, err, "the `--idempotency-key` option is only supported for POST and PATCH requests")
+}
+
 func Test_parseFields(t *testing.T) {
 	io, stdin, _, _ := iostreams.Test()
 	fmt.Fprint(stdin, "pasted contents")
@@ -1180,6 +1279,34 @@ func Test_fillPlaceholders(t *testing.T) {
 			want:    "{branch}-hubot",
 			wantErr: true,
 		},
+		{
+			name: "hostname placeholder with --hostname",
+			args: args{
+				value: "https://{hostname}/api/v3/repos/{owner}/{repo}",
+				opts: &ApiOptions{
+					Hostname: "ghe.io",
+					BaseRepo: func() (ghrepo.Interface, error) {
+						return ghrepo.New("hubot", "robot-uprising"), nil
+					},
+				},
+			},
+			want:    "https://ghe.io/api/v3/repos/hubot/robot-uprising",
+			wantErr: false,
+		},
+		{
+			name: "hostname placeholder from config",
+			args: args{
+				value: "{hostname}/graphql",
+				opts: &ApiOptions{
+					BaseRepo: nil,
+					Config: func() (config.Config, error) {
+						return config.NewBlankConfig(), nil
+					},
+				},
+			},
+			want:    "github.com/graphql",
+			wantErr: false,
+		},
 		{
 			name: "no greedy substitutes (colon)",
 			args: args{
@@ -14,6 +14,14 @@ import (
 )
 
 func httpRequest(client *http.Client, hostname string, method string, p string, params interface{}, headers []string) (*http.Response, error) {
+	req, err := buildRequest(hostname, method, p, params, headers)
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(req)
+}
+
+func buildRequest(hostname string, method string, p string, params interface{}, headers []string) (*http.Request, error) {
 	isGraphQL := p == "graphql"
 	var requestURL string
 	if strings.Contains(p, "://") {
@@ -81,7 +89,7 @@ func httpRequest(client *http.Client, hostname string, method string, p string,
 		req.Header.Set("Content-Type", "application/json; charset=utf-8")
 	}
 
-	return client.Do(req)
+	return req, nil
 }
 
 func groupGraphQLVariables(params map[string]interface{}) map[string]interface{} {
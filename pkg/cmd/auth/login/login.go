@@ -9,6 +9,7 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/pkg/cmd/auth/shared"
@@ -27,10 +28,13 @@ type LoginOptions struct {
 
 	Interactive bool
 
-	Hostname string
-	Scopes   []string
-	Token    string
-	Web      bool
+	Hostname        string
+	Scopes          []string
+	Token           string
+	Web             bool
+	GitProtocol     string
+	SetupGit        bool
+	SkipSSHKeyCheck bool
 }
 
 func NewCmdLogin(f *cmdutil.Factory, runF func(*LoginOptions) error) *cobra.Command {
@@ -56,6 +60,10 @@ func NewCmdLogin(f *cmdutil.Factory, runF func(*LoginOptions) error) *cobra.Comm
 
 			The --scopes flag accepts a comma separated list of scopes you want your gh credentials to have. If
 			absent, this command ensures that gh has access to a minimum set of scopes.
+
+			When authenticating with %[1]s--with-token%[1]s, the %[1]s--git-protocol%[1]s and %[1]s--setup-git%[1]s
+			flags perform the same configuration steps that the interactive flow offers, which makes it
+			possible to fully configure gh from a provisioning script.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# start interactive setup
@@ -66,6 +74,9 @@ func NewCmdLogin(f *cmdutil.Factory, runF func(*LoginOptions) error) *cobra.Comm
 
 			# authenticate with a specific GitHub Enterprise Server instance
 			$ gh auth login --hostname enterprise.internal
+
+			# fully configure gh non-interactively
+			$ gh auth login --with-token --git-protocol ssh --skip-ssh-key-check --setup-git < mytoken.txt
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if tokenStdin && opts.Web {
@@ -75,6 +86,17 @@ func NewCmdLogin(f *cmdutil.Factory, runF func(*LoginOptions) error) *cobra.Comm
 				return cmdutil.FlagErrorf("specify only one of `--scopes` or `--with-token`")
 			}
 
+			if opts.GitProtocol != "" {
+				protocol := strings.ToLower(opts.GitProtocol)
+				if protocol != "https" && protocol != "ssh" {
+					return cmdutil.FlagErrorf("unsupported git protocol %q: valid values are 'https' or 'ssh'", opts.GitProtocol)
+				}
+				opts.GitProtocol = protocol
+			}
+			if opts.SkipSSHKeyCheck && opts.GitProtocol != "ssh" {
+				return cmdutil.FlagErrorf("`--skip-ssh-key-check` requires `--git-protocol ssh`")
+			}
+
 			if tokenStdin {
 				defer opts.IO.In.Close()
 				token, err := ioutil.ReadAll(opts.IO.In)
@@ -111,6 +133,9 @@ func NewCmdLogin(f *cmdutil.Factory, runF func(*LoginOptions) error) *cobra.Comm
 	cmd.Flags().StringSliceVarP(&opts.Scopes, "scopes", "s", nil, "Additional authentication scopes for gh to have")
 	cmd.Flags().BoolVar(&tokenStdin, "with-token", false, "Read token from standard input")
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open a browser to authenticate")
+	cmd.Flags().StringVarP(&opts.GitProtocol, "git-protocol", "p", "", "The protocol to use for git operations: {ssh|https}")
+	cmd.Flags().BoolVar(&opts.SetupGit, "setup-git", false, "Configure git to use GitHub CLI as a credential helper")
+	cmd.Flags().BoolVar(&opts.SkipSSHKeyCheck, "skip-ssh-key-check", false, "Skip verifying SSH connectivity to the host")
 
 	return cmd
 }
@@ -155,7 +180,61 @@ func loginRun(opts *LoginOptions) error {
 			return fmt.Errorf("error validating token: %w", err)
 		}
 
-		return cfg.Write()
+		if opts.GitProtocol == "" && !opts.SetupGit {
+			return cfg.Write()
+		}
+
+		// carry out the remaining configuration steps that the interactive flow offers
+		apiClient := api.NewClientFromHTTP(httpClient)
+		username, err := api.CurrentLoginName(apiClient, hostname)
+		if err != nil {
+			return fmt.Errorf("error using api: %w", err)
+		}
+		if err := cfg.Set(hostname, "user", username); err != nil {
+			return err
+		}
+
+		if opts.GitProtocol != "" {
+			if err := cfg.Set(hostname, "git_protocol", opts.GitProtocol); err != nil {
+				return err
+			}
+		}
+
+		if err := cfg.Write(); err != nil {
+			return err
+		}
+
+		cs := opts.IO.ColorScheme()
+
+		if opts.SetupGit {
+			credentialFlow := &shared.GitCredentialFlow{Executable: opts.MainExecutable}
+			if err := credentialFlow.ForceSetup(hostname); err != nil {
+				return err
+			}
+			if credentialFlow.ShouldSetup() {
+				if err := credentialFlow.Setup(hostname, username, opts.Token); err != nil {
+					return err
+				}
+			}
+		}
+
+		if opts.GitProtocol == "ssh" && !opts.SkipSSHKeyCheck {
+			if err := shared.VerifySSHConnection(hostname); err != nil {
+				fmt.Fprintf(opts.IO.ErrOut, "%s Could not verify SSH connectivity to %s: %v\n", cs.WarningIcon(), hostname, err)
+			}
+		}
+
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Logged in as %s\n", cs.SuccessIcon(), cs.Bold(username))
+		} else {
+			// key=value output that provisioning scripts can parse
+			fmt.Fprintf(opts.IO.Out, "host=%s\nuser=%s\n", hostname, username)
+			if opts.GitProtocol != "" {
+				fmt.Fprintf(opts.IO.Out, "git_protocol=%s\n", opts.GitProtocol)
+			}
+		}
+
+		return nil
 	}
 
 	existingToken, _ := cfg.Get(hostname, "oauth_token")
@@ -186,6 +265,7 @@ func loginRun(opts *LoginOptions) error {
 		Web:         opts.Web,
 		Scopes:      opts.Scopes,
 		Executable:  opts.MainExecutable,
+		GitProtocol: opts.GitProtocol,
 	})
 }
 
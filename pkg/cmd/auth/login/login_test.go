@@ -142,6 +142,38 @@ func Test_NewCmdLogin(t *testing.T) {
 			cli:      "--web --with-token",
 			wantsErr: true,
 		},
+		{
+			name:  "nontty, with-token, full non-interactive setup",
+			stdin: "abc123\n",
+			cli:   "--with-token --git-protocol ssh --skip-ssh-key-check --setup-git",
+			wants: LoginOptions{
+				Hostname:        "github.com",
+				Token:           "abc123",
+				GitProtocol:     "ssh",
+				SetupGit:        true,
+				SkipSSHKeyCheck: true,
+			},
+		},
+		{
+			name:  "git-protocol is normalized",
+			stdin: "abc123\n",
+			cli:   "--with-token --git-protocol SSH",
+			wants: LoginOptions{
+				Hostname:    "github.com",
+				Token:       "abc123",
+				GitProtocol: "ssh",
+			},
+		},
+		{
+			name:     "unsupported git protocol",
+			cli:      "--git-protocol ftp",
+			wantsErr: true,
+		},
+		{
+			name:     "skip-ssh-key-check without ssh protocol",
+			cli:      "--skip-ssh-key-check",
+			wantsErr: true,
+		},
 		{
 			name:     "tty one scope",
 			stdinTTY: true,
@@ -207,6 +239,9 @@ func Test_NewCmdLogin(t *testing.T) {
 			assert.Equal(t, tt.wants.Web, gotOpts.Web)
 			assert.Equal(t, tt.wants.Interactive, gotOpts.Interactive)
 			assert.Equal(t, tt.wants.Scopes, gotOpts.Scopes)
+			assert.Equal(t, tt.wants.GitProtocol, gotOpts.GitProtocol)
+			assert.Equal(t, tt.wants.SetupGit, gotOpts.SetupGit)
+			assert.Equal(t, tt.wants.SkipSSHKeyCheck, gotOpts.SkipSSHKeyCheck)
 		})
 	}
 }
@@ -216,9 +251,11 @@ func Test_loginRun_nontty(t *testing.T) {
 		name       string
 		opts       *LoginOptions
 		httpStubs  func(*httpmock.Registry)
+		runStubs   func(*run.CommandStubber)
 		env        map[string]string
 		wantHosts  string
 		wantErr    string
+		wantStdout string
 		wantStderr string
 	}{
 		{
@@ -276,6 +313,47 @@ func Test_loginRun_nontty(t *testing.T) {
 			},
 			wantHosts: "github.com:\n    oauth_token: abc456\n",
 		},
+		{
+			name: "with token and git-protocol ssh",
+			opts: &LoginOptions{
+				Hostname:        "github.com",
+				Token:           "abc123",
+				GitProtocol:     "ssh",
+				SkipSSHKeyCheck: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("GET", ""), httpmock.ScopesResponder("repo,read:org"))
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"jillv"}}}`))
+			},
+			wantHosts:  "github.com:\n    oauth_token: abc123\n    user: jillv\n    git_protocol: ssh\n",
+			wantStdout: "host=github.com\nuser=jillv\ngit_protocol=ssh\n",
+		},
+		{
+			name: "with token and setup-git",
+			opts: &LoginOptions{
+				Hostname: "github.com",
+				Token:    "abc123",
+				SetupGit: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("GET", ""), httpmock.ScopesResponder("repo,read:org"))
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"jillv"}}}`))
+			},
+			runStubs: func(rs *run.CommandStubber) {
+				rs.Register(`git config credential\.https:/`, 1, "")
+				rs.Register(`git config credential\.helper`, 1, "")
+				rs.Register(`git config --global --replace-all credential\.https://github\.com\.helper`, 0, "")
+				rs.Register(`git config --global --add credential\.https://github\.com\.helper`, 0, "")
+				rs.Register(`git config --global --replace-all credential\.https://gist\.github\.com\.helper`, 0, "")
+				rs.Register(`git config --global --add credential\.https://gist\.github\.com\.helper`, 0, "")
+			},
+			wantHosts:  "github.com:\n    oauth_token: abc123\n    user: jillv\n",
+			wantStdout: "host=github.com\nuser=jillv\n",
+		},
 		{
 			name: "github.com token from environment",
 			opts: &LoginOptions{
@@ -345,8 +423,11 @@ func Test_loginRun_nontty(t *testing.T) {
 				tt.httpStubs(reg)
 			}
 
-			_, restoreRun := run.Stub()
+			rs, restoreRun := run.Stub()
 			defer restoreRun(t)
+			if tt.runStubs != nil {
+				tt.runStubs(rs)
+			}
 
 			mainBuf := bytes.Buffer{}
 			hostsBuf := bytes.Buffer{}
@@ -359,7 +440,7 @@ func Test_loginRun_nontty(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
-			assert.Equal(t, "", stdout.String())
+			assert.Equal(t, tt.wantStdout, stdout.String())
 			assert.Equal(t, tt.wantStderr, stderr.String())
 			assert.Equal(t, tt.wantHosts, hostsBuf.String())
 			reg.Verify(t)
@@ -504,6 +585,9 @@ func Test_loginRun_Survey(t *testing.T) {
 				as.StubPrompt("How would you like to authenticate GitHub CLI?").AnswerWith("Paste an authentication token")
 				as.StubPrompt("Paste your authentication token:").AnswerWith("def456")
 			},
+			runStubs: func(rs *run.CommandStubber) {
+				rs.Register(`ssh -T`, 0, "")
+			},
 			wantErrOut: regexp.MustCompile("Tip: you can generate a Personal Access Token here https://github.com/settings/tokens"),
 		},
 		// TODO how to test browser auth?
@@ -4,10 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/authflow"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -21,6 +23,7 @@ type LogoutOptions struct {
 	Config     func() (config.Config, error)
 
 	Hostname string
+	All      bool
 }
 
 func NewCmdLogout(f *cmdutil.Factory, runF func(*LogoutOptions) error) *cobra.Command {
@@ -37,7 +40,10 @@ func NewCmdLogout(f *cmdutil.Factory, runF func(*LogoutOptions) error) *cobra.Co
 		Long: heredoc.Doc(`Remove authentication for a GitHub host.
 
 			This command removes the authentication configuration for a host either specified
-			interactively or via --hostname.
+			interactively or via --hostname, or for every host at once via --all.
+
+			Tokens that were created by gh's own OAuth flow are revoked on the host before
+			being removed from the configuration.
 		`),
 		Example: heredoc.Doc(`
 			$ gh auth logout
@@ -45,9 +51,15 @@ func NewCmdLogout(f *cmdutil.Factory, runF func(*LogoutOptions) error) *cobra.Co
 
 			$ gh auth logout --hostname enterprise.internal
 			# => log out of specified host
+
+			$ gh auth logout --all
+			# => log out of every host
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if opts.Hostname == "" && !opts.IO.CanPrompt() {
+			if opts.All && opts.Hostname != "" {
+				return cmdutil.FlagErrorf("specify only one of `--hostname` or `--all`")
+			}
+			if opts.Hostname == "" && !opts.All && !opts.IO.CanPrompt() {
 				return cmdutil.FlagErrorf("--hostname required when not running interactively")
 			}
 
@@ -60,13 +72,12 @@ func NewCmdLogout(f *cmdutil.Factory, runF func(*LogoutOptions) error) *cobra.Co
 	}
 
 	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "The hostname of the GitHub instance to log out of")
+	cmd.Flags().BoolVar(&opts.All, "all", false, "Log out of every logged-in host")
 
 	return cmd
 }
 
 func logoutRun(opts *LogoutOptions) error {
-	hostname := opts.Hostname
-
 	cfg, err := opts.Config()
 	if err != nil {
 		return err
@@ -80,41 +91,50 @@ func logoutRun(opts *LogoutOptions) error {
 		return fmt.Errorf("not logged in to any hosts")
 	}
 
-	if hostname == "" {
-		if len(candidates) == 1 {
-			hostname = candidates[0]
-		} else {
-			err = prompt.SurveyAskOne(&survey.Select{
-				Message: "What account do you want to log out of?",
-				Options: candidates,
-			}, &hostname)
-
-			if err != nil {
-				return fmt.Errorf("could not prompt: %w", err)
-			}
-		}
+	var hostnames []string
+	if opts.All {
+		hostnames = candidates
 	} else {
-		var found bool
-		for _, c := range candidates {
-			if c == hostname {
-				found = true
-				break
+		hostname := opts.Hostname
+		if hostname == "" {
+			if len(candidates) == 1 {
+				hostname = candidates[0]
+			} else {
+				err = prompt.SurveyAskOne(&survey.Select{
+					Message: "What account do you want to log out of?",
+					Options: candidates,
+				}, &hostname)
+
+				if err != nil {
+					return fmt.Errorf("could not prompt: %w", err)
+				}
+			}
+		} else {
+			var found bool
+			for _, c := range candidates {
+				if c == hostname {
+					found = true
+					break
+				}
 			}
-		}
 
-		if !found {
-			return fmt.Errorf("not logged into %s", hostname)
+			if !found {
+				return fmt.Errorf("not logged into %s", hostname)
+			}
 		}
+		hostnames = []string{hostname}
 	}
 
-	if err := cfg.CheckWriteable(hostname, "oauth_token"); err != nil {
-		var roErr *config.ReadOnlyEnvError
-		if errors.As(err, &roErr) {
-			fmt.Fprintf(opts.IO.ErrOut, "The value of the %s environment variable is being used for authentication.\n", roErr.Variable)
-			fmt.Fprint(opts.IO.ErrOut, "To erase credentials stored in GitHub CLI, first clear the value from the environment.\n")
-			return cmdutil.SilentError
+	for _, hostname := range hostnames {
+		if err := cfg.CheckWriteable(hostname, "oauth_token"); err != nil {
+			var roErr *config.ReadOnlyEnvError
+			if errors.As(err, &roErr) {
+				fmt.Fprintf(opts.IO.ErrOut, "The value of the %s environment variable is being used for authentication.\n", roErr.Variable)
+				fmt.Fprint(opts.IO.ErrOut, "To erase credentials stored in GitHub CLI, first clear the value from the environment.\n")
+				return cmdutil.SilentError
+			}
+			return err
 		}
-		return err
 	}
 
 	httpClient, err := opts.HttpClient()
@@ -123,22 +143,33 @@ func logoutRun(opts *LogoutOptions) error {
 	}
 	apiClient := api.NewClientFromHTTP(httpClient)
 
-	username, err := api.CurrentLoginName(apiClient, hostname)
-	if err != nil {
-		// suppressing; the user is trying to delete this token and it might be bad.
-		// we'll see if the username is in the config and fall back to that.
-		username, _ = cfg.Get(hostname, "user")
+	usernames := make(map[string]string, len(hostnames))
+	for _, hostname := range hostnames {
+		username, err := api.CurrentLoginName(apiClient, hostname)
+		if err != nil {
+			// suppressing; the user is trying to delete this token and it might be bad.
+			// we'll see if the username is in the config and fall back to that.
+			username, _ = cfg.Get(hostname, "user")
+		}
+		usernames[hostname] = username
 	}
 
-	usernameStr := ""
-	if username != "" {
-		usernameStr = fmt.Sprintf(" account '%s'", username)
+	usernameStr := func(hostname string) string {
+		if usernames[hostname] == "" {
+			return ""
+		}
+		return fmt.Sprintf(" account '%s'", usernames[hostname])
 	}
 
 	if opts.IO.CanPrompt() {
+		message := fmt.Sprintf("Are you sure you want to log out of %s%s?", hostnames[0], usernameStr(hostnames[0]))
+		if len(hostnames) > 1 {
+			message = fmt.Sprintf("Are you sure you want to log out of all %d hosts?", len(hostnames))
+		}
+
 		var keepGoing bool
 		err := prompt.SurveyAskOne(&survey.Confirm{
-			Message: fmt.Sprintf("Are you sure you want to log out of %s%s?", hostname, usernameStr),
+			Message: message,
 			Default: true,
 		}, &keepGoing)
 		if err != nil {
@@ -150,7 +181,17 @@ func logoutRun(opts *LogoutOptions) error {
 		}
 	}
 
-	cfg.UnsetHost(hostname)
+	cs := opts.IO.ColorScheme()
+	for _, hostname := range hostnames {
+		// only tokens minted by gh's own OAuth app can be revoked by it
+		if token, _ := cfg.Get(hostname, "oauth_token"); strings.HasPrefix(token, "gho_") {
+			if err := authflow.RevokeToken(httpClient, hostname, token); err != nil {
+				fmt.Fprintf(opts.IO.ErrOut, "%s Failed to revoke token for %s: %v\n", cs.WarningIcon(), hostname, err)
+			}
+		}
+		cfg.UnsetHost(hostname)
+	}
+
 	err = cfg.Write()
 	if err != nil {
 		return fmt.Errorf("failed to write config, authentication configuration not updated: %w", err)
@@ -159,9 +200,10 @@ func logoutRun(opts *LogoutOptions) error {
 	isTTY := opts.IO.IsStdinTTY() && opts.IO.IsStdoutTTY()
 
 	if isTTY {
-		cs := opts.IO.ColorScheme()
-		fmt.Fprintf(opts.IO.ErrOut, "%s Logged out of %s%s\n",
-			cs.SuccessIcon(), cs.Bold(hostname), usernameStr)
+		for _, hostname := range hostnames {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Logged out of %s%s\n",
+				cs.SuccessIcon(), cs.Bold(hostname), usernameStr(hostname))
+		}
 	}
 
 	return nil
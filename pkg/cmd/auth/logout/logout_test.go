@@ -51,6 +51,19 @@ func Test_NewCmdLogout(t *testing.T) {
 			cli:      "",
 			wantsErr: true,
 		},
+		{
+			name: "nontty all",
+			cli:  "--all",
+			wants: LogoutOptions{
+				All: true,
+			},
+		},
+		{
+			name:     "all with hostname",
+			tty:      true,
+			cli:      "--all --hostname harry.mason",
+			wantsErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -85,6 +98,7 @@ func Test_NewCmdLogout(t *testing.T) {
 			assert.NoError(t, err)
 
 			assert.Equal(t, tt.wants.Hostname, gotOpts.Hostname)
+			assert.Equal(t, tt.wants.All, gotOpts.All)
 		})
 
 	}
@@ -194,6 +208,42 @@ func Test_logoutRun_tty(t *testing.T) {
 	}
 }
 
+func Test_logoutRun_revokesToken(t *testing.T) {
+	io, _, _, stderr := iostreams.Test()
+
+	io.SetStdinTTY(false)
+	io.SetStdoutTTY(false)
+
+	cfg := config.NewBlankConfig()
+	_ = cfg.Set("github.com", "oauth_token", "gho_abc123")
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("DELETE", "applications/178c6fc778ccc68e1d6a/token"),
+		httpmock.StatusStringResponse(204, ""))
+
+	opts := &LogoutOptions{
+		IO:       io,
+		Hostname: "github.com",
+		Config: func() (config.Config, error) {
+			return cfg, nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+	}
+
+	mainBuf := bytes.Buffer{}
+	hostsBuf := bytes.Buffer{}
+	defer config.StubWriteConfig(&mainBuf, &hostsBuf)()
+
+	err := logoutRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, "", hostsBuf.String())
+}
+
 func Test_logoutRun_nontty(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -225,6 +275,13 @@ func Test_logoutRun_nontty(t *testing.T) {
 			},
 			wantErr: `not logged in to any hosts`,
 		},
+		{
+			name: "all, multiple hosts",
+			opts: &LogoutOptions{
+				All: true,
+			},
+			cfgHosts: []string{"harry.mason", "cheryl.mason"},
+		},
 	}
 
 	for _, tt := range tests {
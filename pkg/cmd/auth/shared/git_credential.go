@@ -49,6 +49,24 @@ func (flow *GitCredentialFlow) Prompt(hostname string) error {
 	return nil
 }
 
+// ForceSetup is the non-interactive counterpart of Prompt: it marks the flow
+// for credential helper configuration without asking the user.
+func (flow *GitCredentialFlow) ForceSetup(hostname string) error {
+	var gitErr error
+	flow.helper, gitErr = gitCredentialHelper(hostname)
+	if isOurCredentialHelper(flow.helper) {
+		flow.scopes = append(flow.scopes, "workflow")
+		return nil
+	}
+	if isGitMissing(gitErr) {
+		return gitErr
+	}
+
+	flow.shouldSetup = true
+	flow.scopes = append(flow.scopes, "workflow")
+	return nil
+}
+
 func (flow *GitCredentialFlow) Scopes() []string {
 	return flow.scopes
 }
@@ -29,6 +29,7 @@ type LoginOptions struct {
 	Web         bool
 	Scopes      []string
 	Executable  string
+	GitProtocol string
 
 	sshContext sshContext
 }
@@ -39,8 +40,14 @@ func Login(opts *LoginOptions) error {
 	httpClient := opts.HTTPClient
 	cs := opts.IO.ColorScheme()
 
-	var gitProtocol string
-	if opts.Interactive {
+	gitProtocol := strings.ToLower(opts.GitProtocol)
+	if opts.Interactive && gitProtocol == "" {
+		// suggest SSH when the user already has local keys for it
+		defaultProto := "HTTPS"
+		if pubKeys, err := opts.sshContext.localPublicKeys(); err == nil && len(pubKeys) > 0 {
+			defaultProto = "SSH"
+		}
+
 		var proto string
 		err := prompt.SurveyAskOne(&survey.Select{
 			Message: "What is your preferred protocol for Git operations?",
@@ -48,6 +55,7 @@ func Login(opts *LoginOptions) error {
 				"HTTPS",
 				"SSH",
 			},
+			Default: defaultProto,
 		}, &proto)
 		if err != nil {
 			return fmt.Errorf("could not prompt: %w", err)
@@ -192,6 +200,14 @@ func Login(opts *LoginOptions) error {
 		fmt.Fprintf(opts.IO.ErrOut, "%s Uploaded the SSH key to your GitHub account: %s\n", cs.SuccessIcon(), cs.Bold(keyToUpload))
 	}
 
+	if opts.Interactive && gitProtocol == "ssh" {
+		if err := opts.sshContext.verifyConnection(hostname); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Could not verify SSH connectivity to %s: %v\n", cs.WarningIcon(), hostname, err)
+		} else {
+			fmt.Fprintf(opts.IO.ErrOut, "%s SSH connectivity to %s verified\n", cs.SuccessIcon(), hostname)
+		}
+	}
+
 	fmt.Fprintf(opts.IO.ErrOut, "%s Logged in as %s\n", cs.SuccessIcon(), cs.Bold(username))
 	return nil
 }
@@ -70,6 +70,7 @@ func TestLogin_ssh(t *testing.T) {
 		// simulate that the public key file has been generated
 		_ = ioutil.WriteFile(keyFile+".pub", []byte("PUBKEY"), 0600)
 	})
+	rs.Register(`ssh -T`, 0, "")
 
 	cfg := tinyConfig{}
 
@@ -93,6 +94,7 @@ func TestLogin_ssh(t *testing.T) {
 		- gh config set -h example.com git_protocol ssh
 		✓ Configured git protocol
 		✓ Uploaded the SSH key to your GitHub account: %s.pub
+		✓ SSH connectivity to example.com verified
 		✓ Logged in as monalisa
 	`, keyFile), stderr.String())
 
@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -108,6 +109,30 @@ func (c *sshContext) generateSSHKey() (string, error) {
 	return keyFile + ".pub", run.PrepareCmd(keygenCmd).Run()
 }
 
+// verifyConnection makes sure the user can authenticate over SSH to the given
+// host; the remote shell request is expected to be refused even on success
+func (c *sshContext) verifyConnection(hostname string) error {
+	sshExe, err := safeexec.LookPath("ssh")
+	if err != nil {
+		return err
+	}
+
+	sshCmd := exec.Command(sshExe, "-T", "-o", "BatchMode=yes", "-o", "StrictHostKeyChecking=accept-new", "git@"+hostname)
+	err = run.PrepareCmd(sshCmd).Run()
+	var execError *exec.ExitError
+	if errors.As(err, &execError) && execError.ExitCode() == 255 {
+		return fmt.Errorf("ssh connection to %s failed", hostname)
+	}
+	return nil
+}
+
+// VerifySSHConnection makes sure the user can authenticate over SSH to the
+// given host
+func VerifySSHConnection(hostname string) error {
+	var ctx sshContext
+	return ctx.verifyConnection(hostname)
+}
+
 func sshKeyUpload(httpClient *http.Client, hostname, keyFile string) error {
 	f, err := os.Open(keyFile)
 	if err != nil {
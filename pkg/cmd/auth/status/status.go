@@ -21,6 +21,38 @@ type StatusOptions struct {
 
 	Hostname  string
 	ShowToken bool
+
+	Exporter cmdutil.Exporter
+}
+
+type hostStatus struct {
+	Hostname    string
+	Username    string
+	Scopes      string
+	TokenSource string
+	Token       string
+	Valid       bool
+}
+
+func (h hostStatus) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "hostname":
+			data[f] = h.Hostname
+		case "username":
+			data[f] = h.Username
+		case "scopes":
+			data[f] = h.Scopes
+		case "tokenSource":
+			data[f] = h.TokenSource
+		case "token":
+			data[f] = h.Token
+		case "valid":
+			data[f] = h.Valid
+		}
+	}
+	return data
 }
 
 func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Command {
@@ -49,7 +81,8 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 	}
 
 	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "Check a specific hostname's auth status")
-	cmd.Flags().BoolVarP(&opts.ShowToken, "show-token", "t", false, "Display the auth token")
+	cmd.Flags().BoolVar(&opts.ShowToken, "show-token", false, "Display the auth token")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, []string{"hostname", "username", "scopes", "tokenSource", "token", "valid"})
 
 	return cmd
 }
@@ -85,6 +118,7 @@ func statusRun(opts *StatusOptions) error {
 
 	var failed bool
 	var isHostnameFound bool
+	var statuses []hostStatus
 
 	for _, hostname := range hostnames {
 		if opts.Hostname != "" && opts.Hostname != hostname {
@@ -95,6 +129,24 @@ func statusRun(opts *StatusOptions) error {
 		token, tokenSource, _ := cfg.GetWithSource(hostname, "oauth_token")
 		tokenIsWriteable := cfg.CheckWriteable(hostname, "oauth_token") == nil
 
+		if opts.Exporter != nil {
+			status := hostStatus{Hostname: hostname, TokenSource: tokenSource}
+			status.Token = "*******************"
+			if opts.ShowToken {
+				status.Token = token
+			}
+			if scopes, err := shared.GetScopes(httpClient, hostname, token); err == nil {
+				status.Scopes = scopes
+			}
+			if err := shared.HasMinimumScopes(httpClient, hostname, token); err == nil {
+				status.Valid = true
+				apiClient := api.NewClientFromHTTP(httpClient)
+				status.Username, _ = api.CurrentLoginName(apiClient, hostname)
+			}
+			statuses = append(statuses, status)
+			continue
+		}
+
 		statusInfo[hostname] = []string{}
 		addMsg := func(x string, ys ...interface{}) {
 			statusInfo[hostname] = append(statusInfo[hostname], fmt.Sprintf(x, ys...))
@@ -150,6 +202,10 @@ func statusRun(opts *StatusOptions) error {
 		return cmdutil.SilentError
 	}
 
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, statuses)
+	}
+
 	for _, hostname := range hostnames {
 		lines, ok := statusInfo[hostname]
 		if !ok {
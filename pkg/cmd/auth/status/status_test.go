@@ -2,6 +2,7 @@ package status
 
 import (
 	"bytes"
+	"fmt"
 	"net/http"
 	"regexp"
 	"testing"
@@ -257,3 +258,44 @@ func Test_statusRun(t *testing.T) {
 		})
 	}
 }
+
+type testExporter struct{}
+
+func (e *testExporter) Fields() []string { return nil }
+
+func (e *testExporter) Write(io *iostreams.IOStreams, data interface{}) error {
+	for _, s := range data.([]hostStatus) {
+		fmt.Fprintf(io.Out, "%s: %s (%s)\n", s.Hostname, s.Username, s.Scopes)
+	}
+	return nil
+}
+
+func Test_statusRun_exporter(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+
+	cfg := config.NewBlankConfig()
+	_ = cfg.Set("github.com", "oauth_token", "abc123")
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(httpmock.REST("GET", ""), httpmock.ScopesResponder("repo,read:org"))
+	reg.Register(httpmock.REST("GET", ""), httpmock.ScopesResponder("repo,read:org"))
+	reg.Register(
+		httpmock.GraphQL(`query UserCurrent\b`),
+		httpmock.StringResponse(`{"data":{"viewer":{"login":"tess"}}}`))
+
+	opts := &StatusOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return cfg, nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Exporter: &testExporter{},
+	}
+
+	err := statusRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "github.com: tess (repo,read:org)\n", stdout.String())
+}
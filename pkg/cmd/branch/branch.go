@@ -0,0 +1,23 @@
+package branch
+
+import (
+	cmdDelete "github.com/cli/cli/v2/pkg/cmd/branch/delete"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/branch/list"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdBranch(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "branch <command>",
+		Short: "Manage branches",
+		Long:  "Work with branches in a GitHub repository.",
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,110 @@
+package delete
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+type DeleteOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Branches  []string
+	Confirmed bool
+}
+
+func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <branch>...",
+		Short: "Delete branches",
+		Long:  "Delete one or more branches from the remote repository and, when present, the local clone.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.Branches = args
+
+			if !opts.IO.CanPrompt() && !opts.Confirmed {
+				return cmdutil.FlagErrorf("--confirm required when not running interactively")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Confirmed, "confirm", false, "confirm deletion without prompting")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	if !opts.Confirmed {
+		branchLabel := fmt.Sprintf("%d branches", len(opts.Branches))
+		if len(opts.Branches) == 1 {
+			branchLabel = fmt.Sprintf("branch %s", opts.Branches[0])
+		}
+		var confirmed bool
+		err := prompt.SurveyAskOne(&survey.Confirm{
+			Message: fmt.Sprintf("Delete %s from %s?", branchLabel, ghrepo.FullName(baseRepo)),
+			Default: false,
+		}, &confirmed)
+		if err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+	}
+
+	cs := opts.IO.ColorScheme()
+	for _, branch := range opts.Branches {
+		path := fmt.Sprintf("repos/%s/git/refs/heads/%s", ghrepo.FullName(baseRepo), branch)
+		if err := apiClient.REST(baseRepo.RepoHost(), "DELETE", path, nil, nil); err != nil {
+			return fmt.Errorf("failed to delete branch %s: %w", branch, err)
+		}
+
+		localNote := ""
+		if git.HasLocalBranch(branch) {
+			if err := git.DeleteLocalBranch(branch); err != nil {
+				return fmt.Errorf("failed to delete local branch %s: %w", branch, err)
+			}
+			localNote = " and local branch"
+		}
+
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Deleted branch %s%s\n", cs.SuccessIconWithColor(cs.Red), cs.Cyan(branch), localNote)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package delete
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     string
+		isTTY    bool
+		want     DeleteOptions
+		wantsErr bool
+	}{
+		{
+			name:  "one branch",
+			args:  "feature",
+			isTTY: true,
+			want: DeleteOptions{
+				Branches: []string{"feature"},
+			},
+		},
+		{
+			name:  "multiple branches with confirm",
+			args:  "feature old-work --confirm",
+			isTTY: true,
+			want: DeleteOptions{
+				Branches:  []string{"feature", "old-work"},
+				Confirmed: true,
+			},
+		},
+		{
+			name:     "no arguments",
+			args:     "",
+			isTTY:    true,
+			wantsErr: true,
+		},
+		{
+			name:     "nontty without confirm",
+			args:     "feature",
+			isTTY:    false,
+			wantsErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStdoutTTY(tt.isTTY)
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var gotOpts *DeleteOptions
+			cmd := NewCmdDelete(f, func(opts *DeleteOptions) error {
+				gotOpts = opts
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+			cmd.SetIn(nil)
+			cmd.SetOut(nil)
+			cmd.SetErr(nil)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Branches, gotOpts.Branches)
+			assert.Equal(t, tt.want.Confirmed, gotOpts.Confirmed)
+		})
+	}
+}
+
+func Test_deleteRun(t *testing.T) {
+	fakeHTTP := &httpmock.Registry{}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.Register(
+		httpmock.REST("DELETE", "repos/OWNER/REPO/git/refs/heads/feature"),
+		httpmock.StringResponse("{}"))
+	fakeHTTP.Register(
+		httpmock.REST("DELETE", "repos/OWNER/REPO/git/refs/heads/old-work"),
+		httpmock.StringResponse("{}"))
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+	cs.Register(`git rev-parse --verify refs/heads/feature`, 0, "")
+	cs.Register(`git branch -D feature`, 0, "")
+	cs.Register(`git rev-parse --verify refs/heads/old-work`, 1, "")
+
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	opts := DeleteOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		Branches:  []string{"feature", "old-work"},
+		Confirmed: true,
+	}
+
+	err := deleteRun(&opts)
+	require.NoError(t, err)
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "✓ Deleted branch feature and local branch\n✓ Deleted branch old-work\n", stderr.String())
+}
@@ -0,0 +1,121 @@
+package list
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/shurcooL/githubv4"
+)
+
+type PullRequest struct {
+	Number int
+	Title  string
+	Author string
+	State  string
+}
+
+type Branch struct {
+	Name          string
+	CommittedDate time.Time
+	IsDefault     bool
+	// MergedPRCount counts pull requests from this branch that have been
+	// merged; it drives the --merged filter.
+	MergedPRCount int
+	// PullRequest is the open pull request for this branch, if any.
+	PullRequest *PullRequest
+}
+
+func fetchBranches(httpClient *http.Client, repo ghrepo.Interface, limit int) ([]Branch, error) {
+	type prNode struct {
+		Number int
+		Title  string
+		State  string
+		Author struct {
+			Login string
+		}
+	}
+	type responseData struct {
+		Repository struct {
+			DefaultBranchRef struct {
+				Name string
+			}
+			Refs struct {
+				Nodes []struct {
+					Name   string
+					Target struct {
+						Commit struct {
+							CommittedDate time.Time
+						} `graphql:"... on Commit"`
+					}
+					OpenPullRequests struct {
+						Nodes []prNode
+					} `graphql:"openPullRequests: associatedPullRequests(first: 1, states: OPEN)"`
+					MergedPullRequests struct {
+						TotalCount int
+					} `graphql:"mergedPullRequests: associatedPullRequests(states: MERGED)"`
+				}
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"refs(refPrefix: \"refs/heads/\", first: $perPage, after: $endCursor, orderBy: {field: TAG_COMMIT_DATE, direction: DESC})"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	perPage := limit
+	if limit > 100 {
+		perPage = 100
+	}
+
+	variables := map[string]interface{}{
+		"owner":     githubv4.String(repo.RepoOwner()),
+		"name":      githubv4.String(repo.RepoName()),
+		"perPage":   githubv4.Int(perPage),
+		"endCursor": (*githubv4.String)(nil),
+	}
+
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), httpClient)
+
+	var branches []Branch
+loop:
+	for {
+		var query responseData
+		err := gql.QueryNamed(context.Background(), "RepositoryBranchList", &query, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range query.Repository.Refs.Nodes {
+			branch := Branch{
+				Name:          node.Name,
+				CommittedDate: node.Target.Commit.CommittedDate,
+				IsDefault:     node.Name == query.Repository.DefaultBranchRef.Name,
+				MergedPRCount: node.MergedPullRequests.TotalCount,
+			}
+			if len(node.OpenPullRequests.Nodes) > 0 {
+				pr := node.OpenPullRequests.Nodes[0]
+				branch.PullRequest = &PullRequest{
+					Number: pr.Number,
+					Title:  pr.Title,
+					Author: pr.Author.Login,
+					State:  pr.State,
+				}
+			}
+			branches = append(branches, branch)
+			if len(branches) == limit {
+				break loop
+			}
+		}
+
+		if !query.Repository.Refs.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = githubv4.String(query.Repository.Refs.PageInfo.EndCursor)
+	}
+
+	return branches, nil
+}
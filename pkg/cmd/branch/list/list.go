@@ -0,0 +1,146 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	LimitResults int
+	MergedOnly   bool
+	Stale        time.Duration
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	var staleFlag string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List branches in a repository",
+		Long: heredoc.Doc(`
+			List remote branches along with the open pull request associated with each.
+
+			The --merged filter selects branches whose pull requests have all been
+			merged; the --stale filter selects branches with no new commits for the
+			given duration, for example --stale 720h for 30 days.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.LimitResults < 1 {
+				return cmdutil.FlagErrorf("invalid value for --limit: %v", opts.LimitResults)
+			}
+
+			if staleFlag != "" {
+				stale, err := time.ParseDuration(staleFlag)
+				if err != nil || stale <= 0 {
+					return cmdutil.FlagErrorf("invalid value for --stale: %q", staleFlag)
+				}
+				opts.Stale = stale
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.LimitResults, "limit", "L", 30, "Maximum number of branches to fetch")
+	cmd.Flags().BoolVar(&opts.MergedOnly, "merged", false, "Show only branches whose pull requests have been merged")
+	cmd.Flags().StringVar(&staleFlag, "stale", "", "Show only branches with no commits for the given `duration`")
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	branches, err := fetchBranches(httpClient, baseRepo, opts.LimitResults)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	branches = filterBranches(branches, opts.MergedOnly, opts.Stale, now)
+
+	if len(branches) == 0 {
+		return fmt.Errorf("no branches match in %s", ghrepo.FullName(baseRepo))
+	}
+
+	table := utils.NewTablePrinter(opts.IO)
+	cs := opts.IO.ColorScheme()
+	for _, branch := range branches {
+		name := branch.Name
+		if branch.IsDefault && table.IsTTY() {
+			name += " (default)"
+		}
+		table.AddField(name, nil, cs.ColorFromString("cyan"))
+
+		prField := ""
+		prAuthor := ""
+		var prColor func(string) string
+		if pr := branch.PullRequest; pr != nil {
+			prField = fmt.Sprintf("#%d", pr.Number)
+			prAuthor = pr.Author
+			prColor = cs.Green
+		} else if branch.MergedPRCount > 0 {
+			prField = "merged"
+			prColor = cs.Magenta
+		}
+		table.AddField(prField, nil, prColor)
+		table.AddField(prAuthor, nil, nil)
+
+		committedAt := branch.CommittedDate.Format(time.RFC3339)
+		if table.IsTTY() {
+			committedAt = utils.FuzzyAgo(now.Sub(branch.CommittedDate))
+		}
+		table.AddField(committedAt, nil, cs.Gray)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
+
+// filterBranches narrows the branch list per the --merged and --stale flags.
+// The default branch is never considered merged or stale.
+func filterBranches(branches []Branch, mergedOnly bool, stale time.Duration, now time.Time) []Branch {
+	filtered := branches[:0:0]
+	for _, branch := range branches {
+		if mergedOnly && (branch.IsDefault || branch.PullRequest != nil || branch.MergedPRCount == 0) {
+			continue
+		}
+		if stale > 0 && (branch.IsDefault || now.Sub(branch.CommittedDate) < stale) {
+			continue
+		}
+		filtered = append(filtered, branch)
+	}
+	return filtered
+}
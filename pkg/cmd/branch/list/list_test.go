@@ -0,0 +1,93 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_listRun(t *testing.T) {
+	fakeHTTP := &httpmock.Registry{}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.Register(httpmock.GraphQL(`\bRepositoryBranchList\(`), httpmock.StringResponse(`
+	{ "data": { "repository": {
+		"defaultBranchRef": { "name": "trunk" },
+		"refs": {
+			"nodes": [
+				{
+					"name": "trunk",
+					"target": { "committedDate": "2021-05-20T11:00:00Z" },
+					"openPullRequests": { "nodes": [] },
+					"mergedPullRequests": { "totalCount": 0 }
+				},
+				{
+					"name": "feature",
+					"target": { "committedDate": "2021-05-19T09:00:00Z" },
+					"openPullRequests": { "nodes": [
+						{ "number": 123, "title": "Add feature", "state": "OPEN", "author": { "login": "hubot" } }
+					] },
+					"mergedPullRequests": { "totalCount": 0 }
+				},
+				{
+					"name": "old-work",
+					"target": { "committedDate": "2021-01-02T15:04:05Z" },
+					"openPullRequests": { "nodes": [] },
+					"mergedPullRequests": { "totalCount": 1 }
+				}
+			],
+			"pageInfo": { "hasNextPage": false }
+		}
+	} } }`))
+
+	io, _, stdout, stderr := iostreams.Test()
+	opts := ListOptions{
+		IO:           io,
+		LimitResults: 30,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	err := listRun(&opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		trunk			2021-05-20T11:00:00Z
+		feature	#123	hubot	2021-05-19T09:00:00Z
+		old-work	merged		2021-01-02T15:04:05Z
+	`), stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
+func Test_filterBranches(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	branches := []Branch{
+		{Name: "trunk", IsDefault: true, CommittedDate: now.Add(-100 * 24 * time.Hour)},
+		{Name: "feature", CommittedDate: now.Add(-time.Hour), PullRequest: &PullRequest{Number: 123}},
+		{Name: "old-work", CommittedDate: now.Add(-60 * 24 * time.Hour), MergedPRCount: 1},
+		{Name: "abandoned", CommittedDate: now.Add(-90 * 24 * time.Hour)},
+	}
+
+	names := func(branches []Branch) []string {
+		var out []string
+		for _, b := range branches {
+			out = append(out, b.Name)
+		}
+		return out
+	}
+
+	assert.Equal(t, []string{"trunk", "feature", "old-work", "abandoned"}, names(filterBranches(branches, false, 0, now)))
+	assert.Equal(t, []string{"old-work"}, names(filterBranches(branches, true, 0, now)))
+	assert.Equal(t, []string{"old-work", "abandoned"}, names(filterBranches(branches, false, 30*24*time.Hour, now)))
+	assert.Equal(t, []string{"old-work"}, names(filterBranches(branches, true, 30*24*time.Hour, now)))
+}
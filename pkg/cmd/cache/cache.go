@@ -0,0 +1,25 @@
+package cache
+
+import (
+	cmdDelete "github.com/cli/cli/v2/pkg/cmd/cache/delete"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/cache/list"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdCache(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache <command>",
+		Short: "Manage Actions caches",
+		Long:  "List and delete caches created by GitHub Actions workflows.",
+		Annotations: map[string]string{
+			"IsActions": "true",
+		},
+	}
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+
+	return cmd
+}
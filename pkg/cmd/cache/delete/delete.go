@@ -0,0 +1,119 @@
+package delete
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/cache/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type DeleteOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Key       string
+	DeleteAll bool
+}
+
+func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete [<key>]",
+		Short: "Delete Actions caches",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.Key = args[0]
+			}
+
+			if opts.Key == "" && !opts.DeleteAll {
+				return cmdutil.FlagErrorf("must provide a cache key or use `--all`")
+			}
+			if opts.Key != "" && opts.DeleteAll {
+				return cmdutil.FlagErrorf("specify only one of cache key or `--all`")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.DeleteAll, "all", "a", false, "Delete all caches")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOptions) error {
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("failed to determine base repo: %w", err)
+	}
+
+	c, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("failed to create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(c)
+
+	filters := &shared.FilterOptions{Key: opts.Key}
+	if opts.DeleteAll {
+		filters.Key = ""
+	}
+
+	opts.IO.StartProgressIndicator()
+	caches, err := shared.GetCaches(client, baseRepo, filters, 100)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get caches: %w", err)
+	}
+
+	toDelete := []shared.Cache{}
+	for _, cache := range caches {
+		if opts.DeleteAll || cache.Key == opts.Key {
+			toDelete = append(toDelete, cache)
+		}
+	}
+
+	if len(toDelete) == 0 {
+		if opts.DeleteAll {
+			return fmt.Errorf("no caches to delete")
+		}
+		return fmt.Errorf("no cache found with key %s", opts.Key)
+	}
+
+	opts.IO.StartProgressIndicator()
+	for _, cache := range toDelete {
+		if err := shared.DeleteCache(client, baseRepo, cache.ID); err != nil {
+			opts.IO.StopProgressIndicator()
+			return fmt.Errorf("failed to delete cache %s: %w", cache.Key, err)
+		}
+	}
+	opts.IO.StopProgressIndicator()
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Deleted %s from %s\n",
+			cs.SuccessIcon(),
+			utils.Pluralize(len(toDelete), "cache"),
+			ghrepo.FullName(baseRepo))
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package delete
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/cache/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    DeleteOptions
+		wantsErr string
+	}{
+		{
+			name:     "blank",
+			wantsErr: "must provide a cache key or use `--all`",
+		},
+		{
+			name: "key",
+			cli:  "Linux-node-abc123",
+			wants: DeleteOptions{
+				Key: "Linux-node-abc123",
+			},
+		},
+		{
+			name: "all",
+			cli:  "--all",
+			wants: DeleteOptions{
+				DeleteAll: true,
+			},
+		},
+		{
+			name:     "key and all",
+			cli:      "Linux-node-abc123 --all",
+			wantsErr: "specify only one of cache key or `--all`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *DeleteOptions
+			cmd := NewCmdDelete(f, func(opts *DeleteOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Key, gotOpts.Key)
+			assert.Equal(t, tt.wants.DeleteAll, gotOpts.DeleteAll)
+		})
+	}
+}
+
+func TestDeleteRun(t *testing.T) {
+	caches := []shared.Cache{
+		{ID: 1, Key: "Linux-node-abc123"},
+		{ID: 2, Key: "Linux-go-def456"},
+	}
+
+	tests := []struct {
+		name      string
+		opts      DeleteOptions
+		tty       bool
+		httpStubs func(*httpmock.Registry)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "delete by key",
+			tty:  true,
+			opts: DeleteOptions{Key: "Linux-node-abc123"},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
+					httpmock.JSONResponse(shared.CachesPayload{
+						TotalCount:    1,
+						ActionsCaches: caches[0:1],
+					}))
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches/1"),
+					httpmock.StringResponse("{}"))
+			},
+			wantOut: "✓ Deleted 1 cache from OWNER/REPO\n",
+		},
+		{
+			name: "delete all",
+			tty:  true,
+			opts: DeleteOptions{DeleteAll: true},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
+					httpmock.JSONResponse(shared.CachesPayload{
+						TotalCount:    2,
+						ActionsCaches: caches,
+					}))
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches/1"),
+					httpmock.StringResponse("{}"))
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches/2"),
+					httpmock.StringResponse("{}"))
+			},
+			wantOut: "✓ Deleted 2 caches from OWNER/REPO\n",
+		},
+		{
+			name: "no cache matches key",
+			opts: DeleteOptions{Key: "missing-key"},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
+					httpmock.JSONResponse(shared.CachesPayload{}))
+			},
+			wantErr: "no cache found with key missing-key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.tty)
+			io.SetStdinTTY(tt.tty)
+
+			opts := tt.opts
+			opts.IO = io
+			opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			err := deleteRun(&opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/cache/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+const defaultLimit = 30
+
+var sortFields = map[string]string{
+	"size":      "size_in_bytes",
+	"last-used": "last_accessed_at",
+	"created":   "created_at",
+}
+
+type ListOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Limit int
+	Key   string
+	Sort  string
+
+	Now func() time.Time
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List Actions caches in a repository",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if opts.Sort != "" {
+				if _, ok := sortFields[opts.Sort]; !ok {
+					return cmdutil.FlagErrorf("invalid sort option %q: must be one of size, last-used, created", opts.Sort)
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", defaultLimit, "Maximum number of caches to fetch")
+	cmd.Flags().StringVarP(&opts.Key, "key", "k", "", "Filter caches by an explicit key or key prefix")
+	cmd.Flags().StringVarP(&opts.Sort, "sort", "s", "", "Sort caches by {size|last-used|created}")
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("failed to determine base repo: %w", err)
+	}
+
+	c, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("failed to create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(c)
+
+	filters := &shared.FilterOptions{
+		Key:  opts.Key,
+		Sort: sortFields[opts.Sort],
+	}
+
+	opts.IO.StartProgressIndicator()
+	caches, err := shared.GetCaches(client, baseRepo, filters, opts.Limit)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get caches: %w", err)
+	}
+
+	if len(caches) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintln(opts.IO.ErrOut, "No caches found")
+		}
+		return nil
+	}
+
+	if err := opts.IO.StartPager(); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "starting pager failed: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	now := time.Now()
+	if opts.Now != nil {
+		now = opts.Now()
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, cache := range caches {
+		tp.AddField(fmt.Sprintf("%d", cache.ID), nil, cs.Cyan)
+		tp.AddField(cache.Key, nil, nil)
+		tp.AddField(humanFileSize(cache.SizeInBytes), nil, nil)
+		tp.AddField(cache.Ref, nil, nil)
+		if tp.IsTTY() {
+			tp.AddField(utils.FuzzyAgoAbbr(now, cache.LastAccessedAt), nil, cs.Gray)
+		} else {
+			tp.AddField(cache.LastAccessedAt.Format(time.RFC3339), nil, nil)
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func humanFileSize(s int64) string {
+	if s < 1024 {
+		return fmt.Sprintf("%d B", s)
+	}
+
+	kb := float64(s) / 1024
+	if kb < 1024 {
+		return fmt.Sprintf("%.2f KiB", kb)
+	}
+
+	mb := kb / 1024
+	if mb < 1024 {
+		return fmt.Sprintf("%.2f MiB", mb)
+	}
+
+	gb := mb / 1024
+	return fmt.Sprintf("%.2f GiB", gb)
+}
@@ -0,0 +1,203 @@
+package list
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/cache/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdList(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    ListOptions
+		wantsErr string
+	}{
+		{
+			name: "blank",
+			wants: ListOptions{
+				Limit: defaultLimit,
+			},
+		},
+		{
+			name: "limit",
+			cli:  "--limit 100",
+			wants: ListOptions{
+				Limit: 100,
+			},
+		},
+		{
+			name:     "bad limit",
+			cli:      "--limit 0",
+			wantsErr: "invalid limit: 0",
+		},
+		{
+			name: "sort",
+			cli:  "--sort size",
+			wants: ListOptions{
+				Limit: defaultLimit,
+				Sort:  "size",
+			},
+		},
+		{
+			name:     "bad sort",
+			cli:      "--sort potatoes",
+			wantsErr: `invalid sort option "potatoes": must be one of size, last-used, created`,
+		},
+		{
+			name: "key",
+			cli:  "--key cache-key",
+			wants: ListOptions{
+				Limit: defaultLimit,
+				Key:   "cache-key",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *ListOptions
+			cmd := NewCmdList(f, func(opts *ListOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Limit, gotOpts.Limit)
+			assert.Equal(t, tt.wants.Key, gotOpts.Key)
+			assert.Equal(t, tt.wants.Sort, gotOpts.Sort)
+		})
+	}
+}
+
+func TestListRun(t *testing.T) {
+	aCache := shared.Cache{
+		ID:             1,
+		Key:            "Linux-node-abc123",
+		Ref:            "refs/heads/trunk",
+		SizeInBytes:    1024,
+		LastAccessedAt: time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+	anotherCache := shared.Cache{
+		ID:             2,
+		Key:            "Linux-go-def456",
+		Ref:            "refs/heads/feature",
+		SizeInBytes:    2 * 1024 * 1024,
+		LastAccessedAt: time.Date(2022, 5, 2, 12, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name      string
+		opts      ListOptions
+		tty       bool
+		httpStubs func(*httpmock.Registry)
+		wantQuery string
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "lists caches",
+			tty:  true,
+			opts: ListOptions{Limit: 30},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
+					httpmock.JSONResponse(shared.CachesPayload{
+						TotalCount:    2,
+						ActionsCaches: []shared.Cache{aCache, anotherCache},
+					}))
+			},
+			wantOut: "1  Linux-node-abc123  1.00 KiB  refs/heads/trunk    2d\n2  Linux-go-def456    2.00 MiB  refs/heads/feature  1d\n",
+		},
+		{
+			name: "sorts by size",
+			opts: ListOptions{Limit: 30, Sort: "size"},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
+					httpmock.JSONResponse(shared.CachesPayload{
+						TotalCount:    1,
+						ActionsCaches: []shared.Cache{anotherCache},
+					}))
+			},
+			wantQuery: "page=1&per_page=30&sort=size_in_bytes",
+			wantOut:   "2\tLinux-go-def456\t2.00 MiB\trefs/heads/feature\t2022-05-02T12:00:00Z\n",
+		},
+		{
+			name: "no caches",
+			tty:  true,
+			opts: ListOptions{Limit: 30},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
+					httpmock.JSONResponse(shared.CachesPayload{}))
+			},
+			wantOut: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.tty)
+			io.SetStdinTTY(tt.tty)
+
+			opts := tt.opts
+			opts.IO = io
+			opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+			opts.Now = func() time.Time {
+				return time.Date(2022, 5, 3, 12, 0, 0, 0, time.UTC)
+			}
+
+			err := listRun(&opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+			if tt.wantQuery != "" {
+				assert.Equal(t, tt.wantQuery, reg.Requests[0].URL.RawQuery)
+			}
+		})
+	}
+}
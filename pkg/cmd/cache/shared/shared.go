@@ -0,0 +1,96 @@
+package shared
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+type Cache struct {
+	ID             int64     `json:"id"`
+	Key            string    `json:"key"`
+	Ref            string    `json:"ref"`
+	Version        string    `json:"version"`
+	SizeInBytes    int64     `json:"size_in_bytes"`
+	CreatedAt      time.Time `json:"created_at"`
+	LastAccessedAt time.Time `json:"last_accessed_at"`
+}
+
+type CachesPayload struct {
+	TotalCount    int     `json:"total_count"`
+	ActionsCaches []Cache `json:"actions_caches"`
+}
+
+type FilterOptions struct {
+	Key  string
+	Sort string
+}
+
+// GetCaches fetches up to limit Actions caches for the repository, most recently
+// used first unless a different sort order was requested.
+func GetCaches(client *api.Client, repo ghrepo.Interface, opts *FilterOptions, limit int) ([]Cache, error) {
+	path := fmt.Sprintf("repos/%s/actions/caches", ghrepo.FullName(repo))
+
+	perPage := limit
+	page := 1
+	if limit > 100 {
+		perPage = 100
+	}
+
+	caches := []Cache{}
+
+	for len(caches) < limit {
+		var result CachesPayload
+
+		parsed, err := url.Parse(path)
+		if err != nil {
+			return nil, err
+		}
+		query := parsed.Query()
+		query.Set("per_page", fmt.Sprintf("%d", perPage))
+		query.Set("page", fmt.Sprintf("%d", page))
+		if opts != nil {
+			if opts.Key != "" {
+				query.Set("key", opts.Key)
+			}
+			if opts.Sort != "" {
+				query.Set("sort", opts.Sort)
+			}
+		}
+		parsed.RawQuery = query.Encode()
+		pagedPath := parsed.String()
+
+		err = client.REST(repo.RepoHost(), "GET", pagedPath, nil, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(result.ActionsCaches) == 0 {
+			break
+		}
+
+		for _, cache := range result.ActionsCaches {
+			caches = append(caches, cache)
+			if len(caches) == limit {
+				break
+			}
+		}
+
+		if len(result.ActionsCaches) < perPage {
+			break
+		}
+
+		page++
+	}
+
+	return caches, nil
+}
+
+// DeleteCache deletes a single Actions cache by its ID.
+func DeleteCache(client *api.Client, repo ghrepo.Interface, cacheID int64) error {
+	path := fmt.Sprintf("repos/%s/actions/caches/%d", ghrepo.FullName(repo), cacheID)
+	return client.REST(repo.RepoHost(), "DELETE", path, nil, nil)
+}
@@ -0,0 +1,21 @@
+package commit
+
+import (
+	cmdView "github.com/cli/cli/v2/pkg/cmd/commit/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdCommit(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit <command>",
+		Short: "Inspect commits",
+		Long:  "Work with commits in a GitHub repository.",
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+
+	return cmd
+}
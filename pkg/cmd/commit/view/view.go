@@ -0,0 +1,193 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/text"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Ref string
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <sha>",
+		Short: "View a commit",
+		Long: heredoc.Doc(`
+			Display the metadata of a commit along with its check status and any
+			associated pull requests.
+		`),
+		Example: heredoc.Doc(`
+			$ gh commit view 1a2b3c4
+			$ gh commit view deadbeef --repo cli/cli
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.Ref = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+type commit struct {
+	Oid             string
+	MessageHeadline string
+	MessageBody     string
+	CommittedDate   time.Time
+	Author          struct {
+		Name  string
+		Email string
+		User  *struct {
+			Login string
+		}
+	}
+	StatusCheckRollup *struct {
+		State string
+	}
+	AssociatedPullRequests struct {
+		Nodes []struct {
+			Number int
+			Title  string
+			State  string
+		}
+	}
+}
+
+func viewRun(opts *ViewOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	c, err := fetchCommit(httpClient, baseRepo, opts.Ref)
+	if err != nil {
+		return err
+	}
+
+	opts.IO.DetectTerminalTheme()
+	if err := opts.IO.StartPager(); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	fmt.Fprintf(out, "%s %s\n", cs.Yellow("commit"), c.Oid)
+	author := fmt.Sprintf("%s <%s>", c.Author.Name, c.Author.Email)
+	if c.Author.User != nil {
+		author += fmt.Sprintf(" (%s)", c.Author.User.Login)
+	}
+	fmt.Fprintf(out, "Author: %s\n", author)
+	fmt.Fprintf(out, "Date:   %s\n", c.CommittedDate.Format(time.RFC1123))
+	fmt.Fprintf(out, "\n%s\n", text.Indent(c.MessageHeadline, "    "))
+	if c.MessageBody != "" {
+		fmt.Fprintf(out, "\n%s\n", text.Indent(strings.TrimSuffix(c.MessageBody, "\n"), "    "))
+	}
+
+	if c.StatusCheckRollup != nil {
+		var stateColor func(string) string
+		switch c.StatusCheckRollup.State {
+		case "SUCCESS":
+			stateColor = cs.Green
+		case "FAILURE", "ERROR":
+			stateColor = cs.Red
+		default:
+			stateColor = cs.Yellow
+		}
+		fmt.Fprintf(out, "\nChecks: %s\n", stateColor(c.StatusCheckRollup.State))
+	}
+
+	if prs := c.AssociatedPullRequests.Nodes; len(prs) > 0 {
+		fmt.Fprintf(out, "\nPull requests:\n")
+		for _, pr := range prs {
+			fmt.Fprintf(out, "  #%d %s (%s)\n", pr.Number, pr.Title, pr.State)
+		}
+	}
+
+	return nil
+}
+
+func fetchCommit(httpClient *http.Client, repo ghrepo.Interface, ref string) (*commit, error) {
+	var response struct {
+		Repository struct {
+			Object *commit
+		}
+	}
+
+	query := `
+	query CommitView($owner: String!, $repo: String!, $ref: String!) {
+		repository(owner: $owner, name: $repo) {
+			object(expression: $ref) {
+				... on Commit {
+					oid
+					messageHeadline
+					messageBody
+					committedDate
+					author {
+						name
+						email
+						user { login }
+					}
+					statusCheckRollup { state }
+					associatedPullRequests(first: 10) {
+						nodes {
+							number
+							title
+							state
+						}
+					}
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+		"ref":   ref,
+	}
+
+	client := api.NewClientFromHTTP(httpClient)
+	if err := client.GraphQL(repo.RepoHost(), query, variables, &response); err != nil {
+		return nil, err
+	}
+	if response.Repository.Object == nil || response.Repository.Object.Oid == "" {
+		return nil, fmt.Errorf("no commit found for %q in %s", ref, ghrepo.FullName(repo))
+	}
+
+	return response.Repository.Object, nil
+}
@@ -0,0 +1,87 @@
+package view
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_viewRun(t *testing.T) {
+	fakeHTTP := &httpmock.Registry{}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.Register(httpmock.GraphQL(`query CommitView\b`), httpmock.StringResponse(`
+	{ "data": { "repository": { "object": {
+		"oid": "deadbeefcafe",
+		"messageHeadline": "Fix the flux capacitor",
+		"messageBody": "It was drawing too much power.",
+		"committedDate": "2020-08-31T15:44:24Z",
+		"author": {
+			"name": "Mona Lisa",
+			"email": "mona@github.com",
+			"user": { "login": "monalisa" }
+		},
+		"statusCheckRollup": { "state": "SUCCESS" },
+		"associatedPullRequests": { "nodes": [
+			{ "number": 123, "title": "Flux capacitor fixes", "state": "MERGED" }
+		] }
+	} } } }`))
+
+	io, _, stdout, stderr := iostreams.Test()
+	opts := ViewOptions{
+		IO:  io,
+		Ref: "deadbeef",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	err := viewRun(&opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		commit deadbeefcafe
+		Author: Mona Lisa <mona@github.com> (monalisa)
+		Date:   Mon, 31 Aug 2020 15:44:24 UTC
+
+		    Fix the flux capacitor
+
+		    It was drawing too much power.
+
+		Checks: SUCCESS
+
+		Pull requests:
+		  #123 Flux capacitor fixes (MERGED)
+	`), stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
+func Test_viewRun_notFound(t *testing.T) {
+	fakeHTTP := &httpmock.Registry{}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.Register(httpmock.GraphQL(`query CommitView\b`), httpmock.StringResponse(`
+	{ "data": { "repository": { "object": null } } }`))
+
+	io, _, _, _ := iostreams.Test()
+	opts := ViewOptions{
+		IO:  io,
+		Ref: "c0ffee",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	err := viewRun(&opts)
+	assert.EqualError(t, err, `no commit found for "c0ffee" in OWNER/REPO`)
+}
@@ -5,9 +5,13 @@ import (
 	"strings"
 
 	"github.com/cli/cli/v2/internal/config"
+	cmdDefaults "github.com/cli/cli/v2/pkg/cmd/config/defaults"
+	cmdExport "github.com/cli/cli/v2/pkg/cmd/config/export"
 	cmdGet "github.com/cli/cli/v2/pkg/cmd/config/get"
+	cmdImport "github.com/cli/cli/v2/pkg/cmd/config/import"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/config/list"
 	cmdSet "github.com/cli/cli/v2/pkg/cmd/config/set"
+	cmdUnset "github.com/cli/cli/v2/pkg/cmd/config/unset"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -34,7 +38,11 @@ func NewCmdConfig(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.AddCommand(cmdGet.NewCmdConfigGet(f, nil))
 	cmd.AddCommand(cmdSet.NewCmdConfigSet(f, nil))
+	cmd.AddCommand(cmdUnset.NewCmdConfigUnset(f, nil))
 	cmd.AddCommand(cmdList.NewCmdConfigList(f, nil))
+	cmd.AddCommand(cmdExport.NewCmdConfigExport(f, nil))
+	cmd.AddCommand(cmdImport.NewCmdConfigImport(f, nil))
+	cmd.AddCommand(cmdDefaults.NewCmdDefaults(f))
 
 	return cmd
 }
@@ -0,0 +1,30 @@
+package defaults
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	deleteCmd "github.com/cli/cli/v2/pkg/cmd/config/defaults/delete"
+	getCmd "github.com/cli/cli/v2/pkg/cmd/config/defaults/get"
+	listCmd "github.com/cli/cli/v2/pkg/cmd/config/defaults/list"
+	setCmd "github.com/cli/cli/v2/pkg/cmd/config/defaults/set"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdDefaults(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "defaults <command>",
+		Short: "Manage default flags for gh commands",
+		Long: heredoc.Doc(`
+			Default flags are applied every time the associated gh command runs, as if they
+			had been typed before any other arguments. Flags passed explicitly on the
+			command line take precedence over configured defaults.
+		`),
+	}
+
+	cmd.AddCommand(getCmd.NewCmdGet(f, nil))
+	cmd.AddCommand(setCmd.NewCmdSet(f, nil))
+	cmd.AddCommand(listCmd.NewCmdList(f, nil))
+	cmd.AddCommand(deleteCmd.NewCmdDelete(f, nil))
+
+	return cmd
+}
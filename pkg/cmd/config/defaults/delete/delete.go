@@ -0,0 +1,71 @@
+package delete
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DeleteOptions struct {
+	Config func() (config.Config, error)
+	IO     *iostreams.IOStreams
+
+	Command string
+}
+
+func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <command>",
+		Short: "Delete the default flags for a gh command",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Command = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return deleteRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	defaultsCfg, err := cfg.Defaults()
+	if err != nil {
+		return fmt.Errorf("couldn't read defaults config: %w", err)
+	}
+
+	command := strings.Join(strings.Fields(opts.Command), " ")
+	flags, ok := defaultsCfg.Get(command)
+	if !ok {
+		return fmt.Errorf("no defaults configured for %s", command)
+	}
+
+	err = defaultsCfg.Delete(command)
+	if err != nil {
+		return fmt.Errorf("failed to delete defaults for %s: %w", command, err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.ErrOut, "%s Deleted defaults for %s; was %s\n", cs.SuccessIconWithColor(cs.Red), command, flags)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package get
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type GetOptions struct {
+	Config func() (config.Config, error)
+	IO     *iostreams.IOStreams
+
+	Command string
+}
+
+func NewCmdGet(f *cmdutil.Factory, runF func(*GetOptions) error) *cobra.Command {
+	opts := &GetOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "get <command>",
+		Short: "Print the default flags for a gh command",
+		Example: heredoc.Doc(`
+			$ gh config defaults get "pr list"
+			--limit 50 --state all
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Command = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return getRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func getRun(opts *GetOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	defaultsCfg, err := cfg.Defaults()
+	if err != nil {
+		return err
+	}
+
+	command := strings.Join(strings.Fields(opts.Command), " ")
+	if flags, ok := defaultsCfg.Get(command); ok {
+		fmt.Fprintln(opts.IO.Out, flags)
+		return nil
+	}
+
+	return fmt.Errorf("no defaults configured for %s", command)
+}
@@ -0,0 +1,74 @@
+package get
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultsGet(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     string
+		cli        string
+		wantStdout string
+		wantErr    string
+	}{
+		{
+			name:    "no defaults",
+			config:  "",
+			cli:     "'pr list'",
+			wantErr: "no defaults configured for pr list",
+		},
+		{
+			name: "defaults configured",
+			config: heredoc.Doc(`
+				defaults:
+				  pr list: --limit 50 --state all
+			`),
+			cli:        "'pr list'",
+			wantStdout: "--limit 50 --state all\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.NewFromString(tt.config)
+
+			io, _, stdout, _ := iostreams.Test()
+
+			factory := &cmdutil.Factory{
+				IOStreams: io,
+				Config: func() (config.Config, error) {
+					return cfg, nil
+				},
+			}
+
+			cmd := NewCmdGet(factory, nil)
+
+			argv, err := shlex.Split(tt.cli)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package list
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	Config func() (config.Config, error)
+	IO     *iostreams.IOStreams
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List commands with configured default flags",
+		Long: heredoc.Doc(`
+			This command prints out all of the default flags gh is configured to apply.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	defaultsCfg, err := cfg.Defaults()
+	if err != nil {
+		return fmt.Errorf("couldn't read defaults config: %w", err)
+	}
+
+	if defaultsCfg.Empty() {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "no defaults configured\n")
+		}
+		return nil
+	}
+
+	tp := utils.NewTablePrinter(opts.IO)
+
+	defaultsMap := defaultsCfg.All()
+	keys := []string{}
+	for command := range defaultsMap {
+		keys = append(keys, command)
+	}
+	sort.Strings(keys)
+
+	for _, command := range keys {
+		tp.AddField(command+":", nil, nil)
+		tp.AddField(defaultsMap[command], nil, nil)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,115 @@
+package set
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+)
+
+type SetOptions struct {
+	Config func() (config.Config, error)
+	IO     *iostreams.IOStreams
+
+	Command string
+	Flags   string
+
+	validCommand func(string) bool
+}
+
+func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command {
+	opts := &SetOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "set <command> <flags>",
+		Short: "Set default flags for a gh command",
+		Example: heredoc.Doc(`
+			$ gh config defaults set "pr list" "--limit 50 --state all"
+			$ gh pr list  #=> gh pr list --limit 50 --state all
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Command = args[0]
+			opts.Flags = args[1]
+
+			opts.validCommand = func(command string) bool {
+				c := cmd.Root()
+				for _, name := range strings.Fields(command) {
+					var next *cobra.Command
+					for _, sub := range c.Commands() {
+						if sub.Name() == name || sub.HasAlias(name) {
+							next = sub
+							break
+						}
+					}
+					if next == nil {
+						return false
+					}
+					c = next
+				}
+				return c != cmd.Root()
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return setRun(opts)
+		},
+	}
+
+	// the <flags> argument starts with a dash; don't parse it as flags for this command
+	cmd.Flags().SetInterspersed(false)
+
+	return cmd
+}
+
+func setRun(opts *SetOptions) error {
+	cs := opts.IO.ColorScheme()
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	defaultsCfg, err := cfg.Defaults()
+	if err != nil {
+		return err
+	}
+
+	command := strings.Join(strings.Fields(opts.Command), " ")
+	if !opts.validCommand(command) {
+		return fmt.Errorf("could not set defaults: %q is not a gh command", command)
+	}
+
+	if _, err := shlex.Split(opts.Flags); err != nil {
+		return fmt.Errorf("did not understand flags: %w", err)
+	}
+
+	successMsg := fmt.Sprintf("%s Added defaults for %s.", cs.SuccessIcon(), cs.Bold(command))
+	if oldFlags, ok := defaultsCfg.Get(command); ok {
+		successMsg = fmt.Sprintf("%s Changed defaults for %s from %s to %s",
+			cs.SuccessIcon(),
+			cs.Bold(command),
+			cs.Bold(oldFlags),
+			cs.Bold(opts.Flags),
+		)
+	}
+
+	err = defaultsCfg.Add(command, opts.Flags)
+	if err != nil {
+		return fmt.Errorf("could not set defaults: %s", err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintln(opts.IO.ErrOut, successMsg)
+	}
+
+	return nil
+}
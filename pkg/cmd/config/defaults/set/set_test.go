@@ -0,0 +1,108 @@
+package set
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/test"
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runCommand(cfg config.Config, isTTY bool, cli string) (*test.CmdOut, error) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(isTTY)
+	io.SetStdinTTY(isTTY)
+	io.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		Config: func() (config.Config, error) {
+			return cfg, nil
+		},
+	}
+
+	cmd := NewCmdSet(factory, nil)
+
+	// fake command nesting structure needed for validCommand
+	rootCmd := &cobra.Command{}
+	rootCmd.AddCommand(cmd)
+	prCmd := &cobra.Command{Use: "pr"}
+	prCmd.AddCommand(&cobra.Command{Use: "list"})
+	prCmd.AddCommand(&cobra.Command{Use: "status"})
+	rootCmd.AddCommand(prCmd)
+
+	argv, err := shlex.Split("set " + cli)
+	if err != nil {
+		return nil, err
+	}
+	rootCmd.SetArgs(argv)
+
+	rootCmd.SetIn(&bytes.Buffer{})
+	rootCmd.SetOut(ioutil.Discard)
+	rootCmd.SetErr(ioutil.Discard)
+
+	_, err = rootCmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestDefaultsSet_invalid_command(t *testing.T) {
+	defer config.StubWriteConfig(ioutil.Discard, ioutil.Discard)()
+
+	cfg := config.NewFromString(``)
+
+	_, err := runCommand(cfg, true, "'pr frobnicate' '--limit 50'")
+	assert.EqualError(t, err, `could not set defaults: "pr frobnicate" is not a gh command`)
+}
+
+func TestDefaultsSet_new(t *testing.T) {
+	mainBuf := bytes.Buffer{}
+	defer config.StubWriteConfig(&mainBuf, ioutil.Discard)()
+
+	cfg := config.NewFromString(heredoc.Doc(`
+		editor: vim
+	`))
+
+	output, err := runCommand(cfg, true, "'pr list' '--limit 50 --state all'")
+	require.NoError(t, err)
+
+	//nolint:staticcheck // prefer exact matchers over ExpectLines
+	test.ExpectLines(t, output.Stderr(), "Added defaults for pr list")
+
+	expected := `editor: vim
+defaults:
+    pr list: --limit 50 --state all
+`
+	assert.Equal(t, expected, mainBuf.String())
+}
+
+func TestDefaultsSet_existing(t *testing.T) {
+	mainBuf := bytes.Buffer{}
+	defer config.StubWriteConfig(&mainBuf, ioutil.Discard)()
+
+	cfg := config.NewFromString(heredoc.Doc(`
+		defaults:
+		  pr list: --limit 50
+	`))
+
+	output, err := runCommand(cfg, true, "'pr list' '--state all'")
+	require.NoError(t, err)
+
+	//nolint:staticcheck // prefer exact matchers over ExpectLines
+	test.ExpectLines(t, output.Stderr(), "Changed defaults for pr list from --limit 50 to --state all")
+
+	expected := `defaults:
+    pr list: --state all
+`
+	assert.Equal(t, expected, mainBuf.String())
+}
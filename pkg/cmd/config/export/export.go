@@ -0,0 +1,143 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Profile is the shareable subset of gh configuration: it carries aliases,
+// per-command default flags, settings, and per-host preferences, but never
+// authentication data such as OAuth tokens.
+type Profile struct {
+	Settings map[string]string            `yaml:"settings,omitempty"`
+	Aliases  map[string]string            `yaml:"aliases,omitempty"`
+	Defaults map[string]string            `yaml:"defaults,omitempty"`
+	Hosts    map[string]map[string]string `yaml:"hosts,omitempty"`
+}
+
+type ExportOptions struct {
+	IO     *iostreams.IOStreams
+	Config func() (config.Config, error)
+}
+
+func NewCmdConfigExport(f *cmdutil.Factory, runF func(*ExportOptions) error) *cobra.Command {
+	opts := &ExportOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export configuration as a shareable profile",
+		Long: heredoc.Doc(`
+			Print a sanitized YAML profile of the current configuration to standard output.
+
+			The profile contains aliases, per-command default flags, settings that differ
+			from their built-in defaults, and per-host preferences. Authentication tokens
+			are never included, so the output is safe to distribute to a team and can be
+			applied on another machine with "gh config import".
+		`),
+		Example: heredoc.Doc(`
+			$ gh config export > gh-profile.yml
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return exportRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func exportRun(opts *ExportOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	profile, err := BuildProfile(cfg)
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(profile)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(opts.IO.Out, string(data))
+	return nil
+}
+
+// BuildProfile collects the shareable parts of cfg. Keys that are not known
+// configuration options, such as oauth_token, are never copied over.
+func BuildProfile(cfg config.Config) (*Profile, error) {
+	profile := &Profile{}
+
+	for _, co := range config.ConfigOptions() {
+		value, err := cfg.Get("", co.Key)
+		var notFound *config.NotFoundError
+		if err != nil && !errors.As(err, &notFound) {
+			return nil, err
+		}
+		if value == "" || value == co.DefaultValue {
+			continue
+		}
+		if profile.Settings == nil {
+			profile.Settings = map[string]string{}
+		}
+		profile.Settings[co.Key] = value
+	}
+
+	aliasCfg, err := cfg.Aliases()
+	if err != nil {
+		return nil, err
+	}
+	if aliases := aliasCfg.All(); len(aliases) > 0 {
+		profile.Aliases = aliases
+	}
+
+	defaultsCfg, err := cfg.Defaults()
+	if err != nil {
+		return nil, err
+	}
+	if defaults := defaultsCfg.All(); len(defaults) > 0 {
+		profile.Defaults = defaults
+	}
+
+	hosts, err := cfg.Hosts()
+	if err != nil {
+		return nil, err
+	}
+	for _, host := range hosts {
+		for _, co := range config.ConfigOptions() {
+			value, src, err := cfg.GetWithSource(host, co.Key)
+			var notFound *config.NotFoundError
+			if err != nil && !errors.As(err, &notFound) {
+				return nil, err
+			}
+			if value == "" || src != config.HostsConfigFile() {
+				continue
+			}
+			if profile.Hosts == nil {
+				profile.Hosts = map[string]map[string]string{}
+			}
+			if profile.Hosts[host] == nil {
+				profile.Hosts[host] = map[string]string{}
+			}
+			profile.Hosts[host][co.Key] = value
+		}
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,71 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_exportRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     string
+		wantStdout string
+	}{
+		{
+			name: "full configuration",
+			config: heredoc.Doc(`
+				git_protocol: ssh
+				editor: vim
+				prompt: enabled
+				aliases:
+				  co: pr checkout
+				defaults:
+				  pr list: --limit 50
+				hosts:
+				  github.com:
+				    oauth_token: OTOKEN
+				    user: monalisa
+				    git_protocol: https
+			`),
+			wantStdout: heredoc.Doc(`
+				settings:
+				    editor: vim
+				    git_protocol: ssh
+				aliases:
+				    co: pr checkout
+				defaults:
+				    pr list: --limit 50
+				hosts:
+				    github.com:
+				        git_protocol: https
+			`),
+		},
+		{
+			name:       "nothing to share",
+			config:     "git_protocol: https\n",
+			wantStdout: "{}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, stdout, stderr := iostreams.Test()
+
+			opts := &ExportOptions{
+				IO: io,
+				Config: func() (config.Config, error) {
+					return config.NewFromString(tt.config), nil
+				},
+			}
+
+			err := exportRun(opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			assert.Equal(t, "", stderr.String())
+		})
+	}
+}
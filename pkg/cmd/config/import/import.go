@@ -0,0 +1,240 @@
+package importcmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmd/config/export"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type ImportOptions struct {
+	IO     *iostreams.IOStreams
+	Config func() (config.Config, error)
+
+	ProfileFile string
+	Force       bool
+}
+
+func NewCmdConfigImport(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Command {
+	opts := &ImportOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import configuration from a shareable profile",
+		Long: heredoc.Doc(`
+			Apply a YAML profile produced by "gh config export" onto the current
+			configuration. Pass "-" to read the profile from standard input.
+
+			Values that would overwrite a differing existing value are confirmed
+			interactively; pass --force to overwrite without prompting.
+		`),
+		Example: heredoc.Doc(`
+			$ gh config import gh-profile.yml
+			$ curl -fsSL https://example.com/gh-profile.yml | gh config import -
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot import: profile file required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProfileFile = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return importRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Overwrite conflicting values without prompting")
+
+	return cmd
+}
+
+func importRun(opts *ImportOptions) error {
+	data, err := cmdutil.ReadFile(opts.ProfileFile, opts.IO.In)
+	if err != nil {
+		return err
+	}
+
+	var profile export.Profile
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&profile); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("invalid profile: %w", err)
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	applied := 0
+
+	for _, key := range sortedKeys(profile.Settings) {
+		changed, err := applySetting(opts, cfg, "", key, profile.Settings[key])
+		if err != nil {
+			return err
+		}
+		if changed {
+			applied++
+		}
+	}
+
+	hosts := make([]string, 0, len(profile.Hosts))
+	for host := range profile.Hosts {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	for _, host := range hosts {
+		settings := profile.Hosts[host]
+		for _, key := range sortedKeys(settings) {
+			changed, err := applySetting(opts, cfg, host, key, settings[key])
+			if err != nil {
+				return err
+			}
+			if changed {
+				applied++
+			}
+		}
+	}
+
+	aliasCfg, err := cfg.Aliases()
+	if err != nil {
+		return err
+	}
+	for _, alias := range sortedKeys(profile.Aliases) {
+		expansion := profile.Aliases[alias]
+		if existing, ok := aliasCfg.Get(alias); ok {
+			if existing == expansion {
+				continue
+			}
+			confirmed, err := confirmOverwrite(opts, fmt.Sprintf("alias %q", alias), existing, expansion)
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				continue
+			}
+		}
+		if err := aliasCfg.Add(alias, expansion); err != nil {
+			return err
+		}
+		applied++
+	}
+
+	defaultsCfg, err := cfg.Defaults()
+	if err != nil {
+		return err
+	}
+	for _, command := range sortedKeys(profile.Defaults) {
+		flags := profile.Defaults[command]
+		if existing, ok := defaultsCfg.Get(command); ok {
+			if existing == flags {
+				continue
+			}
+			confirmed, err := confirmOverwrite(opts, fmt.Sprintf("defaults for %q", command), existing, flags)
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				continue
+			}
+		}
+		if err := defaultsCfg.Add(command, flags); err != nil {
+			return err
+		}
+		applied++
+	}
+
+	if applied > 0 {
+		if err := cfg.Write(); err != nil {
+			return fmt.Errorf("failed to write config to disk: %w", err)
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.ErrOut, "%s Imported %s\n", cs.SuccessIcon(), utils.Pluralize(applied, "configuration value"))
+	}
+
+	return nil
+}
+
+func applySetting(opts *ImportOptions, cfg config.Config, hostname, key, value string) (bool, error) {
+	if err := config.ValidateKey(key); err != nil {
+		warningIcon := opts.IO.ColorScheme().WarningIcon()
+		fmt.Fprintf(opts.IO.ErrOut, "%s warning: '%s' is not a known configuration key\n", warningIcon, key)
+	}
+
+	if err := config.ValidateValue(key, value); err != nil {
+		return false, fmt.Errorf("failed to set %q to %q: %w", key, value, err)
+	}
+
+	existing, src, err := cfg.GetWithSource(hostname, key)
+	var notFound *config.NotFoundError
+	if err != nil && !errors.As(err, &notFound) {
+		return false, err
+	}
+	if hostname != "" && src != config.HostsConfigFile() {
+		// the value fell back to top-level configuration; the host has no
+		// setting of its own to conflict with
+		existing = ""
+	}
+
+	if existing == value {
+		return false, nil
+	}
+
+	if existing != "" {
+		description := key
+		if hostname != "" {
+			description = fmt.Sprintf("%s for %s", key, hostname)
+		}
+		confirmed, err := confirmOverwrite(opts, description, existing, value)
+		if err != nil {
+			return false, err
+		}
+		if !confirmed {
+			return false, nil
+		}
+	}
+
+	if err := cfg.Set(hostname, key, value); err != nil {
+		return false, fmt.Errorf("failed to set %q to %q: %w", key, value, err)
+	}
+	return true, nil
+}
+
+func confirmOverwrite(opts *ImportOptions, description, existing, value string) (bool, error) {
+	if opts.Force {
+		return true, nil
+	}
+	if !opts.IO.CanPrompt() {
+		return false, fmt.Errorf("%s is already set; pass `--force` to overwrite it in a non-interactive session", description)
+	}
+
+	confirmed := false
+	err := prompt.Confirm(fmt.Sprintf("Overwrite %s (currently %q) with %q?", description, existing, value), &confirmed)
+	return confirmed, err
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
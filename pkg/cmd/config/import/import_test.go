@@ -0,0 +1,188 @@
+package importcmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdConfigImport(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		output   ImportOptions
+		wantsErr bool
+	}{
+		{
+			name:     "no arguments",
+			input:    "",
+			output:   ImportOptions{},
+			wantsErr: true,
+		},
+		{
+			name:     "profile file",
+			input:    "gh-profile.yml",
+			output:   ImportOptions{ProfileFile: "gh-profile.yml"},
+			wantsErr: false,
+		},
+		{
+			name:     "force flag",
+			input:    "- --force",
+			output:   ImportOptions{ProfileFile: "-", Force: true},
+			wantsErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{
+				Config: func() (config.Config, error) {
+					return config.ConfigStub{}, nil
+				},
+			}
+
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			var gotOpts *ImportOptions
+			cmd := NewCmdConfigImport(f, func(opts *ImportOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.Flags().BoolP("help", "x", false, "")
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.ProfileFile, gotOpts.ProfileFile)
+			assert.Equal(t, tt.output.Force, gotOpts.Force)
+		})
+	}
+}
+
+func Test_importRun(t *testing.T) {
+	profile := heredoc.Doc(`
+		settings:
+		  editor: vim
+		aliases:
+		  co: pr checkout
+		defaults:
+		  pr list: --limit 50
+		hosts:
+		  github.com:
+		    git_protocol: ssh
+	`)
+
+	tests := []struct {
+		name       string
+		config     string
+		profile    string
+		force      bool
+		tty        bool
+		confirm    bool
+		wantErr    string
+		wantMain   []string
+		wantHosts  []string
+		wantStderr string
+	}{
+		{
+			name:       "apply profile",
+			config:     "",
+			profile:    profile,
+			tty:        true,
+			wantMain:   []string{"editor: vim", "co: pr checkout", "pr list: --limit 50"},
+			wantHosts:  []string{"git_protocol: ssh"},
+			wantStderr: "✓ Imported 4 configuration values\n",
+		},
+		{
+			name:       "conflict confirmed",
+			config:     "editor: emacs\n",
+			profile:    "settings:\n  editor: vim\n",
+			tty:        true,
+			confirm:    true,
+			wantMain:   []string{"editor: vim"},
+			wantStderr: "✓ Imported 1 configuration value\n",
+		},
+		{
+			name:       "conflict declined",
+			config:     "editor: emacs\n",
+			profile:    "settings:\n  editor: vim\n",
+			tty:        true,
+			confirm:    false,
+			wantStderr: "✓ Imported 0 configuration values\n",
+		},
+		{
+			name:    "conflict without prompts",
+			config:  "editor: emacs\n",
+			profile: "settings:\n  editor: vim\n",
+			wantErr: "editor is already set; pass `--force` to overwrite it in a non-interactive session",
+		},
+		{
+			name:     "conflict with force",
+			config:   "editor: emacs\n",
+			profile:  "settings:\n  editor: vim\n",
+			force:    true,
+			wantMain: []string{"editor: vim"},
+		},
+		{
+			name:    "invalid value",
+			config:  "",
+			profile: "settings:\n  git_protocol: ftp\n",
+			wantErr: `failed to set "git_protocol" to "ftp": invalid value`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mainBuf := bytes.Buffer{}
+			hostsBuf := bytes.Buffer{}
+			defer config.StubWriteConfig(&mainBuf, &hostsBuf)()
+			defer prompt.StubConfirm(tt.confirm)()
+
+			io, stdin, _, stderr := iostreams.Test()
+			io.SetStdinTTY(tt.tty)
+			io.SetStdoutTTY(tt.tty)
+			stdin.WriteString(tt.profile)
+
+			opts := &ImportOptions{
+				IO: io,
+				Config: func() (config.Config, error) {
+					return config.NewFromString(tt.config), nil
+				},
+				ProfileFile: "-",
+				Force:       tt.force,
+			}
+
+			err := importRun(opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			for _, want := range tt.wantMain {
+				assert.Contains(t, mainBuf.String(), want)
+			}
+			for _, want := range tt.wantHosts {
+				assert.Contains(t, hostsBuf.String(), want)
+			}
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
@@ -78,12 +78,17 @@ func Test_listRun(t *testing.T) {
 		{
 			name: "list",
 			config: config.ConfigStub{
-				"HOST:git_protocol":     "ssh",
-				"HOST:editor":           "/usr/bin/vim",
-				"HOST:prompt":           "disabled",
-				"HOST:pager":            "less",
-				"HOST:http_unix_socket": "",
-				"HOST:browser":          "brave",
+				"HOST:git_protocol":            "ssh",
+				"HOST:editor":                  "/usr/bin/vim",
+				"HOST:prompt":                  "disabled",
+				"HOST:pager":                   "less",
+				"HOST:http_unix_socket":        "",
+				"HOST:browser":                 "brave",
+				"HOST:request_timeout":         "",
+				"HOST:accessible":              "disabled",
+				"HOST:telemetry":               "disabled",
+				"HOST:pr_merge_commit_subject": "{title} (#{number})",
+				"HOST:pr_merge_commit_body":    "",
 			},
 			input: &ListOptions{Hostname: "HOST"}, // ConfigStub gives empty DefaultHost
 			stdout: `git_protocol=ssh
@@ -92,6 +97,11 @@ prompt=disabled
 pager=less
 http_unix_socket=
 browser=brave
+request_timeout=
+accessible=disabled
+telemetry=disabled
+pr_merge_commit_subject={title} (#{number})
+pr_merge_commit_body=
 `,
 		},
 	}
@@ -9,9 +9,14 @@ import (
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/safeexec"
+	shellquote "github.com/kballard/go-shellquote"
 	"github.com/spf13/cobra"
 )
 
+// stubbed in tests
+var lookPath = safeexec.LookPath
+
 type SetOptions struct {
 	IO     *iostreams.IOStreams
 	Config config.Config
@@ -77,6 +82,12 @@ func setRun(opts *SetOptions) error {
 		}
 	}
 
+	if opts.Key == "editor" && opts.Value != "" {
+		if err := validateEditorValue(opts.Value); err != nil {
+			return fmt.Errorf("failed to set %q to %q: %w", opts.Key, opts.Value, err)
+		}
+	}
+
 	err = opts.Config.Set(opts.Hostname, opts.Key, opts.Value)
 	if err != nil {
 		return fmt.Errorf("failed to set %q to %q: %w", opts.Key, opts.Value, err)
@@ -88,3 +99,19 @@ func setRun(opts *SetOptions) error {
 	}
 	return nil
 }
+
+// validateEditorValue ensures that an editor command, which may carry its own
+// arguments and flags, names a program that can be found on PATH
+func validateEditorValue(value string) error {
+	args, err := shellquote.Split(value)
+	if err != nil {
+		return fmt.Errorf("invalid editor command: %w", err)
+	}
+	if len(args) == 0 {
+		return nil
+	}
+	if _, err := lookPath(args[0]); err != nil {
+		return fmt.Errorf("no %q found on PATH", args[0])
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package set
 
 import (
 	"bytes"
+	"fmt"
 	"testing"
 
 	"github.com/cli/cli/v2/internal/config"
@@ -130,7 +131,46 @@ func Test_setRun(t *testing.T) {
 			wantsErr: true,
 			errMsg:   "failed to set \"git_protocol\" to \"invalid\": valid values are 'https', 'ssh'",
 		},
+		{
+			name: "set editor with arguments",
+			input: &SetOptions{
+				Config: config.ConfigStub{},
+				Key:    "editor",
+				Value:  "vim --clean",
+			},
+			expectedValue: "vim --clean",
+		},
+		{
+			name: "set editor with missing binary",
+			input: &SetOptions{
+				Config: config.ConfigStub{},
+				Key:    "editor",
+				Value:  "nonexistent --wait",
+			},
+			wantsErr: true,
+			errMsg:   "failed to set \"editor\" to \"nonexistent --wait\": no \"nonexistent\" found on PATH",
+		},
+		{
+			name: "set editor with unbalanced quotes",
+			input: &SetOptions{
+				Config: config.ConfigStub{},
+				Key:    "editor",
+				Value:  "code '--wait",
+			},
+			wantsErr: true,
+			errMsg:   "failed to set \"editor\" to \"code '--wait\": invalid editor command: Unterminated single-quoted string",
+		},
 	}
+
+	origLookPath := lookPath
+	lookPath = func(file string) (string, error) {
+		if file == "nonexistent" {
+			return "", fmt.Errorf("exec: %q: executable file not found in $PATH", file)
+		}
+		return "/usr/bin/" + file, nil
+	}
+	defer func() { lookPath = origLookPath }()
+
 	for _, tt := range tests {
 		io, _, stdout, stderr := iostreams.Test()
 		tt.input.IO = io
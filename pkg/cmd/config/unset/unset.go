@@ -0,0 +1,72 @@
+package unset
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type UnsetOptions struct {
+	IO     *iostreams.IOStreams
+	Config config.Config
+
+	Key      string
+	Hostname string
+}
+
+func NewCmdConfigUnset(f *cmdutil.Factory, runF func(*UnsetOptions) error) *cobra.Command {
+	opts := &UnsetOptions{
+		IO: f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "unset <key>",
+		Short: "Remove the value of a given configuration key",
+		Example: heredoc.Doc(`
+			$ gh config unset editor
+			$ gh config unset git_protocol --host github.com
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := f.Config()
+			if err != nil {
+				return err
+			}
+			opts.Config = config
+			opts.Key = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return unsetRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Hostname, "host", "h", "", "Remove per-host setting")
+
+	return cmd
+}
+
+func unsetRun(opts *UnsetOptions) error {
+	err := config.ValidateKey(opts.Key)
+	if err != nil {
+		warningIcon := opts.IO.ColorScheme().WarningIcon()
+		fmt.Fprintf(opts.IO.ErrOut, "%s warning: '%s' is not a known configuration key\n", warningIcon, opts.Key)
+	}
+
+	err = opts.Config.Unset(opts.Hostname, opts.Key)
+	if err != nil {
+		return fmt.Errorf("failed to unset %q: %w", opts.Key, err)
+	}
+
+	err = opts.Config.Write()
+	if err != nil {
+		return fmt.Errorf("failed to write config to disk: %w", err)
+	}
+	return nil
+}
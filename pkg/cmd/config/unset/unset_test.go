@@ -0,0 +1,126 @@
+package unset
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdConfigUnset(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		output   UnsetOptions
+		wantsErr bool
+	}{
+		{
+			name:     "no arguments",
+			input:    "",
+			output:   UnsetOptions{},
+			wantsErr: true,
+		},
+		{
+			name:     "unset key",
+			input:    "key",
+			output:   UnsetOptions{Key: "key"},
+			wantsErr: false,
+		},
+		{
+			name:     "unset key with host",
+			input:    "key --host test.com",
+			output:   UnsetOptions{Hostname: "test.com", Key: "key"},
+			wantsErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{
+				Config: func() (config.Config, error) {
+					return config.ConfigStub{}, nil
+				},
+			}
+
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			var gotOpts *UnsetOptions
+			cmd := NewCmdConfigUnset(f, func(opts *UnsetOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.Flags().BoolP("help", "x", false, "")
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.Hostname, gotOpts.Hostname)
+			assert.Equal(t, tt.output.Key, gotOpts.Key)
+		})
+	}
+}
+
+func Test_unsetRun(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *UnsetOptions
+		stdout string
+		stderr string
+	}{
+		{
+			name: "unset key",
+			input: &UnsetOptions{
+				Config: config.ConfigStub{"editor": "vim"},
+				Key:    "editor",
+			},
+		},
+		{
+			name: "unset key scoped by host",
+			input: &UnsetOptions{
+				Config:   config.ConfigStub{"github.com:editor": "vim"},
+				Hostname: "github.com",
+				Key:      "editor",
+			},
+		},
+		{
+			name: "unset unknown key",
+			input: &UnsetOptions{
+				Config: config.ConfigStub{"unknownKey": "someValue"},
+				Key:    "unknownKey",
+			},
+			stderr: "! warning: 'unknownKey' is not a known configuration key\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, stdout, stderr := iostreams.Test()
+			tt.input.IO = io
+
+			err := unsetRun(tt.input)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.stdout, stdout.String())
+			assert.Equal(t, tt.stderr, stderr.String())
+
+			cfg := tt.input.Config.(config.ConfigStub)
+			val, err := cfg.Get(tt.input.Hostname, tt.input.Key)
+			assert.Error(t, err)
+			assert.Equal(t, "", val)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package dependabot
+
+import (
+	cmdList "github.com/cli/cli/v2/pkg/cmd/dependabot/list"
+	cmdMerge "github.com/cli/cli/v2/pkg/cmd/dependabot/merge"
+	cmdRebase "github.com/cli/cli/v2/pkg/cmd/dependabot/rebase"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdDependabot(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dependabot <command>",
+		Short: "Manage dependabot pull requests",
+		Long:  "Work with pull requests opened by dependabot in a GitHub repository.",
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdRebase.NewCmdRebase(f, nil))
+	cmd.AddCommand(cmdMerge.NewCmdMerge(f, nil))
+
+	return cmd
+}
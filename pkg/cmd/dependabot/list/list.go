@@ -0,0 +1,103 @@
+package list
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/dependabot/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	LimitResults int
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List open dependabot pull requests",
+		Long:  "List open pull requests authored by dependabot along with the kind of version bump each performs.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.LimitResults < 1 {
+				return cmdutil.FlagErrorf("invalid value for --limit: %v", opts.LimitResults)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.LimitResults, "limit", "L", 30, "Maximum number of items to fetch")
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	prs, err := shared.ListPullRequests(httpClient, baseRepo, opts.LimitResults)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	table := utils.NewTablePrinter(opts.IO)
+	cs := opts.IO.ColorScheme()
+	for _, pr := range prs {
+		prNum := strconv.Itoa(pr.Number)
+		if table.IsTTY() {
+			prNum = "#" + prNum
+		}
+		table.AddField(prNum, nil, cs.Cyan)
+		table.AddField(pr.Title, nil, nil)
+
+		updateType := pr.UpdateType()
+		var typeColor func(string) string
+		switch updateType {
+		case shared.UpdateTypeMajor:
+			typeColor = cs.Red
+		case shared.UpdateTypeMinor:
+			typeColor = cs.Yellow
+		case shared.UpdateTypePatch:
+			typeColor = cs.Green
+		}
+		table.AddField(updateType, nil, typeColor)
+
+		createdAt := pr.CreatedAt.Format(time.RFC3339)
+		if table.IsTTY() {
+			createdAt = utils.FuzzyAgo(now.Sub(pr.CreatedAt))
+		}
+		table.AddField(createdAt, nil, cs.Gray)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
@@ -0,0 +1,63 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_listRun(t *testing.T) {
+	fakeHTTP := &httpmock.Registry{}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.Register(httpmock.GraphQL(`\bDependabotPullRequestList\(`), httpmock.StringResponse(`
+	{ "data": { "search": {
+		"nodes": [
+			{
+				"id": "PR_1",
+				"number": 42,
+				"title": "Bump lodash from 4.17.20 to 4.17.21",
+				"headRefName": "dependabot/npm_and_yarn/lodash-4.17.21",
+				"createdAt": "2022-04-01T10:00:00Z",
+				"commits": { "nodes": [
+					{ "commit": { "statusCheckRollup": { "state": "SUCCESS" } } }
+				] }
+			},
+			{
+				"id": "PR_2",
+				"number": 43,
+				"title": "Bump eslint from 7.32.0 to 8.0.0",
+				"headRefName": "dependabot/npm_and_yarn/eslint-8.0.0",
+				"createdAt": "2022-04-02T10:00:00Z",
+				"commits": { "nodes": [] }
+			}
+		],
+		"pageInfo": { "hasNextPage": false }
+	} } }`))
+
+	io, _, stdout, stderr := iostreams.Test()
+	opts := ListOptions{
+		IO:           io,
+		LimitResults: 30,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	err := listRun(&opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		42	Bump lodash from 4.17.20 to 4.17.21	patch	2022-04-01T10:00:00Z
+		43	Bump eslint from 7.32.0 to 8.0.0	major	2022-04-02T10:00:00Z
+	`), stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
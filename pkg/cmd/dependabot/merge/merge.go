@@ -0,0 +1,93 @@
+package merge
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/dependabot/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type MergeOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+}
+
+func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Command {
+	opts := &MergeOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "merge",
+		Short: "Merge dependabot patch updates with passing checks",
+		Long:  "Merge every open dependabot pull request that bumps a patch version and whose checks are passing. Major and minor updates, and pull requests with failing or pending checks, are skipped.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return mergeRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func mergeRun(opts *MergeOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	prs, err := shared.ListPullRequests(httpClient, baseRepo, 100)
+	if err != nil {
+		return err
+	}
+
+	if len(prs) == 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "no open dependabot pull requests in %s\n", ghrepo.FullName(baseRepo))
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	merged := 0
+	for _, pr := range prs {
+		if updateType := pr.UpdateType(); updateType != shared.UpdateTypePatch {
+			if updateType == "" {
+				updateType = "unknown"
+			}
+			fmt.Fprintf(opts.IO.ErrOut, "%s Skipping pull request #%d: %s update\n", cs.WarningIcon(), pr.Number, updateType)
+			continue
+		}
+		if pr.ChecksState != "SUCCESS" {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Skipping pull request #%d: checks are not passing\n", cs.WarningIcon(), pr.Number)
+			continue
+		}
+
+		if err := shared.MergePullRequest(httpClient, baseRepo, pr.ID); err != nil {
+			return fmt.Errorf("failed to merge pull request #%d: %w", pr.Number, err)
+		}
+		fmt.Fprintf(opts.IO.ErrOut, "%s Merged pull request #%d\n", cs.SuccessIcon(), pr.Number)
+		merged++
+	}
+
+	if merged == 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "no dependabot pull requests eligible for merging in %s\n", ghrepo.FullName(baseRepo))
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package merge
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_mergeRun(t *testing.T) {
+	fakeHTTP := &httpmock.Registry{}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.Register(httpmock.GraphQL(`\bDependabotPullRequestList\(`), httpmock.StringResponse(`
+	{ "data": { "search": {
+		"nodes": [
+			{
+				"id": "PR_1",
+				"number": 42,
+				"title": "Bump lodash from 4.17.20 to 4.17.21",
+				"commits": { "nodes": [
+					{ "commit": { "statusCheckRollup": { "state": "SUCCESS" } } }
+				] }
+			},
+			{
+				"id": "PR_2",
+				"number": 43,
+				"title": "Bump eslint from 7.32.0 to 8.0.0",
+				"commits": { "nodes": [
+					{ "commit": { "statusCheckRollup": { "state": "SUCCESS" } } }
+				] }
+			},
+			{
+				"id": "PR_3",
+				"number": 44,
+				"title": "Bump ws from 7.4.5 to 7.4.6",
+				"commits": { "nodes": [
+					{ "commit": { "statusCheckRollup": { "state": "FAILURE" } } }
+				] }
+			}
+		],
+		"pageInfo": { "hasNextPage": false }
+	} } }`))
+	fakeHTTP.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.GraphQLMutation(`{}`, func(inputs map[string]interface{}) {
+			assert.Equal(t, "PR_1", inputs["pullRequestId"])
+		}))
+
+	io, _, stdout, stderr := iostreams.Test()
+	opts := MergeOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	err := mergeRun(&opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, heredoc.Doc(`
+		✓ Merged pull request #42
+		! Skipping pull request #43: major update
+		! Skipping pull request #44: checks are not passing
+	`), stderr.String())
+}
@@ -0,0 +1,81 @@
+package rebase
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/dependabot/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RebaseOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+}
+
+func NewCmdRebase(f *cmdutil.Factory, runF func(*RebaseOptions) error) *cobra.Command {
+	opts := &RebaseOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "rebase",
+		Short: "Request a rebase of all open dependabot pull requests",
+		Long:  "Comment `@dependabot rebase` on every open dependabot pull request in the repository.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return rebaseRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func rebaseRun(opts *RebaseOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	prs, err := shared.ListPullRequests(httpClient, baseRepo, 100)
+	if err != nil {
+		return err
+	}
+
+	if len(prs) == 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "no open dependabot pull requests in %s\n", ghrepo.FullName(baseRepo))
+		return nil
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	cs := opts.IO.ColorScheme()
+	for _, pr := range prs {
+		_, err := api.CommentCreate(apiClient, baseRepo.RepoHost(), api.CommentCreateInput{
+			Body:      "@dependabot rebase",
+			SubjectId: pr.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to request rebase of pull request #%d: %w", pr.Number, err)
+		}
+		fmt.Fprintf(opts.IO.ErrOut, "%s Requested rebase of pull request #%d\n", cs.SuccessIcon(), pr.Number)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package rebase
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_rebaseRun(t *testing.T) {
+	fakeHTTP := &httpmock.Registry{}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.Register(httpmock.GraphQL(`\bDependabotPullRequestList\(`), httpmock.StringResponse(`
+	{ "data": { "search": {
+		"nodes": [
+			{
+				"id": "PR_1",
+				"number": 42,
+				"title": "Bump lodash from 4.17.20 to 4.17.21",
+				"commits": { "nodes": [] }
+			},
+			{
+				"id": "PR_2",
+				"number": 43,
+				"title": "Bump eslint from 7.32.0 to 8.0.0",
+				"commits": { "nodes": [] }
+			}
+		],
+		"pageInfo": { "hasNextPage": false }
+	} } }`))
+
+	var mu sync.Mutex
+	commented := map[string]string{}
+	commentStub := func(inputs map[string]interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+		commented[inputs["subjectId"].(string)] = inputs["body"].(string)
+	}
+	fakeHTTP.Register(
+		httpmock.GraphQL(`mutation CommentCreate\b`),
+		httpmock.GraphQLMutation(`{ "data": { "addComment": { "commentEdge": { "node": {
+			"url": "https://github.com/OWNER/REPO/pull/42#issuecomment-1"
+		} } } } }`, commentStub))
+	fakeHTTP.Register(
+		httpmock.GraphQL(`mutation CommentCreate\b`),
+		httpmock.GraphQLMutation(`{ "data": { "addComment": { "commentEdge": { "node": {
+			"url": "https://github.com/OWNER/REPO/pull/43#issuecomment-2"
+		} } } } }`, commentStub))
+
+	io, _, stdout, stderr := iostreams.Test()
+	opts := RebaseOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	err := rebaseRun(&opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"PR_1": "@dependabot rebase",
+		"PR_2": "@dependabot rebase",
+	}, commented)
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, heredoc.Doc(`
+		✓ Requested rebase of pull request #42
+		✓ Requested rebase of pull request #43
+	`), stderr.String())
+}
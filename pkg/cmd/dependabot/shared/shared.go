@@ -0,0 +1,175 @@
+package shared
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/githubsearch"
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/shurcooL/githubv4"
+)
+
+// DependabotLogin is the login under which dependabot authors pull requests.
+const DependabotLogin = "app/dependabot"
+
+const (
+	UpdateTypeMajor = "major"
+	UpdateTypeMinor = "minor"
+	UpdateTypePatch = "patch"
+)
+
+// PullRequest is an open pull request authored by dependabot.
+type PullRequest struct {
+	ID          string
+	Number      int
+	Title       string
+	HeadRefName string
+	CreatedAt   time.Time
+	ChecksState string
+}
+
+// UpdateType returns the kind of version bump the pull request performs.
+func (pr PullRequest) UpdateType() string {
+	return UpdateType(pr.Title)
+}
+
+var bumpPattern = regexp.MustCompile(`(?i)\bbumps? .+ from v?([0-9]+(?:\.[0-9]+)*)\S* to v?([0-9]+(?:\.[0-9]+)*)`)
+
+// UpdateType classifies a dependabot pull request title such as "Bump lodash
+// from 4.17.20 to 4.17.21" as a major, minor, or patch update by comparing the
+// two versions. It returns an empty string when the title does not describe a
+// version bump.
+func UpdateType(title string) string {
+	m := bumpPattern.FindStringSubmatch(title)
+	if m == nil {
+		return ""
+	}
+
+	from := strings.Split(m[1], ".")
+	to := strings.Split(m[2], ".")
+	for i := 0; i < len(from) && i < len(to); i++ {
+		if from[i] == to[i] {
+			continue
+		}
+		switch i {
+		case 0:
+			return UpdateTypeMajor
+		case 1:
+			return UpdateTypeMinor
+		default:
+			return UpdateTypePatch
+		}
+	}
+	if len(from) != len(to) {
+		return UpdateTypePatch
+	}
+	return ""
+}
+
+// ListPullRequests returns up to limit open pull requests authored by
+// dependabot in the repository, newest first.
+func ListPullRequests(httpClient *http.Client, repo ghrepo.Interface, limit int) ([]PullRequest, error) {
+	type responseData struct {
+		Search struct {
+			Nodes []struct {
+				PullRequest struct {
+					ID          string
+					Number      int
+					Title       string
+					HeadRefName string
+					CreatedAt   time.Time
+					Commits     struct {
+						Nodes []struct {
+							Commit struct {
+								StatusCheckRollup struct {
+									State string
+								}
+							}
+						}
+					} `graphql:"commits(last: 1)"`
+				} `graphql:"... on PullRequest"`
+			}
+			PageInfo struct {
+				HasNextPage bool
+				EndCursor   string
+			}
+		} `graphql:"search(query: $q, type: ISSUE, first: $perPage, after: $endCursor)"`
+	}
+
+	q := githubsearch.NewQuery()
+	q.SetType(githubsearch.PullRequest)
+	q.SetState(githubsearch.Open)
+	q.InRepository(ghrepo.FullName(repo))
+	q.AuthoredBy(DependabotLogin)
+
+	perPage := limit
+	if limit > 100 {
+		perPage = 100
+	}
+
+	variables := map[string]interface{}{
+		"q":         githubv4.String(q.String()),
+		"perPage":   githubv4.Int(perPage),
+		"endCursor": (*githubv4.String)(nil),
+	}
+
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), httpClient)
+
+	var prs []PullRequest
+loop:
+	for {
+		var query responseData
+		err := gql.QueryNamed(context.Background(), "DependabotPullRequestList", &query, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range query.Search.Nodes {
+			pr := PullRequest{
+				ID:          node.PullRequest.ID,
+				Number:      node.PullRequest.Number,
+				Title:       node.PullRequest.Title,
+				HeadRefName: node.PullRequest.HeadRefName,
+				CreatedAt:   node.PullRequest.CreatedAt,
+			}
+			if nodes := node.PullRequest.Commits.Nodes; len(nodes) > 0 {
+				pr.ChecksState = nodes[0].Commit.StatusCheckRollup.State
+			}
+			prs = append(prs, pr)
+			if len(prs) == limit {
+				break loop
+			}
+		}
+
+		if !query.Search.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = githubv4.String(query.Search.PageInfo.EndCursor)
+	}
+
+	return prs, nil
+}
+
+// MergePullRequest merges the pull request using the repository's default
+// merge method.
+func MergePullRequest(httpClient *http.Client, repo ghrepo.Interface, prID string) error {
+	var mutation struct {
+		MergePullRequest struct {
+			ClientMutationId string
+		} `graphql:"mergePullRequest(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.MergePullRequestInput{
+			PullRequestID: githubv4.ID(prID),
+		},
+	}
+
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), httpClient)
+	return gql.MutateNamed(context.Background(), "PullRequestMerge", &mutation, variables)
+}
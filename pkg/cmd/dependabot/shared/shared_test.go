@@ -0,0 +1,26 @@
+package shared
+
+import "testing"
+
+func TestUpdateType(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Bump lodash from 4.17.20 to 4.17.21", UpdateTypePatch},
+		{"Bump eslint from 7.32.0 to 8.0.0", UpdateTypeMajor},
+		{"Bump actions/checkout from 2 to 3", UpdateTypeMajor},
+		{"build(deps): bump github.com/spf13/cobra from 1.4.0 to 1.5.0", UpdateTypeMinor},
+		{"Bumps ws from 7.4.5 to 7.4.6", UpdateTypePatch},
+		{"Bump golang.org/x/crypto from v0.0.0-20210817164053 to v0.0.0-20220411220226", ""},
+		{"Update README", ""},
+		{"Bump foo from 1.2.3 to 1.2.3", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			if got := UpdateType(tt.title); got != tt.want {
+				t.Errorf("UpdateType(%q) = %q, want %q", tt.title, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package categories
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/discussion/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type CategoriesOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+}
+
+func NewCmdCategories(f *cmdutil.Factory, runF func(*CategoriesOptions) error) *cobra.Command {
+	opts := &CategoriesOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "categories",
+		Short: "List discussion categories in a repository",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return categoriesRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func categoriesRun(opts *CategoriesOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	categories, err := shared.ListCategories(apiClient, baseRepo)
+	if err != nil {
+		return err
+	}
+
+	if len(categories) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "No discussion categories found in %s\n", ghrepo.FullName(baseRepo))
+		}
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	table := utils.NewTablePrinter(opts.IO)
+	for _, category := range categories {
+		table.AddField(category.Slug, nil, cs.Bold)
+		table.AddField(category.Name, nil, nil)
+		answerable := ""
+		if category.IsAnswerable {
+			answerable = "answerable"
+		}
+		table.AddField(answerable, nil, cs.Gray)
+		table.AddField(category.Description, nil, nil)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
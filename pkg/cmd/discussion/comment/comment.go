@@ -0,0 +1,116 @@
+package comment
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/discussion/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CommentOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	SelectorArg  string
+	Body         string
+	MarkAsAnswer bool
+}
+
+func NewCmdComment(f *cmdutil.Factory, runF func(*CommentOptions) error) *cobra.Command {
+	opts := &CommentOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	var bodyFile string
+
+	cmd := &cobra.Command{
+		Use:   "comment <number>",
+		Short: "Comment on a discussion",
+		Example: heredoc.Doc(`
+			$ gh discussion comment 123 --body "How about a release?"
+			$ gh discussion comment 123 --body-file answer.md --mark-as-answer
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot comment: discussion number argument required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.SelectorArg = args[0]
+
+			if cmd.Flags().Changed("body") && bodyFile != "" {
+				return cmdutil.FlagErrorf("specify only one of `--body` or `--body-file`")
+			}
+			if bodyFile != "" {
+				b, err := cmdutil.ReadFile(bodyFile, opts.IO.In)
+				if err != nil {
+					return err
+				}
+				opts.Body = string(b)
+			}
+			if opts.Body == "" {
+				return cmdutil.FlagErrorf("`--body` or `--body-file` required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return commentRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "The comment body `text`")
+	cmd.Flags().StringVarP(&bodyFile, "body-file", "F", "", "Read body text from `file` (use \"-\" to read from standard input)")
+	cmd.Flags().BoolVar(&opts.MarkAsAnswer, "mark-as-answer", false, "Mark the new comment as the answer to the discussion")
+
+	return cmd
+}
+
+func commentRun(opts *CommentOptions) error {
+	number, err := strconv.Atoi(opts.SelectorArg)
+	if err != nil {
+		return fmt.Errorf("invalid discussion number: %q", opts.SelectorArg)
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	discussion, err := shared.FindDiscussion(apiClient, baseRepo, number)
+	if err != nil {
+		return err
+	}
+
+	if opts.MarkAsAnswer && !discussion.Category.IsAnswerable {
+		return fmt.Errorf("discussions in the %q category cannot be answered", discussion.Category.Name)
+	}
+
+	comment, err := shared.AddComment(apiClient, baseRepo, discussion.ID, opts.Body)
+	if err != nil {
+		return err
+	}
+
+	if opts.MarkAsAnswer {
+		if err := shared.MarkCommentAsAnswer(apiClient, baseRepo, comment.ID); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintln(opts.IO.Out, comment.URL)
+	return nil
+}
@@ -0,0 +1,201 @@
+package comment
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdComment(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    CommentOptions
+		wantsErr string
+	}{
+		{
+			name: "body flag",
+			cli:  `123 --body "hello"`,
+			wants: CommentOptions{
+				SelectorArg: "123",
+				Body:        "hello",
+			},
+		},
+		{
+			name: "mark as answer",
+			cli:  `123 -b "hello" --mark-as-answer`,
+			wants: CommentOptions{
+				SelectorArg:  "123",
+				Body:         "hello",
+				MarkAsAnswer: true,
+			},
+		},
+		{
+			name:     "no body",
+			cli:      "123",
+			wantsErr: "`--body` or `--body-file` required",
+		},
+		{
+			name:     "no argument",
+			cli:      `--body "hello"`,
+			wantsErr: "cannot comment: discussion number argument required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *CommentOptions
+			cmd := NewCmdComment(f, func(opts *CommentOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.SelectorArg, gotOpts.SelectorArg)
+			assert.Equal(t, tt.wants.Body, gotOpts.Body)
+			assert.Equal(t, tt.wants.MarkAsAnswer, gotOpts.MarkAsAnswer)
+		})
+	}
+}
+
+func TestCommentRun(t *testing.T) {
+	discussionResponse := `
+	{ "data": { "repository": { "discussion": {
+		"id": "D_1",
+		"number": 123,
+		"title": "How do I install?",
+		"category": {"name": "Q&A", "isAnswerable": true}
+	} } } }`
+
+	tests := []struct {
+		name      string
+		opts      CommentOptions
+		httpStubs func(*testing.T, *httpmock.Registry)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "posts a comment",
+			opts: CommentOptions{
+				SelectorArg: "123",
+				Body:        "try the installer",
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query DiscussionByNumber\b`),
+					httpmock.StringResponse(discussionResponse))
+				reg.Register(
+					httpmock.GraphQL(`mutation DiscussionCommentAdd\b`),
+					httpmock.GraphQLMutation(`
+					{ "data": { "addDiscussionComment": { "comment": {
+						"id": "DC_1",
+						"url": "https://github.com/OWNER/REPO/discussions/123#discussioncomment-1"
+					} } } }`, func(inputs map[string]interface{}) {
+						assert.Equal(t, "D_1", inputs["discussionId"])
+						assert.Equal(t, "try the installer", inputs["body"])
+					}))
+			},
+			wantOut: "https://github.com/OWNER/REPO/discussions/123#discussioncomment-1\n",
+		},
+		{
+			name: "marks the comment as the answer",
+			opts: CommentOptions{
+				SelectorArg:  "123",
+				Body:         "try the installer",
+				MarkAsAnswer: true,
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query DiscussionByNumber\b`),
+					httpmock.StringResponse(discussionResponse))
+				reg.Register(
+					httpmock.GraphQL(`mutation DiscussionCommentAdd\b`),
+					httpmock.StringResponse(`
+					{ "data": { "addDiscussionComment": { "comment": {
+						"id": "DC_1",
+						"url": "https://github.com/OWNER/REPO/discussions/123#discussioncomment-1"
+					} } } }`))
+				reg.Register(
+					httpmock.GraphQL(`mutation DiscussionMarkAnswer\b`),
+					httpmock.GraphQLMutation(`{"data": {} }`, func(inputs map[string]interface{}) {
+						assert.Equal(t, "DC_1", inputs["id"])
+					}))
+			},
+			wantOut: "https://github.com/OWNER/REPO/discussions/123#discussioncomment-1\n",
+		},
+		{
+			name: "cannot answer an unanswerable category",
+			opts: CommentOptions{
+				SelectorArg:  "123",
+				Body:         "try the installer",
+				MarkAsAnswer: true,
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query DiscussionByNumber\b`),
+					httpmock.StringResponse(`
+					{ "data": { "repository": { "discussion": {
+						"id": "D_1",
+						"number": 123,
+						"category": {"name": "General", "isAnswerable": false}
+					} } } }`))
+			},
+			wantErr: `discussions in the "General" category cannot be answered`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(t, reg)
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+
+			opts := tt.opts
+			opts.IO = io
+			opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			err := commentRun(&opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package discussion
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	categoriesCmd "github.com/cli/cli/v2/pkg/cmd/discussion/categories"
+	commentCmd "github.com/cli/cli/v2/pkg/cmd/discussion/comment"
+	listCmd "github.com/cli/cli/v2/pkg/cmd/discussion/list"
+	viewCmd "github.com/cli/cli/v2/pkg/cmd/discussion/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdDiscussion(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "discussion <command>",
+		Short: "Work with GitHub Discussions",
+		Long:  `Browse and comment on discussions in a GitHub repository.`,
+		Example: heredoc.Doc(`
+			$ gh discussion list
+			$ gh discussion view 123
+			$ gh discussion comment 123 --body "Thanks for raising this!"
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(listCmd.NewCmdList(f, nil))
+	cmd.AddCommand(viewCmd.NewCmdView(f, nil))
+	cmd.AddCommand(commentCmd.NewCmdComment(f, nil))
+	cmd.AddCommand(categoriesCmd.NewCmdCategories(f, nil))
+
+	return cmd
+}
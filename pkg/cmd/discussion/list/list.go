@@ -0,0 +1,121 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/discussion/shared"
+	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/text"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Limit    int
+	Category string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List discussions in a repository",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of discussions to fetch")
+	cmd.Flags().StringVarP(&opts.Category, "category", "c", "", "Filter by category slug")
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	categoryID := ""
+	if opts.Category != "" {
+		category, err := shared.CategoryBySlug(apiClient, baseRepo, opts.Category)
+		if err != nil {
+			return err
+		}
+		categoryID = category.ID
+	}
+
+	discussions, totalCount, err := shared.ListDiscussions(apiClient, baseRepo, categoryID, opts.Limit)
+	if err != nil {
+		return err
+	}
+
+	if err := opts.IO.StartPager(); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	if len(discussions) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "No discussions found in %s\n", ghrepo.FullName(baseRepo))
+		}
+		return nil
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		title := prShared.ListHeader(ghrepo.FullName(baseRepo), "discussion", len(discussions), totalCount, opts.Category != "")
+		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", title)
+	}
+
+	cs := opts.IO.ColorScheme()
+	table := utils.NewTablePrinter(opts.IO)
+	for _, discussion := range discussions {
+		number := fmt.Sprintf("%d", discussion.Number)
+		if table.IsTTY() {
+			number = "#" + number
+		}
+		table.AddField(number, nil, cs.Green)
+		table.AddField(text.ReplaceExcessiveWhitespace(discussion.Title), nil, nil)
+		table.AddField(discussion.Category.Name, nil, cs.Cyan)
+		if table.IsTTY() {
+			table.AddField(utils.FuzzyAgo(time.Since(discussion.CreatedAt)), nil, cs.Gray)
+		} else {
+			table.AddField(discussion.CreatedAt.String(), nil, nil)
+		}
+		table.EndRow()
+	}
+
+	return table.Render()
+}
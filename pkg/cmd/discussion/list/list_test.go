@@ -0,0 +1,163 @@
+package list
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdList(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    ListOptions
+		wantsErr string
+	}{
+		{
+			name: "blank",
+			wants: ListOptions{
+				Limit: 30,
+			},
+		},
+		{
+			name: "limit and category",
+			cli:  "-L 10 -c ideas",
+			wants: ListOptions{
+				Limit:    10,
+				Category: "ideas",
+			},
+		},
+		{
+			name:     "invalid limit",
+			cli:      "-L 0",
+			wantsErr: "invalid limit: 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *ListOptions
+			cmd := NewCmdList(f, func(opts *ListOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Limit, gotOpts.Limit)
+			assert.Equal(t, tt.wants.Category, gotOpts.Category)
+		})
+	}
+}
+
+func TestListRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       ListOptions
+		httpStubs  func(*testing.T, *httpmock.Registry)
+		wantStdout string
+	}{
+		{
+			name: "lists discussions",
+			opts: ListOptions{
+				Limit: 30,
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query DiscussionList\b`),
+					httpmock.GraphQLQuery(`
+					{ "data": { "repository": { "discussions": {
+						"totalCount": 2,
+						"nodes": [
+							{"number": 5, "title": "Release cadence", "category": {"name": "Ideas"}, "createdAt": "2022-04-01T12:00:00Z"},
+							{"number": 3, "title": "How do I install?", "category": {"name": "Q&A"}, "createdAt": "2022-03-01T12:00:00Z"}
+						],
+						"pageInfo": {"hasNextPage": false}
+					} } } }`, func(_ string, params map[string]interface{}) {
+						assert.Equal(t, "OWNER", params["owner"])
+						assert.Equal(t, "REPO", params["repo"])
+						assert.Equal(t, float64(30), params["limit"])
+					}))
+			},
+			wantStdout: "5\tRelease cadence\tIdeas\t2022-04-01 12:00:00 +0000 UTC\n3\tHow do I install?\tQ&A\t2022-03-01 12:00:00 +0000 UTC\n",
+		},
+		{
+			name: "filters by category",
+			opts: ListOptions{
+				Limit:    30,
+				Category: "ideas",
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query DiscussionCategoryList\b`),
+					httpmock.StringResponse(`
+					{ "data": { "repository": { "discussionCategories": { "nodes": [
+						{"id": "CAT1", "name": "Ideas", "slug": "ideas"}
+					] } } } }`))
+				reg.Register(
+					httpmock.GraphQL(`query DiscussionList\b`),
+					httpmock.GraphQLQuery(`
+					{ "data": { "repository": { "discussions": {
+						"totalCount": 1,
+						"nodes": [
+							{"number": 5, "title": "Release cadence", "category": {"name": "Ideas"}, "createdAt": "2022-04-01T12:00:00Z"}
+						],
+						"pageInfo": {"hasNextPage": false}
+					} } } }`, func(_ string, params map[string]interface{}) {
+						assert.Equal(t, "CAT1", params["categoryId"])
+					}))
+			},
+			wantStdout: "5\tRelease cadence\tIdeas\t2022-04-01 12:00:00 +0000 UTC\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(t, reg)
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+
+			opts := tt.opts
+			opts.IO = io
+			opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			err := listRun(&opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
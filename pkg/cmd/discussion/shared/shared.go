@@ -0,0 +1,254 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+type Category struct {
+	ID           string
+	Name         string
+	Slug         string
+	Description  string
+	IsAnswerable bool
+}
+
+type Comment struct {
+	ID  string
+	URL string
+}
+
+type Discussion struct {
+	ID        string
+	Number    int
+	Title     string
+	Body      string
+	URL       string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Author struct {
+		Login string
+	}
+	Category Category
+	Comments struct {
+		TotalCount int
+	}
+	Answer *Comment
+}
+
+// ListCategories returns the discussion categories configured for a repository.
+func ListCategories(client *api.Client, repo ghrepo.Interface) ([]Category, error) {
+	var result struct {
+		Repository struct {
+			DiscussionCategories struct {
+				Nodes []Category
+			}
+		}
+	}
+
+	query := `
+	query DiscussionCategoryList($owner: String!, $repo: String!) {
+		repository(owner: $owner, name: $repo) {
+			discussionCategories(first: 25) {
+				nodes {
+					id
+					name
+					slug
+					description
+					isAnswerable
+				}
+			}
+		}
+	}`
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+	}
+
+	if err := client.GraphQL(repo.RepoHost(), query, variables, &result); err != nil {
+		return nil, err
+	}
+	return result.Repository.DiscussionCategories.Nodes, nil
+}
+
+// CategoryBySlug looks up a discussion category by its slug or name.
+func CategoryBySlug(client *api.Client, repo ghrepo.Interface, slug string) (*Category, error) {
+	categories, err := ListCategories(client, repo)
+	if err != nil {
+		return nil, err
+	}
+	for _, category := range categories {
+		if strings.EqualFold(category.Slug, slug) || strings.EqualFold(category.Name, slug) {
+			return &category, nil
+		}
+	}
+	return nil, fmt.Errorf("no discussion category found matching %q", slug)
+}
+
+// ListDiscussions returns up to limit discussions in reverse chronological
+// order, optionally restricted to a category.
+func ListDiscussions(client *api.Client, repo ghrepo.Interface, categoryID string, limit int) ([]Discussion, int, error) {
+	var result struct {
+		Repository struct {
+			Discussions struct {
+				TotalCount int
+				Nodes      []Discussion
+				PageInfo   struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			}
+		}
+	}
+
+	query := `
+	query DiscussionList($owner: String!, $repo: String!, $limit: Int!, $endCursor: String, $categoryId: ID) {
+		repository(owner: $owner, name: $repo) {
+			discussions(first: $limit, after: $endCursor, categoryId: $categoryId, orderBy: {field: CREATED_AT, direction: DESC}) {
+				totalCount
+				nodes {
+					id
+					number
+					title
+					url
+					createdAt
+					updatedAt
+					author { login }
+					category { name slug }
+					comments { totalCount }
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}`
+
+	var discussions []Discussion
+	totalCount := 0
+	var endCursor interface{}
+	for len(discussions) < limit {
+		pageSize := limit - len(discussions)
+		if pageSize > 100 {
+			pageSize = 100
+		}
+		variables := map[string]interface{}{
+			"owner":     repo.RepoOwner(),
+			"repo":      repo.RepoName(),
+			"limit":     pageSize,
+			"endCursor": endCursor,
+		}
+		if categoryID != "" {
+			variables["categoryId"] = categoryID
+		}
+
+		if err := client.GraphQL(repo.RepoHost(), query, variables, &result); err != nil {
+			return nil, 0, err
+		}
+		totalCount = result.Repository.Discussions.TotalCount
+		discussions = append(discussions, result.Repository.Discussions.Nodes...)
+		if !result.Repository.Discussions.PageInfo.HasNextPage {
+			break
+		}
+		endCursor = result.Repository.Discussions.PageInfo.EndCursor
+	}
+
+	return discussions, totalCount, nil
+}
+
+// FindDiscussion fetches a discussion by its number.
+func FindDiscussion(client *api.Client, repo ghrepo.Interface, number int) (*Discussion, error) {
+	var result struct {
+		Repository struct {
+			Discussion *Discussion
+		}
+	}
+
+	query := `
+	query DiscussionByNumber($owner: String!, $repo: String!, $number: Int!) {
+		repository(owner: $owner, name: $repo) {
+			discussion(number: $number) {
+				id
+				number
+				title
+				body
+				url
+				createdAt
+				updatedAt
+				author { login }
+				category { name slug isAnswerable }
+				comments { totalCount }
+				answer { id url }
+			}
+		}
+	}`
+	variables := map[string]interface{}{
+		"owner":  repo.RepoOwner(),
+		"repo":   repo.RepoName(),
+		"number": number,
+	}
+
+	if err := client.GraphQL(repo.RepoHost(), query, variables, &result); err != nil {
+		return nil, err
+	}
+	if result.Repository.Discussion == nil {
+		return nil, fmt.Errorf("no discussion found with number %d", number)
+	}
+	return result.Repository.Discussion, nil
+}
+
+// AddComment posts a comment on a discussion and returns the new comment.
+func AddComment(client *api.Client, repo ghrepo.Interface, discussionID, body string) (*Comment, error) {
+	var result struct {
+		AddDiscussionComment struct {
+			Comment Comment
+		}
+	}
+
+	mutation := `
+	mutation DiscussionCommentAdd($input: AddDiscussionCommentInput!) {
+		addDiscussionComment(input: $input) {
+			comment { id url }
+		}
+	}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"discussionId": discussionID,
+			"body":         body,
+		},
+	}
+
+	if err := client.GraphQL(repo.RepoHost(), mutation, variables, &result); err != nil {
+		return nil, err
+	}
+	return &result.AddDiscussionComment.Comment, nil
+}
+
+// MarkCommentAsAnswer marks a discussion comment as the chosen answer.
+func MarkCommentAsAnswer(client *api.Client, repo ghrepo.Interface, commentID string) error {
+	var result struct {
+		MarkDiscussionCommentAsAnswer struct {
+			ClientMutationID string
+		}
+	}
+
+	mutation := `
+	mutation DiscussionMarkAnswer($input: MarkDiscussionCommentAsAnswerInput!) {
+		markDiscussionCommentAsAnswer(input: $input) {
+			clientMutationId
+		}
+	}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"id": commentID,
+		},
+	}
+
+	return client.GraphQL(repo.RepoHost(), mutation, variables, &result)
+}
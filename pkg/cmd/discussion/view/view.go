@@ -0,0 +1,158 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/discussion/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/markdown"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type browser interface {
+	Browse(string) error
+}
+
+type ViewOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Browser    browser
+
+	SelectorArg string
+	BrowserMode bool
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Browser:    f.Browser,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <number>",
+		Short: "View a discussion",
+		Long: heredoc.Doc(`
+			Display the title, body, and other information about a discussion.
+
+			With '--web', open the discussion in a web browser instead.
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot view discussion: number argument required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.SelectorArg = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.BrowserMode, "web", "w", false, "Open a discussion in the browser")
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	number, err := strconv.Atoi(opts.SelectorArg)
+	if err != nil {
+		return fmt.Errorf("invalid discussion number: %q", opts.SelectorArg)
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	discussion, err := shared.FindDiscussion(apiClient, baseRepo, number)
+	if err != nil {
+		return err
+	}
+
+	if opts.BrowserMode {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(discussion.URL))
+		}
+		return opts.Browser.Browse(discussion.URL)
+	}
+
+	opts.IO.DetectTerminalTheme()
+
+	if err := opts.IO.StartPager(); err != nil {
+		return err
+	}
+	defer opts.IO.StopPager()
+
+	if opts.IO.IsStdoutTTY() {
+		return printHumanPreview(opts, discussion)
+	}
+	return printRawPreview(opts.IO, discussion)
+}
+
+func printRawPreview(io *iostreams.IOStreams, discussion *shared.Discussion) error {
+	out := io.Out
+
+	fmt.Fprintf(out, "title:\t%s\n", discussion.Title)
+	fmt.Fprintf(out, "author:\t%s\n", discussion.Author.Login)
+	fmt.Fprintf(out, "category:\t%s\n", discussion.Category.Name)
+	fmt.Fprintf(out, "answered:\t%v\n", discussion.Answer != nil)
+	fmt.Fprintf(out, "comments:\t%d\n", discussion.Comments.TotalCount)
+	fmt.Fprintf(out, "number:\t%d\n", discussion.Number)
+	fmt.Fprintf(out, "url:\t%s\n", discussion.URL)
+
+	fmt.Fprintln(out, "--")
+	fmt.Fprintln(out, discussion.Body)
+
+	return nil
+}
+
+func printHumanPreview(opts *ViewOptions, discussion *shared.Discussion) error {
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	fmt.Fprintf(out, "%s #%d\n", cs.Bold(discussion.Title), discussion.Number)
+	fmt.Fprintf(out,
+		"%s • %s opened %s • %s\n",
+		cs.Cyan(discussion.Category.Name),
+		discussion.Author.Login,
+		utils.FuzzyAgo(time.Since(discussion.CreatedAt)),
+		utils.Pluralize(discussion.Comments.TotalCount, "comment"),
+	)
+	if discussion.Answer != nil {
+		fmt.Fprintln(out, cs.Green("✓ Answered"))
+	}
+
+	var md string
+	var err error
+	if discussion.Body == "" {
+		md = fmt.Sprintf("\n  %s\n\n", cs.Gray("No description provided"))
+	} else {
+		md, err = markdown.Render(discussion.Body, markdown.WithIO(opts.IO))
+		if err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(out, "\n%s\n", md)
+
+	fmt.Fprintf(out, cs.Gray("View this discussion on GitHub: %s\n"), discussion.URL)
+
+	return nil
+}
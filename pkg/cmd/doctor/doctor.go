@@ -0,0 +1,252 @@
+package doctor
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/cmd/auth/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/safeexec"
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+)
+
+type DoctorOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+}
+
+func NewCmdDoctor(f *cmdutil.Factory, runF func(*DoctorOptions) error) *cobra.Command {
+	opts := &DoctorOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Args:  cobra.ExactArgs(0),
+		Short: "Diagnose common configuration problems",
+		Long: heredoc.Doc(`
+			Check the local environment for problems that commonly break gh and
+			suggest how to fix them.
+
+			The checks cover configuration file validity, authentication tokens and
+			their scopes for each known host, availability of the git executable,
+			the git credential helper, proxy settings, and the configured pager,
+			editor, and web browser.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return doctorRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func doctorRun(opts *DoctorOptions) error {
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	var failed bool
+
+	fmt.Fprintln(out, cs.Bold("Configuration"))
+	cfg, err := opts.Config()
+	if err != nil {
+		fmt.Fprintf(out, "%s could not read configuration: %v\n", cs.Red("X"), err)
+		fmt.Fprintf(out, "- check the syntax of %s and %s\n", config.ConfigFile(), config.HostsConfigFile())
+		return cmdutil.SilentError
+	}
+	fmt.Fprintf(out, "%s Configuration files are valid\n", cs.SuccessIcon())
+
+	fmt.Fprintln(out, cs.Bold("\nGit"))
+	if !checkGit(opts) {
+		failed = true
+	}
+
+	fmt.Fprintln(out, cs.Bold("\nAuthentication"))
+	if !checkAuth(opts, cfg) {
+		failed = true
+	}
+
+	fmt.Fprintln(out, cs.Bold("\nProxy"))
+	if !checkProxy(opts) {
+		failed = true
+	}
+
+	fmt.Fprintln(out, cs.Bold("\nTools"))
+	if !checkTools(opts, cfg) {
+		failed = true
+	}
+
+	if failed {
+		return cmdutil.SilentError
+	}
+	return nil
+}
+
+func checkGit(opts *DoctorOptions) bool {
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	versionCmd, err := git.GitCommand("version")
+	if err != nil {
+		fmt.Fprintf(out, "%s %v\n", cs.Red("X"), err)
+		return false
+	}
+	version, err := run.PrepareCmd(versionCmd).Output()
+	if err != nil {
+		fmt.Fprintf(out, "%s could not run git: %v\n", cs.Red("X"), err)
+		return false
+	}
+	fmt.Fprintf(out, "%s %s\n", cs.SuccessIcon(), strings.TrimSpace(string(version)))
+
+	helper, _ := git.Config("credential.helper")
+	if helper == "" {
+		fmt.Fprintf(out, "%s no git credential helper configured\n", cs.WarningIcon())
+		fmt.Fprintf(out, "- to authenticate git with your GitHub credentials, run: %s\n", cs.Bold("gh auth setup-git"))
+	} else {
+		fmt.Fprintf(out, "%s git credential helper: %s\n", cs.SuccessIcon(), helper)
+	}
+	return true
+}
+
+func checkAuth(opts *DoctorOptions, cfg config.Config) bool {
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	hostnames, err := cfg.Hosts()
+	if err != nil || len(hostnames) == 0 {
+		fmt.Fprintf(out, "%s not logged into any GitHub hosts\n", cs.WarningIcon())
+		fmt.Fprintf(out, "- to authenticate, run: %s\n", cs.Bold("gh auth login"))
+		return true
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		fmt.Fprintf(out, "%s %v\n", cs.Red("X"), err)
+		return false
+	}
+
+	ok := true
+	for _, hostname := range hostnames {
+		token, tokenSource, _ := cfg.GetWithSource(hostname, "oauth_token")
+		if err := shared.HasMinimumScopes(httpClient, hostname, token); err != nil {
+			var missingScopes *shared.MissingScopesError
+			if errors.As(err, &missingScopes) {
+				fmt.Fprintf(out, "%s %s: the token in %s is %s\n", cs.Red("X"), hostname, tokenSource, err)
+				fmt.Fprintf(out, "- to request missing scopes, run: %s %s\n", cs.Bold("gh auth refresh -h"), cs.Bold(hostname))
+			} else {
+				fmt.Fprintf(out, "%s %s: authentication failed: %v\n", cs.Red("X"), hostname, err)
+				fmt.Fprintf(out, "- to re-authenticate, run: %s %s\n", cs.Bold("gh auth login -h"), cs.Bold(hostname))
+			}
+			ok = false
+			continue
+		}
+		scopes, _ := shared.GetScopes(httpClient, hostname, token)
+		fmt.Fprintf(out, "%s %s: token valid (scopes: %s)\n", cs.SuccessIcon(), hostname, scopes)
+	}
+	return ok
+}
+
+func checkProxy(opts *DoctorOptions) bool {
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	ok := true
+	found := false
+	for _, name := range []string{"HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy"} {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		found = true
+		if _, err := url.Parse(value); err != nil {
+			fmt.Fprintf(out, "%s %s is not a valid URL: %v\n", cs.Red("X"), name, err)
+			ok = false
+		} else {
+			fmt.Fprintf(out, "%s %s=%s\n", cs.SuccessIcon(), name, value)
+		}
+	}
+	if !found {
+		fmt.Fprintf(out, "%s no proxy configured\n", cs.SuccessIcon())
+	}
+	return ok
+}
+
+func checkTools(opts *DoctorOptions, cfg config.Config) bool {
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	ok := true
+
+	editor, _ := cmdutil.DetermineEditor(opts.Config)
+	if editor == "" {
+		fmt.Fprintf(out, "%s no editor configured; interactive editing will use a built-in fallback\n", cs.WarningIcon())
+		fmt.Fprintf(out, "- to set one, run %s or set the %s environment variable\n",
+			cs.Bold("gh config set editor <command>"), cs.Bold("GH_EDITOR"))
+	} else if !checkExecutable(opts, "editor", editor) {
+		ok = false
+	}
+
+	pager := os.Getenv("GH_PAGER")
+	if pager == "" {
+		pager = os.Getenv("PAGER")
+	}
+	if pager == "" {
+		pager, _ = cfg.Get("", "pager")
+	}
+	if pager == "" {
+		fmt.Fprintf(out, "%s no pager configured; output will not be paginated\n", cs.SuccessIcon())
+	} else if !checkExecutable(opts, "pager", pager) {
+		ok = false
+	}
+
+	browser := os.Getenv("GH_BROWSER")
+	if browser == "" {
+		browser, _ = cfg.Get("", "browser")
+	}
+	if browser == "" {
+		browser = os.Getenv("BROWSER")
+	}
+	if browser == "" {
+		fmt.Fprintf(out, "%s no browser configured; the system default will be used\n", cs.SuccessIcon())
+	} else if !checkExecutable(opts, "browser", browser) {
+		ok = false
+	}
+
+	return ok
+}
+
+// checkExecutable reports whether the first word of a configured command
+// resolves to an executable in PATH.
+func checkExecutable(opts *DoctorOptions, kind, command string) bool {
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	args, err := shlex.Split(command)
+	if err != nil || len(args) == 0 {
+		fmt.Fprintf(out, "%s could not parse %s command %q\n", cs.Red("X"), kind, command)
+		return false
+	}
+	if _, err := safeexec.LookPath(args[0]); err != nil {
+		fmt.Fprintf(out, "%s %s %q not found in PATH\n", cs.Red("X"), kind, args[0])
+		return false
+	}
+	fmt.Fprintf(out, "%s %s: %s\n", cs.SuccessIcon(), kind, command)
+	return true
+}
@@ -0,0 +1,117 @@
+package doctor
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_doctorRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       func(config.Config)
+		httpStubs func(*httpmock.Registry)
+		runStubs  func(*run.CommandStubber)
+		wantErr   string
+		wantOut   *regexp.Regexp
+	}{
+		{
+			name: "all good",
+			cfg: func(c config.Config) {
+				_ = c.Set("github.com", "oauth_token", "abc123")
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("GET", ""), httpmock.ScopesResponder("repo,read:org"))
+				reg.Register(httpmock.REST("GET", ""), httpmock.ScopesResponder("repo,read:org"))
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git version`, 0, "git version 2.30.0\n")
+				cs.Register(`git config credential\.helper`, 0, "osxkeychain\n")
+			},
+			wantOut: regexp.MustCompile(`(?s)git version 2\.30\.0.*credential helper: osxkeychain.*github\.com: token valid \(scopes: repo,read:org\)`),
+		},
+		{
+			name: "missing scopes",
+			cfg: func(c config.Config) {
+				_ = c.Set("github.com", "oauth_token", "abc123")
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("GET", ""), httpmock.ScopesResponder("repo"))
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git version`, 0, "git version 2.30.0\n")
+				cs.Register(`git config credential\.helper`, 0, "osxkeychain\n")
+			},
+			wantOut: regexp.MustCompile(`(?s)github\.com: the token in .* is missing required scope.*gh auth refresh -h`),
+			wantErr: "SilentError",
+		},
+		{
+			name: "not logged in",
+			cfg:  func(c config.Config) {},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git version`, 0, "git version 2.30.0\n")
+				cs.Register(`git config credential\.helper`, 1, "")
+			},
+			wantOut: regexp.MustCompile(`(?s)no git credential helper configured.*gh auth setup-git.*not logged into any GitHub hosts.*gh auth login`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GH_EDITOR", "")
+			t.Setenv("GH_PAGER", "")
+			t.Setenv("PAGER", "")
+			t.Setenv("GH_BROWSER", "")
+			t.Setenv("BROWSER", "")
+			t.Setenv("HTTPS_PROXY", "")
+			t.Setenv("https_proxy", "")
+			t.Setenv("HTTP_PROXY", "")
+			t.Setenv("http_proxy", "")
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(true)
+
+			cfg := config.NewBlankConfig()
+			if tt.cfg != nil {
+				tt.cfg(cfg)
+			}
+
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			cmdStubs, restoreRun := run.Stub()
+			defer restoreRun(t)
+			if tt.runStubs != nil {
+				tt.runStubs(cmdStubs)
+			}
+
+			opts := &DoctorOptions{
+				IO: io,
+				Config: func() (config.Config, error) {
+					return cfg, nil
+				},
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+			}
+
+			err := doctorRun(opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Regexp(t, tt.wantOut, stdout.String())
+		})
+	}
+}
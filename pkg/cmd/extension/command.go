@@ -4,12 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/extensions"
 	"github.com/cli/cli/v2/pkg/prompt"
@@ -122,6 +124,7 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 		func() *cobra.Command {
 			var flagAll bool
 			var flagForce bool
+			var flagDryRun bool
 			cmd := &cobra.Command{
 				Use:   "upgrade {<name> | --all}",
 				Short: "Upgrade installed extensions",
@@ -142,6 +145,9 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 					if len(args) > 0 {
 						name = normalizeExtensionSelector(args[0])
 					}
+					if flagDryRun {
+						m.EnableDryRunMode()
+					}
 					cs := io.ColorScheme()
 					err := m.Upgrade(name, flagForce)
 					if err != nil && !errors.Is(err, upToDateError) {
@@ -153,12 +159,16 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 						return cmdutil.SilentError
 					}
 					if io.IsStdoutTTY() {
+						successStr := "Successfully"
+						if flagDryRun {
+							successStr = "Would have"
+						}
 						if errors.Is(err, upToDateError) {
 							fmt.Fprintf(io.Out, "%s Extension already up to date\n", cs.SuccessIcon())
 						} else if name != "" {
-							fmt.Fprintf(io.Out, "%s Successfully upgraded extension %s\n", cs.SuccessIcon(), name)
+							fmt.Fprintf(io.Out, "%s %s upgraded extension %s\n", cs.SuccessIcon(), successStr, name)
 						} else {
-							fmt.Fprintf(io.Out, "%s Successfully upgraded extensions\n", cs.SuccessIcon())
+							fmt.Fprintf(io.Out, "%s %s upgraded extensions\n", cs.SuccessIcon(), successStr)
 						}
 					}
 					return nil
@@ -166,6 +176,7 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 			}
 			cmd.Flags().BoolVar(&flagAll, "all", false, "Upgrade all extensions")
 			cmd.Flags().BoolVar(&flagForce, "force", false, "Force upgrade extension")
+			cmd.Flags().BoolVar(&flagDryRun, "dry-run", false, "Only display upgrades")
 			return cmd
 		}(),
 		&cobra.Command{
@@ -184,6 +195,54 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 				return nil
 			},
 		},
+		&cobra.Command{
+			Use:   "release <tag>",
+			Short: "Tag a new release of the extension in the current directory",
+			Long: heredoc.Doc(`
+				Create an annotated git tag for the extension in the current directory and push
+				it to the origin remote, so that release automation can build and publish
+				precompiled binaries for it.
+
+				The tag name must start with "v", e.g. "v1.0.0".
+			`),
+			Args: cmdutil.ExactArgs(1, "must specify a tag name"),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				tag := args[0]
+				if !strings.HasPrefix(tag, "v") {
+					return cmdutil.FlagErrorf(`tag name must start with "v", e.g. "v1.0.0"`)
+				}
+
+				wd, err := os.Getwd()
+				if err != nil {
+					return err
+				}
+				if !strings.HasPrefix(filepath.Base(wd), "gh-") {
+					return errors.New("the current directory is not a gh extension")
+				}
+
+				tagCmd, err := git.GitCommand("tag", "-a", tag, "-m", tag)
+				if err != nil {
+					return err
+				}
+				if err := run.PrepareCmd(tagCmd).Run(); err != nil {
+					return err
+				}
+
+				pushCmd, err := git.GitCommand("push", "origin", tag)
+				if err != nil {
+					return err
+				}
+				if err := run.PrepareCmd(pushCmd).Run(); err != nil {
+					return err
+				}
+
+				if io.IsStdoutTTY() {
+					cs := io.ColorScheme()
+					fmt.Fprintf(io.Out, "%s Tagged and pushed %s; release automation will publish binaries for it\n", cs.SuccessIcon(), tag)
+				}
+				return nil
+			},
+		},
 		func() *cobra.Command {
 			promptCreate := func() (string, extensions.ExtTemplateType, error) {
 				var extName string
@@ -200,6 +259,7 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 						"Script (Bash, Ruby, Python, etc)",
 						"Go",
 						"Other Precompiled (C++, Rust, etc)",
+						"Go with release automation",
 					},
 				}, &extTmplType)
 				return extName, extensions.ExtTemplateType(extTmplType), err
@@ -220,12 +280,15 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 
 				# Create a non-Go precompiled extension
 				gh extension create --precompiled=other foobar
+
+				# Create a Go extension with release automation
+				gh extension create --precompiled=go-release foobar
 				`),
 				Args: cobra.MaximumNArgs(1),
 				RunE: func(cmd *cobra.Command, args []string) error {
 					if cmd.Flags().Changed("precompiled") {
-						if flagType != "go" && flagType != "other" {
-							return cmdutil.FlagErrorf("value for --precompiled must be 'go' or 'other'. Got '%s'", flagType)
+						if flagType != "go" && flagType != "go-release" && flagType != "other" {
+							return cmdutil.FlagErrorf("value for --precompiled must be 'go', 'go-release', or 'other'. Got '%s'", flagType)
 						}
 					}
 					var extName string
@@ -242,6 +305,8 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 						extName = args[0]
 						if flagType == "go" {
 							tmplType = extensions.GoBinTemplateType
+						} else if flagType == "go-release" {
+							tmplType = extensions.GoReleaseTemplateType
 						} else if flagType == "other" {
 							tmplType = extensions.OtherBinTemplateType
 						}
@@ -269,7 +334,7 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 						fullName, extName)
 
 					cs := io.ColorScheme()
-					if tmplType == extensions.GoBinTemplateType {
+					if tmplType == extensions.GoBinTemplateType || tmplType == extensions.GoReleaseTemplateType {
 						goBinChecks = heredoc.Docf(`
 						%[1]s Downloaded Go dependencies
 						%[1]s Built %[2]s binary
@@ -277,6 +342,12 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 						steps = heredoc.Docf(`
 						- run 'cd %[1]s; gh extension install .; gh %[2]s' to see your new extension in action
 						- use 'go build && gh %[2]s' to see changes in your code as you develop`, fullName, extName)
+						if tmplType == extensions.GoReleaseTemplateType {
+							steps += heredoc.Doc(`
+
+							- run script/release.sh to cross-compile binaries into dist/
+							- use 'gh extension release <tag>' to tag a release and let CI publish it`)
+						}
 					} else if tmplType == extensions.OtherBinTemplateType {
 						steps = heredoc.Docf(`
 						- run 'cd %[1]s; gh extension install .' to install your extension locally
@@ -302,7 +373,7 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 					return nil
 				},
 			}
-			cmd.Flags().StringVar(&flagType, "precompiled", "", "Create a precompiled extension. Possible values: go, other")
+			cmd.Flags().StringVar(&flagType, "precompiled", "", "Create a precompiled extension. Possible values: go, go-release, other")
 			return cmd
 		}(),
 	)
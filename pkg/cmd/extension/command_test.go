@@ -222,6 +222,25 @@ func TestNewCmdExtension(t *testing.T) {
 			},
 			isTTY: false,
 		},
+		{
+			name: "upgrade all dry run",
+			args: []string{"upgrade", "--all", "--dry-run"},
+			managerStubs: func(em *extensions.ExtensionManagerMock) func(*testing.T) {
+				em.EnableDryRunModeFunc = func() {}
+				em.UpgradeFunc = func(name string, force bool) error {
+					return nil
+				}
+				return func(t *testing.T) {
+					dryRunCalls := em.EnableDryRunModeCalls()
+					assert.Equal(t, 1, len(dryRunCalls))
+					calls := em.UpgradeCalls()
+					assert.Equal(t, 1, len(calls))
+					assert.Equal(t, "", calls[0].Name)
+				}
+			},
+			isTTY:      true,
+			wantStdout: "✓ Would have upgraded extensions\n",
+		},
 		{
 			name: "remove extension tty",
 			args: []string{"remove", "hello"},
@@ -318,7 +337,7 @@ func TestNewCmdExtension(t *testing.T) {
 			askStubs: func(as *prompt.AskStubber) {
 				as.StubPrompt("Extension name:").AnswerWith("test")
 				as.StubPrompt("What kind of extension?").
-					AssertOptions([]string{"Script (Bash, Ruby, Python, etc)", "Go", "Other Precompiled (C++, Rust, etc)"}).
+					AssertOptions([]string{"Script (Bash, Ruby, Python, etc)", "Go", "Other Precompiled (C++, Rust, etc)", "Go with release automation"}).
 					AnswerDefault()
 			},
 			wantStdout: heredoc.Doc(`
@@ -368,6 +387,41 @@ func TestNewCmdExtension(t *testing.T) {
 				https://docs.github.com/github-cli/github-cli/creating-github-cli-extensions
 			`),
 		},
+		{
+			name: "create extension with arg, --precompiled=go-release",
+			args: []string{"create", "test", "--precompiled", "go-release"},
+			managerStubs: func(em *extensions.ExtensionManagerMock) func(*testing.T) {
+				em.CreateFunc = func(name string, tmplType extensions.ExtTemplateType) error {
+					return nil
+				}
+				return func(t *testing.T) {
+					calls := em.CreateCalls()
+					assert.Equal(t, 1, len(calls))
+					assert.Equal(t, "gh-test", calls[0].Name)
+					assert.Equal(t, extensions.GoReleaseTemplateType, calls[0].TmplType)
+				}
+			},
+			isTTY: true,
+			wantStdout: heredoc.Doc(`
+				✓ Created directory gh-test
+				✓ Initialized git repository
+				✓ Set up extension scaffolding
+				✓ Downloaded Go dependencies
+				✓ Built gh-test binary
+
+				gh-test is ready for development!
+
+				Next Steps
+				- run 'cd gh-test; gh extension install .; gh test' to see your new extension in action
+				- use 'go build && gh test' to see changes in your code as you develop
+				- run script/release.sh to cross-compile binaries into dist/
+				- use 'gh extension release <tag>' to tag a release and let CI publish it
+				- commit and use 'gh repo create' to share your extension with others
+
+				For more information on writing extensions:
+				https://docs.github.com/github-cli/github-cli/creating-github-cli-extensions
+			`),
+		},
 		{
 			name: "create extension with arg, --precompiled=other",
 			args: []string{"create", "test", "--precompiled", "other"},
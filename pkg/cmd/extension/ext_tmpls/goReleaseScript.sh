@@ -0,0 +1,30 @@
+#!/usr/bin/env bash
+set -e
+
+# Cross-compiles release binaries into dist/<platform>-<arch>[.exe] for every
+# platform that gh extension install knows how to download.
+
+platforms=(
+  darwin-amd64
+  darwin-arm64
+  freebsd-386
+  freebsd-amd64
+  freebsd-arm64
+  linux-386
+  linux-amd64
+  linux-arm
+  linux-arm64
+  windows-386
+  windows-amd64
+)
+
+for platform in "${platforms[@]}"; do
+  goos="${platform%-*}"
+  goarch="${platform#*-}"
+  ext=""
+  if [ "$goos" = "windows" ]; then
+    ext=".exe"
+  fi
+  echo "building dist/${platform}${ext}"
+  GOOS="$goos" GOARCH="$goarch" CGO_ENABLED=0 go build -trimpath -ldflags="-s -w" -o "dist/${platform}${ext}"
+done
@@ -15,7 +15,6 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
-	"sync"
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/git"
@@ -37,6 +36,7 @@ type Manager struct {
 	client     *http.Client
 	config     config.Config
 	io         *iostreams.IOStreams
+	dryRunMode bool
 }
 
 func NewManager(io *iostreams.IOStreams) *Manager {
@@ -64,6 +64,19 @@ func (m *Manager) SetClient(client *http.Client) {
 	m.client = client
 }
 
+func (m *Manager) EnableDryRunMode() {
+	m.dryRunMode = true
+}
+
+func (m *Manager) updateChecker() *updateChecker {
+	return &updateChecker{
+		client:        m.client,
+		cacheFilePath: filepath.Join(m.installDir(), versionCacheName),
+		lookPath:      m.lookPath,
+		newCommand:    m.newCommand,
+	}
+}
+
 func (m *Manager) Dispatch(args []string, stdin io.Reader, stdout, stderr io.Writer) (bool, error) {
 	if len(args) == 0 {
 		return false, errors.New("too few arguments in list")
@@ -149,7 +162,7 @@ func (m *Manager) list(includeMetadata bool) ([]Extension, error) {
 	}
 
 	if includeMetadata {
-		m.populateLatestVersions(results)
+		m.updateChecker().populateLatestVersions(results)
 	}
 
 	return results, nil
@@ -247,61 +260,6 @@ func (m *Manager) getRemoteUrl(extension string) string {
 	return strings.TrimSpace(string(url))
 }
 
-func (m *Manager) populateLatestVersions(exts []Extension) {
-	size := len(exts)
-	type result struct {
-		index   int
-		version string
-	}
-	ch := make(chan result, size)
-	var wg sync.WaitGroup
-	wg.Add(size)
-	for idx, ext := range exts {
-		go func(i int, e Extension) {
-			defer wg.Done()
-			version, _ := m.getLatestVersion(e)
-			ch <- result{index: i, version: version}
-		}(idx, ext)
-	}
-	wg.Wait()
-	close(ch)
-	for r := range ch {
-		ext := &exts[r.index]
-		ext.latestVersion = r.version
-	}
-}
-
-func (m *Manager) getLatestVersion(ext Extension) (string, error) {
-	if ext.isLocal {
-		return "", localExtensionUpgradeError
-	}
-	if ext.IsBinary() {
-		repo, err := ghrepo.FromFullName(ext.url)
-		if err != nil {
-			return "", err
-		}
-		r, err := fetchLatestRelease(m.client, repo)
-		if err != nil {
-			return "", err
-		}
-		return r.Tag, nil
-	} else {
-		gitExe, err := m.lookPath("git")
-		if err != nil {
-			return "", err
-		}
-		extDir := filepath.Dir(ext.path)
-		gitDir := "--git-dir=" + filepath.Join(extDir, ".git")
-		cmd := m.newCommand(gitExe, gitDir, "ls-remote", "origin", "HEAD")
-		lsRemote, err := cmd.Output()
-		if err != nil {
-			return "", err
-		}
-		remoteSha := bytes.SplitN(lsRemote, []byte("\t"), 2)[0]
-		return string(remoteSha), nil
-	}
-}
-
 func (m *Manager) InstallLocal(dir string) error {
 	name := filepath.Base(dir)
 	targetLink := filepath.Join(m.installDir(), name)
@@ -446,7 +404,7 @@ func (m *Manager) Upgrade(name string, force bool) error {
 		var err error
 		// For single extensions manually retrieve latest version since we forgo
 		// doing it during list.
-		f.latestVersion, err = m.getLatestVersion(f)
+		f.latestVersion, err = m.updateChecker().latestVersion(f)
 		if err != nil {
 			return err
 		}
@@ -468,7 +426,13 @@ func (m *Manager) upgradeExtensions(exts []Extension, force bool) error {
 			fmt.Fprintf(m.io.Out, "%s\n", err)
 			continue
 		}
-		fmt.Fprintf(m.io.Out, "upgrade complete\n")
+		if m.dryRunMode {
+			fmt.Fprintf(m.io.Out, "would have upgraded from %s to %s\n",
+				displayExtensionVersion(&f, f.currentVersion),
+				displayExtensionVersion(&f, f.latestVersion))
+		} else {
+			fmt.Fprintf(m.io.Out, "upgrade complete\n")
+		}
 	}
 	if failed {
 		return errors.New("some extensions failed to upgrade")
@@ -483,6 +447,9 @@ func (m *Manager) upgradeExtension(ext Extension, force bool) error {
 	if !ext.UpdateAvailable() {
 		return upToDateError
 	}
+	if m.dryRunMode {
+		return nil
+	}
 	var err error
 	if ext.IsBinary() {
 		err = m.upgradeBinExtension(ext)
@@ -540,6 +507,15 @@ func (m *Manager) installDir() string {
 	return filepath.Join(m.dataDir(), "extensions")
 }
 
+// displayExtensionVersion returns a human-readable form of an extension
+// version, abbreviating raw commit SHAs of git-based extensions.
+func displayExtensionVersion(ext *Extension, version string) string {
+	if !ext.IsBinary() && len(version) > 8 {
+		return version[:8]
+	}
+	return version
+}
+
 //go:embed ext_tmpls/goBinMain.go.txt
 var mainGoTmpl string
 
@@ -555,6 +531,9 @@ var scriptTmpl string
 //go:embed ext_tmpls/buildScript.sh
 var buildScript []byte
 
+//go:embed ext_tmpls/goReleaseScript.sh
+var goReleaseScript []byte
+
 func (m *Manager) Create(name string, tmplType extensions.ExtTemplateType) error {
 	exe, err := m.lookPath("git")
 	if err != nil {
@@ -567,6 +546,8 @@ func (m *Manager) Create(name string, tmplType extensions.ExtTemplateType) error
 
 	if tmplType == extensions.GoBinTemplateType {
 		return m.goBinScaffolding(exe, name)
+	} else if tmplType == extensions.GoReleaseTemplateType {
+		return m.goReleaseScaffolding(exe, name)
 	} else if tmplType == extensions.OtherBinTemplateType {
 		return m.otherBinScaffolding(exe, name)
 	}
@@ -593,6 +574,19 @@ func (m *Manager) otherBinScaffolding(gitExe, name string) error {
 	return m.newCommand(gitExe, "-C", name, "add", ".").Run()
 }
 
+// goReleaseScaffolding is goBinScaffolding plus a cross-compiling release script so that
+// binaries for all supported platforms can be built locally as well as in CI.
+func (m *Manager) goReleaseScaffolding(gitExe, name string) error {
+	if err := m.goBinScaffolding(gitExe, name); err != nil {
+		return err
+	}
+	releaseScriptPath := filepath.Join("script", "release.sh")
+	if err := writeFile(filepath.Join(name, releaseScriptPath), goReleaseScript, 0755); err != nil {
+		return err
+	}
+	return m.newCommand(gitExe, "-C", name, "add", releaseScriptPath, "--chmod=+x").Run()
+}
+
 func (m *Manager) goBinScaffolding(gitExe, name string) error {
 	goExe, err := m.lookPath("go")
 	if err != nil {
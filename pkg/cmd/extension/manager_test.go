@@ -243,6 +243,52 @@ func TestManager_UpgradeExtensions(t *testing.T) {
 	assert.Equal(t, "", stderr.String())
 }
 
+func TestManager_UpgradeExtensions_DryRun(t *testing.T) {
+	tempDir := t.TempDir()
+	assert.NoError(t, stubExtension(filepath.Join(tempDir, "extensions", "gh-hello", "gh-hello")))
+	io, _, stdout, stderr := iostreams.Test()
+	m := newTestManager(tempDir, nil, io)
+	m.EnableDryRunMode()
+	exts, err := m.list(false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(exts))
+	exts[0].currentVersion = "abcd1234efgh5678"
+	exts[0].latestVersion = "1234abcd5678efgh"
+	err = m.upgradeExtensions(exts, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "[hello]: would have upgraded from abcd1234 to 1234abcd\n", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
+func TestUpdateChecker_fetchLatestTag_etagCache(t *testing.T) {
+	tempDir := t.TempDir()
+	reg := httpmock.Registry{}
+	defer reg.Verify(t)
+	client := http.Client{Transport: &reg}
+
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/releases/latest"),
+		httpmock.WithHeader(httpmock.StringResponse(`{"tag_name":"v1.0.2"}`), "ETag", `"abc123"`))
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/releases/latest"),
+		httpmock.StatusStringResponse(304, ""))
+
+	m := newTestManager(tempDir, &client, nil)
+	repo := ghrepo.NewWithHost("owner", "gh-bin-ext", "example.com")
+
+	tag, err := m.updateChecker().fetchLatestTag(repo)
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.0.2", tag)
+
+	// a fresh checker revalidates with the stored ETag and reuses the cached tag
+	tag, err = m.updateChecker().fetchLatestTag(repo)
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.0.2", tag)
+
+	assert.Equal(t, "", reg.Requests[0].Header.Get("If-None-Match"))
+	assert.Equal(t, `"abc123"`, reg.Requests[1].Header.Get("If-None-Match"))
+}
+
 func TestManager_UpgradeExtension_LocalExtension(t *testing.T) {
 	tempDir := t.TempDir()
 	assert.NoError(t, stubLocalExtension(tempDir, filepath.Join(tempDir, "extensions", "gh-local", "gh-local")))
@@ -642,6 +688,39 @@ func TestManager_Create_go_binary(t *testing.T) {
 	assert.Equal(t, "", stderr.String())
 }
 
+func TestManager_Create_go_release(t *testing.T) {
+	chdirTemp(t)
+	reg := httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query UserCurrent\b`),
+		httpmock.StringResponse(`{"data":{"viewer":{"login":"jillv"}}}`))
+
+	io, _, stdout, stderr := iostreams.Test()
+	m := newTestManager(".", &http.Client{Transport: &reg}, io)
+
+	err := m.Create("gh-test", extensions.GoReleaseTemplateType)
+	require.NoError(t, err)
+
+	files, err := ioutil.ReadDir("gh-test")
+	require.NoError(t, err)
+	assert.Equal(t, []string{".github", ".gitignore", "main.go", "script"}, fileNames(files))
+
+	files, err = ioutil.ReadDir(filepath.Join("gh-test", "script"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"release.sh"}, fileNames(files))
+
+	assert.Equal(t, heredoc.Doc(`
+		[git init --quiet gh-test]
+		[go mod init github.com/jillv/gh-test]
+		[go mod tidy]
+		[go build]
+		[git -C gh-test add .]
+		[git -C gh-test add script/release.sh --chmod=+x]
+	`), stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
 func TestManager_Create_other_binary(t *testing.T) {
 	chdirTemp(t)
 	io, _, stdout, stderr := iostreams.Test()
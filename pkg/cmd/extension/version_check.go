@@ -0,0 +1,160 @@
+package extension
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"gopkg.in/yaml.v3"
+)
+
+const versionCacheName = ".version-cache.yml"
+
+type versionCacheEntry struct {
+	ETag string `yaml:"etag"`
+	Tag  string `yaml:"tag"`
+}
+
+// updateChecker determines the latest available version of installed
+// extensions. Release tag lookups are performed in parallel and validated
+// with ETags, so that repeated checks against an unchanged release reuse
+// the previously cached tag instead of re-downloading release metadata.
+type updateChecker struct {
+	client        *http.Client
+	cacheFilePath string
+	lookPath      func(string) (string, error)
+	newCommand    func(string, ...string) *exec.Cmd
+
+	mu    sync.Mutex
+	cache map[string]versionCacheEntry
+}
+
+func (c *updateChecker) populateLatestVersions(exts []Extension) {
+	size := len(exts)
+	type result struct {
+		index   int
+		version string
+	}
+	ch := make(chan result, size)
+	var wg sync.WaitGroup
+	wg.Add(size)
+	for idx, ext := range exts {
+		go func(i int, e Extension) {
+			defer wg.Done()
+			version, _ := c.latestVersion(e)
+			ch <- result{index: i, version: version}
+		}(idx, ext)
+	}
+	wg.Wait()
+	close(ch)
+	for r := range ch {
+		ext := &exts[r.index]
+		ext.latestVersion = r.version
+	}
+}
+
+func (c *updateChecker) latestVersion(ext Extension) (string, error) {
+	if ext.isLocal {
+		return "", localExtensionUpgradeError
+	}
+	if ext.IsBinary() {
+		repo, err := ghrepo.FromFullName(ext.url)
+		if err != nil {
+			return "", err
+		}
+		return c.fetchLatestTag(repo)
+	}
+	gitExe, err := c.lookPath("git")
+	if err != nil {
+		return "", err
+	}
+	extDir := filepath.Dir(ext.path)
+	gitDir := "--git-dir=" + filepath.Join(extDir, ".git")
+	cmd := c.newCommand(gitExe, gitDir, "ls-remote", "origin", "HEAD")
+	lsRemote, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	remoteSha := bytes.SplitN(lsRemote, []byte("\t"), 2)[0]
+	return string(remoteSha), nil
+}
+
+// fetchLatestTag looks up the tag of the latest release for a repository,
+// sending the ETag from a previous lookup so an unchanged release responds
+// with 304 and the cached tag is reused.
+func (c *updateChecker) fetchLatestTag(repo ghrepo.Interface) (string, error) {
+	cacheKey := repo.RepoHost() + "/" + ghrepo.FullName(repo)
+	cached := c.cacheEntry(cacheKey)
+
+	url := ghinstance.RESTPrefix(repo.RepoHost()) + "repos/" + ghrepo.FullName(repo) + "/releases/latest"
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+	if cached.ETag != "" {
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return cached.Tag, nil
+	}
+	if resp.StatusCode > 299 {
+		return "", api.HandleHTTPError(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	var r struct {
+		Tag string `json:"tag_name"`
+	}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return "", err
+	}
+
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		c.setCacheEntry(cacheKey, versionCacheEntry{ETag: etag, Tag: r.Tag})
+	}
+	return r.Tag, nil
+}
+
+func (c *updateChecker) cacheEntry(key string) versionCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cache == nil {
+		c.cache = map[string]versionCacheEntry{}
+		if content, err := ioutil.ReadFile(c.cacheFilePath); err == nil {
+			_ = yaml.Unmarshal(content, &c.cache)
+		}
+	}
+	return c.cache[key]
+}
+
+func (c *updateChecker) setCacheEntry(key string, entry versionCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache[key] = entry
+	content, err := yaml.Marshal(c.cache)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(c.cacheFilePath), 0755); err != nil {
+		return
+	}
+	_ = ioutil.WriteFile(c.cacheFilePath, content, 0600)
+}
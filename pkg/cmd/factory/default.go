@@ -11,10 +11,12 @@ import (
 	"github.com/cli/cli/v2/context"
 	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmd/extension"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
 )
 
 func New(appVersion string) *cmdutil.Factory {
@@ -126,10 +128,34 @@ func configFunc() func() (config.Config, error) {
 			configError = nil
 		}
 		cachedConfig = config.InheritEnv(cachedConfig)
+		registerHostAliases(cachedConfig)
 		return cachedConfig, configError
 	}
 }
 
+// registerHostAliases propagates host aliases declared in the hosts configuration, e.g.
+//
+//	github-work:
+//	    alias_of: ghe.company.com
+//
+// to the ghinstance package so that hostname resolution can expand them.
+func registerHostAliases(cfg config.Config) {
+	hosts, err := cfg.Hosts()
+	if err != nil {
+		return
+	}
+
+	aliases := map[string]string{}
+	for _, host := range hosts {
+		if target, _ := cfg.Get(host, "alias_of"); target != "" {
+			aliases[host] = target
+		}
+	}
+	if len(aliases) > 0 {
+		ghinstance.SetHostAliases(aliases)
+	}
+}
+
 func branchFunc() func() (string, error) {
 	return func() (string, error) {
 		currentBranch, err := git.CurrentBranch()
@@ -170,6 +196,11 @@ func ioStreams(f *cmdutil.Factory) *iostreams.IOStreams {
 		io.SetNeverPrompt(true)
 	}
 
+	if accessible, _ := cfg.GetOrDefault("", "accessible"); accessible == "enabled" {
+		io.SetAccessible(true)
+		prompt.EnableAccessible(io.In, io.ErrOut)
+	}
+
 	// Pager precedence
 	// 1. GH_PAGER
 	// 2. pager from config
@@ -125,7 +125,24 @@ func NewHTTPClient(io *iostreams.IOStreams, cfg configGetter, appVersion string,
 		)
 	}
 
-	return api.NewHTTPClient(opts...), nil
+	client := api.NewHTTPClient(opts...)
+
+	timeout := os.Getenv("GH_REQUEST_TIMEOUT")
+	if timeout == "" {
+		timeout, err = cfg.Get("", "request_timeout")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if timeout != "" {
+		duration, err := time.ParseDuration(timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for request_timeout: %q", timeout)
+		}
+		client.Timeout = duration
+	}
+
+	return client, nil
 }
 
 var ssoHeader string
@@ -7,6 +7,7 @@ import (
 	"os"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -177,6 +178,58 @@ func TestNewHTTPClient(t *testing.T) {
 	}
 }
 
+func TestNewHTTPClient_requestTimeout(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      configGetter
+		env         string
+		wantTimeout time.Duration
+		wantErr     string
+	}{
+		{
+			name:   "no timeout configured",
+			config: tinyConfig{},
+		},
+		{
+			name:        "timeout from environment",
+			config:      tinyConfig{},
+			env:         "5s",
+			wantTimeout: 5 * time.Second,
+		},
+		{
+			name:        "timeout from config",
+			config:      tinyConfig{":request_timeout": "1m"},
+			wantTimeout: time.Minute,
+		},
+		{
+			name:        "environment overrides config",
+			config:      tinyConfig{":request_timeout": "1m"},
+			env:         "10s",
+			wantTimeout: 10 * time.Second,
+		},
+		{
+			name:    "invalid timeout",
+			config:  tinyConfig{},
+			env:     "long",
+			wantErr: `invalid value for request_timeout: "long"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GH_REQUEST_TIMEOUT", tt.env)
+
+			io, _, _, _ := iostreams.Test()
+			client, err := NewHTTPClient(io, tt.config, "v1.2.3", false)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTimeout, client.Timeout)
+		})
+	}
+}
+
 type tinyConfig map[string]string
 
 func (c tinyConfig) Get(host, key string) (string, error) {
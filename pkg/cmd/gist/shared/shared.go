@@ -21,6 +21,7 @@ type GistFile struct {
 	Type     string `json:"type,omitempty"`
 	Language string `json:"language,omitempty"`
 	Content  string `json:"content"`
+	RawURL   string `json:"raw_url,omitempty"`
 }
 
 type GistOwner struct {
@@ -9,6 +9,7 @@ import (
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	"github.com/cli/cli/v2/pkg/cmd/issue/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -24,6 +25,8 @@ type CloseOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 
 	SelectorArg string
+	Comment     string
+	Reason      string
 }
 
 func NewCmdClose(f *cmdutil.Factory, runF func(*CloseOptions) error) *cobra.Command {
@@ -35,7 +38,7 @@ func NewCmdClose(f *cmdutil.Factory, runF func(*CloseOptions) error) *cobra.Comm
 
 	cmd := &cobra.Command{
 		Use:   "close {<number> | <url>}",
-		Short: "Close issue",
+		Short: i18n.T("Close issue"),
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
@@ -45,6 +48,12 @@ func NewCmdClose(f *cmdutil.Factory, runF func(*CloseOptions) error) *cobra.Comm
 				opts.SelectorArg = args[0]
 			}
 
+			switch opts.Reason {
+			case "", "completed", "not-planned":
+			default:
+				return cmdutil.FlagErrorf("invalid reason %q: must be one of completed, not-planned", opts.Reason)
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -52,6 +61,9 @@ func NewCmdClose(f *cmdutil.Factory, runF func(*CloseOptions) error) *cobra.Comm
 		},
 	}
 
+	cmd.Flags().StringVarP(&opts.Comment, "comment", "c", "", "Leave a closing comment")
+	cmd.Flags().StringVarP(&opts.Reason, "reason", "r", "", "Reason for closing: {completed|not-planned}")
+
 	return cmd
 }
 
@@ -73,7 +85,22 @@ func closeRun(opts *CloseOptions) error {
 		return nil
 	}
 
-	err = apiClose(httpClient, baseRepo, issue)
+	if issue.IsPullRequest() && opts.Reason != "" {
+		return fmt.Errorf("`--reason` is not supported for pull requests")
+	}
+
+	if opts.Comment != "" {
+		apiClient := api.NewClientFromHTTP(httpClient)
+		_, err = api.CommentCreate(apiClient, baseRepo.RepoHost(), api.CommentCreateInput{
+			Body:      opts.Comment,
+			SubjectId: issue.ID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = apiClose(httpClient, baseRepo, issue, opts.Reason)
 	if err != nil {
 		return err
 	}
@@ -83,7 +110,14 @@ func closeRun(opts *CloseOptions) error {
 	return nil
 }
 
-func apiClose(httpClient *http.Client, repo ghrepo.Interface, issue *api.Issue) error {
+// CloseIssueInput mirrors githubv4.CloseIssueInput, adding the stateReason field that
+// the pinned githubv4 version does not yet define.
+type CloseIssueInput struct {
+	IssueID     githubv4.ID `json:"issueId"`
+	StateReason string      `json:"stateReason,omitempty"`
+}
+
+func apiClose(httpClient *http.Client, repo ghrepo.Interface, issue *api.Issue, reason string) error {
 	if issue.IsPullRequest() {
 		return api.PullRequestClose(httpClient, repo, issue.ID)
 	}
@@ -96,9 +130,18 @@ func apiClose(httpClient *http.Client, repo ghrepo.Interface, issue *api.Issue)
 		} `graphql:"closeIssue(input: $input)"`
 	}
 
+	var stateReason string
+	switch reason {
+	case "completed":
+		stateReason = "COMPLETED"
+	case "not-planned":
+		stateReason = "NOT_PLANNED"
+	}
+
 	variables := map[string]interface{}{
-		"input": githubv4.CloseIssueInput{
-			IssueID: issue.ID,
+		"input": CloseIssueInput{
+			IssueID:     issue.ID,
+			StateReason: stateReason,
 		},
 	}
 
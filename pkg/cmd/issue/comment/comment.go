@@ -3,6 +3,7 @@ package comment
 import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	issueShared "github.com/cli/cli/v2/pkg/cmd/issue/shared"
 	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -17,13 +18,14 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*prShared.CommentableOptions) e
 		InteractiveEditSurvey: prShared.CommentableInteractiveEditSurvey(f.Config, f.IOStreams),
 		ConfirmSubmitSurvey:   prShared.CommentableConfirmSubmitSurvey,
 		OpenInBrowser:         f.Browser.Browse,
+		RestoreCmd:            "gh issue comment",
 	}
 
 	var bodyFile string
 
 	cmd := &cobra.Command{
 		Use:   "comment {<number> | <url>}",
-		Short: "Create a new issue comment",
+		Short: i18n.T("Create a new issue comment"),
 		Example: heredoc.Doc(`
 			$ gh issue comment 22 --body "I was able to reproduce this issue, lets fix it."
 		`),
@@ -56,8 +58,10 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*prShared.CommentableOptions) e
 
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Supply a body. Will prompt for one otherwise.")
 	cmd.Flags().StringVarP(&bodyFile, "body-file", "F", "", "Read body text from `file` (use \"-\" to read from standard input)")
+	cmd.Flags().StringArrayVar(&opts.Attachments, "attach", nil, "Upload a `file` and append a link to it to the comment body")
 	cmd.Flags().BoolP("editor", "e", false, "Add body using editor")
 	cmd.Flags().BoolP("web", "w", false, "Add body in browser")
+	cmd.Flags().StringVar(&opts.RecoverFile, "recover", "", "Recover input from a failed run of comment")
 
 	return cmd
 }
@@ -199,7 +199,7 @@ func Test_commentRun(t *testing.T) {
 				InputType:   0,
 				Body:        "",
 
-				InteractiveEditSurvey: func() (string, error) { return "comment body", nil },
+				InteractiveEditSurvey: func(string) (string, error) { return "comment body", nil },
 				ConfirmSubmitSurvey:   func() (bool, error) { return true, nil },
 			},
 			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
@@ -225,7 +225,7 @@ func Test_commentRun(t *testing.T) {
 				InputType:   shared.InputTypeEditor,
 				Body:        "",
 
-				EditSurvey: func() (string, error) { return "comment body", nil },
+				EditSurvey: func(string) (string, error) { return "comment body", nil },
 			},
 			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
 				mockCommentCreate(t, reg)
@@ -3,11 +3,13 @@ package create
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -35,12 +37,14 @@ type CreateOptions struct {
 
 	Title       string
 	Body        string
+	Attachments []string
 	Interactive bool
 
 	Assignees []string
 	Labels    []string
 	Projects  []string
 	Milestone string
+	IssueType string
 }
 
 func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
@@ -55,7 +59,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 	cmd := &cobra.Command{
 		Use:   "create",
-		Short: "Create a new issue",
+		Short: i18n.T("Create a new issue"),
 		Example: heredoc.Doc(`
 			$ gh issue create --title "I found a bug" --body "Nothing works"
 			$ gh issue create --label "bug,help wanted"
@@ -91,6 +95,14 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("must provide title and body when not running interactively")
 			}
 
+			if len(opts.Attachments) > 0 && opts.WebMode {
+				return cmdutil.FlagErrorf("the `--attach` flag is not supported with `--web`")
+			}
+
+			if opts.IssueType != "" && opts.WebMode {
+				return cmdutil.FlagErrorf("the `--type` flag is not supported with `--web`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -101,11 +113,13 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Supply a title. Will prompt for one otherwise.")
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Supply a body. Will prompt for one otherwise.")
 	cmd.Flags().StringVarP(&bodyFile, "body-file", "F", "", "Read body text from `file` (use \"-\" to read from standard input)")
+	cmd.Flags().StringArrayVar(&opts.Attachments, "attach", nil, "Upload a `file` and append a link to it to the body")
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the browser to create an issue")
 	cmd.Flags().StringSliceVarP(&opts.Assignees, "assignee", "a", nil, "Assign people by their `login`. Use \"@me\" to self-assign.")
 	cmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", nil, "Add labels by `name`")
 	cmd.Flags().StringSliceVarP(&opts.Projects, "project", "p", nil, "Add the issue to projects by `name`")
 	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Add the issue to a milestone by `name`")
+	cmd.Flags().StringVar(&opts.IssueType, "type", "", "Set the issue type by `name`")
 	cmd.Flags().StringVar(&opts.RecoverFile, "recover", "", "Recover input from a failed run of create")
 
 	return cmd
@@ -164,8 +178,19 @@ func createRun(opts *CreateOptions) (err error) {
 				return
 			}
 			if !utils.ValidURL(openURL) {
-				err = fmt.Errorf("cannot open in browser: maximum URL length exceeded")
-				return
+				// fall back to preserving the body outside of the URL
+				err = prShared.PreserveBodyForWeb(opts.IO, &tb)
+				if err != nil {
+					return
+				}
+				openURL, err = generatePreviewURL(apiClient, baseRepo, tb)
+				if err != nil {
+					return
+				}
+				if !utils.ValidURL(openURL) {
+					err = fmt.Errorf("cannot open in browser: maximum URL length exceeded")
+					return
+				}
 			}
 		} else if ok, _ := tpl.HasTemplates(); ok {
 			openURL = ghrepo.GenerateRepoURL(baseRepo, "issues/new/choose")
@@ -179,7 +204,7 @@ func createRun(opts *CreateOptions) (err error) {
 	}
 
 	if isTerminal {
-		fmt.Fprintf(opts.IO.ErrOut, "\nCreating issue in %s\n\n", ghrepo.FullName(baseRepo))
+		fmt.Fprintf(opts.IO.ErrOut, i18n.T("\nCreating issue in %s\n\n"), ghrepo.FullName(baseRepo))
 	}
 
 	repo, err := api.GitHubRepo(apiClient, baseRepo)
@@ -283,6 +308,13 @@ func createRun(opts *CreateOptions) (err error) {
 		}
 		return opts.Browser.Browse(openURL)
 	} else if action == prShared.SubmitAction {
+		if len(opts.Attachments) > 0 {
+			tb.Body, err = prShared.AttachFiles(httpClient, baseRepo.RepoHost(), tb.Body, opts.Attachments)
+			if err != nil {
+				return
+			}
+		}
+
 		params := map[string]interface{}{
 			"title": tb.Title,
 			"body":  tb.Body,
@@ -296,6 +328,15 @@ func createRun(opts *CreateOptions) (err error) {
 			return
 		}
 
+		if opts.IssueType != "" {
+			var issueTypeID string
+			issueTypeID, err = resolveIssueTypeID(apiClient, baseRepo, opts.IssueType)
+			if err != nil {
+				return
+			}
+			params["issueTypeId"] = issueTypeID
+		}
+
 		var newIssue *api.Issue
 		newIssue, err = api.IssueCreate(apiClient, repo, params)
 		if err != nil {
@@ -310,6 +351,25 @@ func createRun(opts *CreateOptions) (err error) {
 	return
 }
 
+func resolveIssueTypeID(apiClient *api.Client, baseRepo ghrepo.Interface, name string) (string, error) {
+	issueTypes, err := api.RepoIssueTypes(apiClient, baseRepo)
+	if err != nil {
+		return "", fmt.Errorf("error fetching issue types: %w", err)
+	}
+	if len(issueTypes) == 0 {
+		return "", fmt.Errorf("the '%s' repository does not have issue types", ghrepo.FullName(baseRepo))
+	}
+
+	var names []string
+	for _, issueType := range issueTypes {
+		if strings.EqualFold(issueType.Name, name) {
+			return issueType.ID, nil
+		}
+		names = append(names, issueType.Name)
+	}
+	return "", fmt.Errorf("issue type %q not found; available types: %s", name, strings.Join(names, ", "))
+}
+
 func generatePreviewURL(apiClient *api.Client, baseRepo ghrepo.Interface, tb shared.IssueMetadataState) (string, error) {
 	openURL := ghrepo.GenerateRepoURL(baseRepo, "issues/new")
 	return prShared.WithPrAndIssueQueryParams(apiClient, baseRepo, openURL, tb)
@@ -138,13 +138,14 @@ func TestNewCmdCreate(t *testing.T) {
 
 func Test_createRun(t *testing.T) {
 	tests := []struct {
-		name        string
-		opts        CreateOptions
-		httpStubs   func(*httpmock.Registry)
-		wantsStdout string
-		wantsStderr string
-		wantsBrowse string
-		wantsErr    string
+		name              string
+		opts              CreateOptions
+		httpStubs         func(*httpmock.Registry)
+		wantsStdout       string
+		wantsStderr       string
+		wantsStderrRegexp []string
+		wantsBrowse       string
+		wantsErr          string
 	}{
 		{
 			name: "no args",
@@ -245,7 +246,11 @@ func Test_createRun(t *testing.T) {
 				WebMode: true,
 				Body:    strings.Repeat("A", 9216),
 			},
-			wantsErr: "cannot open in browser: maximum URL length exceeded",
+			wantsBrowse: "https://github.com/OWNER/REPO/issues/new?body=",
+			wantsStderrRegexp: []string{
+				`! Body exceeds the maximum link length; paste it into the web form from .*`,
+				`Opening github.com/OWNER/REPO/issues/new in your browser.`,
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -278,7 +283,12 @@ func Test_createRun(t *testing.T) {
 			}
 
 			assert.Equal(t, tt.wantsStdout, stdout.String())
-			assert.Equal(t, tt.wantsStderr, stderr.String())
+			if tt.wantsStderrRegexp != nil {
+				//nolint:staticcheck // prefer exact matchers over ExpectLines
+				test.ExpectLines(t, stderr.String(), tt.wantsStderrRegexp...)
+			} else {
+				assert.Equal(t, tt.wantsStderr, stderr.String())
+			}
 			browser.Verify(t, tt.wantsBrowse)
 		})
 	}
@@ -568,6 +578,25 @@ func TestIssueCreate_metadata(t *testing.T) {
 			}]
 		}
 		`))
+	http.Register(
+		httpmock.GraphQL(`query RepositoryProjectV2List\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "projectsV2": {
+			"nodes": [],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`query OrganizationProjectV2List\b`),
+		httpmock.StringResponse(`
+		{	"data": { "organization": null },
+			"errors": [{
+				"type": "NOT_FOUND",
+				"path": [ "organization" ],
+				"message": "Could not resolve to an Organization with the login of 'OWNER'."
+			}]
+		}
+		`))
 	http.Register(
 		httpmock.GraphQL(`mutation IssueCreate\b`),
 		httpmock.GraphQLMutation(`
@@ -597,6 +626,153 @@ func TestIssueCreate_metadata(t *testing.T) {
 	assert.Equal(t, "https://github.com/OWNER/REPO/issues/12\n", output.String())
 }
 
+func TestIssueCreate_projectV2(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"id": "REPOID",
+			"hasIssuesEnabled": true
+		} } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`query RepositoryProjectList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "projects": {
+			"nodes": [
+				{ "name": "Cleanup", "id": "CLEANUPID" }
+			],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`query OrganizationProjectList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "organization": { "projects": {
+			"nodes": [],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`query RepositoryProjectV2List\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "projectsV2": {
+			"nodes": [
+				{ "title": "Launch", "id": "LAUNCHV2ID", "closed": false }
+			],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`query OrganizationProjectV2List\b`),
+		httpmock.StringResponse(`
+		{ "data": { "organization": { "projectsV2": {
+			"nodes": [],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`mutation IssueCreate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "createIssue": { "issue": {
+			"id": "NEWISSUEID",
+			"URL": "https://github.com/OWNER/REPO/issues/12"
+		} } } }
+	`, func(inputs map[string]interface{}) {
+			assert.Equal(t, "TITLE", inputs["title"])
+			if v, ok := inputs["projectV2Ids"]; ok {
+				t.Errorf("did not expect projectV2Ids: %v", v)
+			}
+		}))
+	http.Register(
+		httpmock.GraphQL(`mutation AddProjectV2ItemById\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "addProjectV2ItemById": { "item": {
+			"id": "ITEMID"
+		} } } }
+	`, func(inputs map[string]interface{}) {
+			assert.Equal(t, "LAUNCHV2ID", inputs["projectId"])
+			assert.Equal(t, "NEWISSUEID", inputs["contentId"])
+		}))
+
+	output, err := runCommand(http, true, `-t TITLE -b BODY -p launch`)
+	if err != nil {
+		t.Errorf("error running command `issue create`: %v", err)
+	}
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/issues/12\n", output.String())
+}
+
+func TestIssueCreate_issueType(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"id": "REPOID",
+			"hasIssuesEnabled": true
+		} } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`query RepositoryIssueTypes\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "issueTypes": {
+			"nodes": [
+				{ "name": "Bug", "id": "BUGTYPEID" },
+				{ "name": "Task", "id": "TASKTYPEID" }
+			]
+		} } } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`mutation IssueCreate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "createIssue": { "issue": {
+			"URL": "https://github.com/OWNER/REPO/issues/12"
+		} } } }
+	`, func(inputs map[string]interface{}) {
+			assert.Equal(t, "TITLE", inputs["title"])
+			assert.Equal(t, "BUGTYPEID", inputs["issueTypeId"])
+		}))
+
+	output, err := runCommand(http, true, `-t TITLE -b BODY --type bug`)
+	if err != nil {
+		t.Errorf("error running command `issue create`: %v", err)
+	}
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/issues/12\n", output.String())
+}
+
+func TestIssueCreate_issueTypeNotFound(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"id": "REPOID",
+			"hasIssuesEnabled": true
+		} } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`query RepositoryIssueTypes\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "issueTypes": {
+			"nodes": [
+				{ "name": "Bug", "id": "BUGTYPEID" }
+			]
+		} } } }
+		`))
+
+	_, err := runCommand(http, true, `-t TITLE -b BODY --type epic`)
+	assert.EqualError(t, err, `issue type "epic" not found; available types: Bug`)
+}
+
 func TestIssueCreate_disabledIssues(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -2,6 +2,7 @@ package issue
 
 import (
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/i18n"
 	cmdClose "github.com/cli/cli/v2/pkg/cmd/issue/close"
 	cmdComment "github.com/cli/cli/v2/pkg/cmd/issue/comment"
 	cmdCreate "github.com/cli/cli/v2/pkg/cmd/issue/create"
@@ -19,8 +20,8 @@ import (
 func NewCmdIssue(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "issue <command>",
-		Short: "Manage issues",
-		Long:  `Work with GitHub issues`,
+		Short: i18n.T("Manage issues"),
+		Long:  i18n.T("Work with GitHub issues"),
 		Example: heredoc.Doc(`
 			$ gh issue list
 			$ gh issue create --label bug
@@ -76,35 +76,39 @@ func listIssues(client *api.Client, repo ghrepo.Interface, filters prShared.Filt
 		}
 	}
 
-	var issues []api.Issue
-	var totalCount int
-	pageLimit := min(limit, 100)
+	fetchPage := func(offset, pageSize int) (interface{}, int, error) {
+		pageVariables := map[string]interface{}{}
+		for name, value := range variables {
+			pageVariables[name] = value
+		}
+		pageVariables["limit"] = pageSize
+		if offset > 0 {
+			pageVariables["endCursor"] = api.OffsetCursor(offset)
+		}
 
-loop:
-	for {
 		var response responseData
-		variables["limit"] = pageLimit
-		err := client.GraphQL(repo.RepoHost(), query, variables, &response)
+		err := client.GraphQL(repo.RepoHost(), query, pageVariables, &response)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 		if !response.Repository.HasIssuesEnabled {
-			return nil, fmt.Errorf("the '%s' repository has disabled issues", ghrepo.FullName(repo))
+			return nil, 0, fmt.Errorf("the '%s' repository has disabled issues", ghrepo.FullName(repo))
 		}
-		totalCount = response.Repository.Issues.TotalCount
+		return response.Repository.Issues.Nodes, response.Repository.Issues.TotalCount, nil
+	}
 
-		for _, issue := range response.Repository.Issues.Nodes {
-			issues = append(issues, issue)
+	pages, totalCount, err := api.PaginateConcurrently(min(limit, 100), limit, fetchPage)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []api.Issue
+	for _, page := range pages {
+		for _, issue := range page.([]api.Issue) {
 			if len(issues) == limit {
-				break loop
+				break
 			}
-		}
-
-		if response.Repository.Issues.PageInfo.HasNextPage {
-			variables["endCursor"] = response.Repository.Issues.PageInfo.EndCursor
-			pageLimit = min(pageLimit, limit-len(issues))
-		} else {
-			break
+			issues = append(issues, issue)
 		}
 	}
 
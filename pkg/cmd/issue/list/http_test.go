@@ -23,6 +23,7 @@ func TestIssueList(t *testing.T) {
 				"hasIssuesEnabled": true,
 				"issues": {
 					"nodes": [],
+					"totalCount": 150,
 					"pageInfo": {
 						"hasNextPage": true,
 						"endCursor": "ENDCURSOR"
@@ -38,6 +39,7 @@ func TestIssueList(t *testing.T) {
 				"hasIssuesEnabled": true,
 				"issues": {
 					"nodes": [],
+					"totalCount": 150,
 					"pageInfo": {
 						"hasNextPage": false,
 						"endCursor": "ENDCURSOR"
@@ -76,8 +78,11 @@ func TestIssueList(t *testing.T) {
 
 	bodyBytes, _ = ioutil.ReadAll(http.Requests[1].Body)
 	_ = json.Unmarshal(bodyBytes, &reqBody)
-	if endCursor := reqBody.Variables["endCursor"].(string); endCursor != "ENDCURSOR" {
-		t.Errorf("expected %q, got %q", "ENDCURSOR", endCursor)
+	if reqLimit := reqBody.Variables["limit"].(float64); reqLimit != 50 {
+		t.Errorf("expected 50, got %v", reqLimit)
+	}
+	if endCursor := reqBody.Variables["endCursor"].(string); endCursor != api.OffsetCursor(100) {
+		t.Errorf("expected %q, got %q", api.OffsetCursor(100), endCursor)
 	}
 }
 
@@ -96,25 +101,7 @@ func TestIssueList_pagination(t *testing.T) {
 							"title": "issue1",
 							"labels": { "nodes": [ { "name": "bug" } ], "totalCount": 1 },
 							"assignees": { "nodes": [ { "login": "user1" } ], "totalCount": 1 }
-						}
-					],
-					"pageInfo": {
-						"hasNextPage": true,
-						"endCursor": "ENDCURSOR"
-					},
-					"totalCount": 2
-				}
-			} } }
-			`),
-	)
-
-	http.Register(
-		httpmock.GraphQL(`query IssueList\b`),
-		httpmock.StringResponse(`
-			{ "data": { "repository": {
-				"hasIssuesEnabled": true,
-				"issues": {
-					"nodes": [
+						},
 						{
 							"title": "issue2",
 							"labels": { "nodes": [ { "name": "enhancement" } ], "totalCount": 1 },
@@ -132,7 +119,7 @@ func TestIssueList_pagination(t *testing.T) {
 	)
 
 	repo := ghrepo.New("OWNER", "REPO")
-	res, err := listIssues(client, repo, prShared.FilterOptions{}, 0)
+	res, err := listIssues(client, repo, prShared.FilterOptions{}, 2)
 	if err != nil {
 		t.Fatalf("IssueList() error = %v", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -12,11 +13,13 @@ import (
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	issueShared "github.com/cli/cli/v2/pkg/cmd/issue/shared"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/iostreams/listview"
 	"github.com/cli/cli/v2/utils"
 	graphql "github.com/cli/shurcooL-graphql"
 	"github.com/shurcooL/githubv4"
@@ -34,17 +37,22 @@ type ListOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 	Browser    browser
 
-	WebMode  bool
-	Exporter cmdutil.Exporter
-
-	Assignee     string
-	Labels       []string
-	State        string
-	LimitResults int
-	Author       string
-	Mention      string
-	Milestone    string
-	Search       string
+	WebMode     bool
+	Interactive bool
+	Exporter    cmdutil.Exporter
+	GroupBy     string
+	Stats       bool
+
+	Assignee      string
+	Labels        []string
+	State         string
+	LimitResults  int
+	Author        string
+	Mention       string
+	Milestone     string
+	CreatedAfter  string
+	CreatedBefore string
+	Search        string
 }
 
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
@@ -57,7 +65,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 
 	cmd := &cobra.Command{
 		Use:   "list",
-		Short: "List and filter issues in this repository",
+		Short: i18n.T("List and filter issues in this repository"),
 		Example: heredoc.Doc(`
 			$ gh issue list -l "bug" -l "help wanted"
 			$ gh issue list -A monalisa
@@ -75,6 +83,39 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 				return cmdutil.FlagErrorf("invalid limit: %v", opts.LimitResults)
 			}
 
+			if err := prShared.ValidateDateFilters(opts.CreatedAfter, opts.CreatedBefore); err != nil {
+				return cmdutil.FlagErrorf("%v", err)
+			}
+
+			if opts.Interactive && opts.WebMode {
+				return cmdutil.FlagErrorf("specify only one of `--interactive` or `--web`")
+			}
+			if opts.Interactive && opts.Exporter != nil {
+				return cmdutil.FlagErrorf("specify only one of `--interactive` or `--json`")
+			}
+			if opts.Interactive && !opts.IO.CanPrompt() {
+				return cmdutil.FlagErrorf("`--interactive` requires an interactive terminal")
+			}
+
+			if opts.GroupBy != "" {
+				switch opts.GroupBy {
+				case "label", "milestone", "assignee":
+				default:
+					return cmdutil.FlagErrorf("invalid value for --group-by: %q (valid values are: label, milestone, assignee)", opts.GroupBy)
+				}
+				if opts.WebMode {
+					return cmdutil.FlagErrorf("specify only one of `--group-by` or `--web`")
+				}
+				if opts.Interactive {
+					return cmdutil.FlagErrorf("specify only one of `--group-by` or `--interactive`")
+				}
+				if opts.Exporter != nil {
+					return cmdutil.FlagErrorf("specify only one of `--group-by` or `--json`")
+				}
+			} else if opts.Stats {
+				return cmdutil.FlagErrorf("`--stats` requires `--group-by`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -83,6 +124,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the browser to list the issue(s)")
+	cmd.Flags().BoolVar(&opts.Interactive, "interactive", false, "Interactively navigate the results")
 	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Filter by assignee")
 	cmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", nil, "Filter by labels")
 	cmd.Flags().StringVarP(&opts.State, "state", "s", "open", "Filter by state: {open|closed|all}")
@@ -93,7 +135,14 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.Author, "author", "A", "", "Filter by author")
 	cmd.Flags().StringVar(&opts.Mention, "mention", "", "Filter by mention")
 	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Filter by milestone `number` or `title`")
+	cmd.Flags().StringVar(&opts.CreatedAfter, "created-after", "", "Filter by creation `date` on or after YYYY-MM-DD")
+	cmd.Flags().StringVar(&opts.CreatedBefore, "created-before", "", "Filter by creation `date` on or before YYYY-MM-DD")
 	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Search issues with `query`")
+	cmd.Flags().StringVar(&opts.GroupBy, "group-by", "", "Group results by `field`: {label|milestone|assignee}")
+	_ = cmd.RegisterFlagCompletionFunc("group-by", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"label", "milestone", "assignee"}, cobra.ShellCompDirectiveNoFileComp
+	})
+	cmd.Flags().BoolVar(&opts.Stats, "stats", false, "Print the number of issues per group instead of listing them")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.IssueFields)
 
 	return cmd
@@ -125,15 +174,24 @@ func listRun(opts *ListOptions) error {
 	}
 
 	filterOptions := prShared.FilterOptions{
-		Entity:    "issue",
-		State:     issueState,
-		Assignee:  opts.Assignee,
-		Labels:    opts.Labels,
-		Author:    opts.Author,
-		Mention:   opts.Mention,
-		Milestone: opts.Milestone,
-		Search:    opts.Search,
-		Fields:    defaultFields,
+		Entity:        "issue",
+		State:         issueState,
+		Assignee:      opts.Assignee,
+		Labels:        opts.Labels,
+		Author:        opts.Author,
+		Mention:       opts.Mention,
+		Milestone:     opts.Milestone,
+		CreatedAfter:  opts.CreatedAfter,
+		CreatedBefore: opts.CreatedBefore,
+		Search:        opts.Search,
+		Fields:        defaultFields,
+	}
+
+	switch opts.GroupBy {
+	case "milestone":
+		filterOptions.Fields = append(filterOptions.Fields, "milestone")
+	case "assignee":
+		filterOptions.Fields = append(filterOptions.Fields, "assignees")
 	}
 
 	isTerminal := opts.IO.IsStdoutTTY()
@@ -160,6 +218,10 @@ func listRun(opts *ListOptions) error {
 		return err
 	}
 
+	if opts.Interactive {
+		return interactiveList(opts, httpClient, baseRepo, filterOptions, listResult)
+	}
+
 	err = opts.IO.StartPager()
 	if err != nil {
 		return err
@@ -178,15 +240,148 @@ func listRun(opts *ListOptions) error {
 		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", title)
 	}
 
+	if opts.GroupBy != "" {
+		return printGroupedIssues(opts.IO, groupIssues(listResult.Issues, opts.GroupBy), opts.Stats)
+	}
+
 	issueShared.PrintIssues(opts.IO, "", len(listResult.Issues), listResult.Issues)
 
 	return nil
 }
 
+type issueGroup struct {
+	name   string
+	issues []api.Issue
+}
+
+// groupIssues buckets issues by the requested field. An issue with multiple
+// labels or assignees is counted in each of its groups; issues without a value
+// are collected under a catch-all group. Groups are ordered by descending
+// size, then by name.
+func groupIssues(issues []api.Issue, groupBy string) []issueGroup {
+	buckets := map[string][]api.Issue{}
+	for _, issue := range issues {
+		var keys []string
+		switch groupBy {
+		case "label":
+			keys = issue.Labels.Names()
+		case "assignee":
+			keys = issue.Assignees.Logins()
+		case "milestone":
+			if issue.Milestone != nil && issue.Milestone.Title != "" {
+				keys = []string{issue.Milestone.Title}
+			}
+		}
+		if len(keys) == 0 {
+			keys = []string{noGroupName(groupBy)}
+		}
+		for _, key := range keys {
+			buckets[key] = append(buckets[key], issue)
+		}
+	}
+
+	groups := make([]issueGroup, 0, len(buckets))
+	for name, grouped := range buckets {
+		groups = append(groups, issueGroup{name: name, issues: grouped})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if len(groups[i].issues) != len(groups[j].issues) {
+			return len(groups[i].issues) > len(groups[j].issues)
+		}
+		return groups[i].name < groups[j].name
+	})
+	return groups
+}
+
+func noGroupName(groupBy string) string {
+	switch groupBy {
+	case "label":
+		return "no label"
+	case "milestone":
+		return "no milestone"
+	case "assignee":
+		return "unassigned"
+	}
+	return ""
+}
+
+func printGroupedIssues(io *iostreams.IOStreams, groups []issueGroup, stats bool) error {
+	cs := io.ColorScheme()
+
+	if stats {
+		table := utils.NewTablePrinter(io)
+		for _, group := range groups {
+			table.AddField(group.name, nil, cs.Bold)
+			table.AddField(strconv.Itoa(len(group.issues)), nil, nil)
+			table.EndRow()
+		}
+		return table.Render()
+	}
+
+	for i, group := range groups {
+		if i > 0 {
+			fmt.Fprintln(io.Out)
+		}
+		fmt.Fprintf(io.Out, "%s\n", cs.Bold(group.name))
+		issueShared.PrintIssues(io, "  ", len(group.issues), group.issues)
+	}
+	return nil
+}
+
+func interactiveList(opts *ListOptions, httpClient *http.Client, repo ghrepo.Interface, filters prShared.FilterOptions, listResult *api.IssuesAndTotalCount) error {
+	label := func(issue api.Issue) string {
+		return fmt.Sprintf("#%d %s", issue.Number, issue.Title)
+	}
+
+	issues := listResult.Issues
+	items := make([]string, len(issues))
+	for i, issue := range issues {
+		items[i] = label(issue)
+	}
+
+	list := &listview.List{
+		Message: prShared.ListHeader(ghrepo.FullName(repo), "issue", len(issues), listResult.TotalCount, !filters.IsDefault()),
+		Items:   items,
+		Actions: []listview.Action{
+			{
+				Name: "Open in browser",
+				Run: func(index int) error {
+					return opts.Browser.Browse(issues[index].URL)
+				},
+			},
+		},
+	}
+	if len(issues) < listResult.TotalCount {
+		list.LoadMore = func() ([]string, bool, error) {
+			result, err := issueList(httpClient, repo, filters, len(issues)+opts.LimitResults)
+			if err != nil {
+				return nil, false, err
+			}
+			var added []string
+			for _, issue := range result.Issues[len(issues):] {
+				added = append(added, label(issue))
+			}
+			issues = result.Issues
+			return added, len(issues) < result.TotalCount, nil
+		}
+	}
+
+	return list.Run()
+}
+
 func issueList(client *http.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int) (*api.IssuesAndTotalCount, error) {
 	apiClient := api.NewClientFromHTTP(client)
 
-	if filters.Search != "" || len(filters.Labels) > 0 || filters.Milestone != "" {
+	meReplacer := shared.NewMeReplacer(apiClient, repo.RepoHost())
+	if err := meReplacer.ReplaceFilters(&filters); err != nil {
+		return nil, err
+	}
+
+	// the `Repository.issues` connection's filterBy doesn't understand date
+	// ranges or app logins such as "app/dependabot"; those go through search
+	if filters.Search != "" || len(filters.Labels) > 0 || filters.Milestone != "" ||
+		filters.CreatedAfter != "" || filters.CreatedBefore != "" ||
+		strings.HasPrefix(filters.Author, "app/") || strings.HasPrefix(filters.Mention, "app/") {
 		if milestoneNumber, err := strconv.ParseInt(filters.Milestone, 10, 32); err == nil {
 			milestone, err := milestoneByNumber(client, repo, int32(milestoneNumber))
 			if err != nil {
@@ -198,21 +393,6 @@ func issueList(client *http.Client, repo ghrepo.Interface, filters prShared.Filt
 		return searchIssues(apiClient, repo, filters, limit)
 	}
 
-	var err error
-	meReplacer := shared.NewMeReplacer(apiClient, repo.RepoHost())
-	filters.Assignee, err = meReplacer.Replace(filters.Assignee)
-	if err != nil {
-		return nil, err
-	}
-	filters.Author, err = meReplacer.Replace(filters.Author)
-	if err != nil {
-		return nil, err
-	}
-	filters.Mention, err = meReplacer.Replace(filters.Mention)
-	if err != nil {
-		return nil, err
-	}
-
 	return listIssues(apiClient, repo, filters, limit)
 }
 
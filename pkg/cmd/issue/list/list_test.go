@@ -136,6 +136,54 @@ No issues match your search in OWNER/REPO
 `, output.String())
 }
 
+func TestIssueList_groupByLabel(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.FileResponse("./fixtures/issueList.json"))
+
+	output, err := runCommand(http, false, "--group-by label")
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	assert.Equal(t, "", output.Stderr())
+	//nolint:staticcheck // prefer exact matchers over ExpectLines
+	test.ExpectLines(t, output.String(),
+		`label`,
+		`  1[\t]+number won[\t]+label[\t]+\d+`,
+		`  2[\t]+number too[\t]+label[\t]+\d+`,
+		`  4[\t]+number fore[\t]+label[\t]+\d+`)
+}
+
+func TestIssueList_groupByStats(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.FileResponse("./fixtures/issueList.json"))
+
+	output, err := runCommand(http, false, "--group-by label --stats")
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	assert.Equal(t, "", output.Stderr())
+	assert.Equal(t, "label\t3\n", output.String())
+}
+
+func TestIssueList_statsWithoutGroupBy(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "--stats")
+
+	assert.EqualError(t, err, "`--stats` requires `--group-by`")
+}
+
 func TestIssueList_withInvalidLimitFlag(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -365,6 +413,9 @@ func Test_issueList(t *testing.T) {
 				},
 			},
 			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{"data": {"viewer": {"login": "monalisa"} } }`))
 				reg.Register(
 					httpmock.GraphQL(`query IssueSearch\b`),
 					httpmock.GraphQLQuery(`
@@ -379,7 +430,7 @@ func Test_issueList(t *testing.T) {
 							"owner": "OWNER",
 							"repo":  "REPO",
 							"limit": float64(30),
-							"query": "repo:OWNER/REPO is:issue is:open assignee:@me author:@me mentions:@me auth bug",
+							"query": "repo:OWNER/REPO is:issue is:open assignee:monalisa author:monalisa mentions:monalisa auth bug",
 							"type":  "ISSUE",
 						}, params)
 					}))
@@ -417,6 +468,40 @@ func Test_issueList(t *testing.T) {
 					}))
 			},
 		},
+		{
+			name: "app author and date range",
+			args: args{
+				limit: 30,
+				repo:  ghrepo.New("OWNER", "REPO"),
+				filters: prShared.FilterOptions{
+					Entity:        "issue",
+					State:         "open",
+					Author:        "app/dependabot",
+					CreatedAfter:  "2022-01-01",
+					CreatedBefore: "2022-03-01",
+				},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query IssueSearch\b`),
+					httpmock.GraphQLQuery(`
+					{ "data": {
+						"repository": { "hasIssuesEnabled": true },
+						"search": {
+							"issueCount": 0,
+							"nodes": []
+						}
+					} }`, func(_ string, params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"owner": "OWNER",
+							"repo":  "REPO",
+							"limit": float64(30),
+							"query": "repo:OWNER/REPO is:issue is:open author:app/dependabot created:2022-01-01..2022-03-01",
+							"type":  "ISSUE",
+						}, params)
+					}))
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	"github.com/cli/cli/v2/pkg/cmd/issue/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -24,6 +25,7 @@ type ReopenOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 
 	SelectorArg string
+	Comment     string
 }
 
 func NewCmdReopen(f *cmdutil.Factory, runF func(*ReopenOptions) error) *cobra.Command {
@@ -35,7 +37,7 @@ func NewCmdReopen(f *cmdutil.Factory, runF func(*ReopenOptions) error) *cobra.Co
 
 	cmd := &cobra.Command{
 		Use:   "reopen {<number> | <url>}",
-		Short: "Reopen issue",
+		Short: i18n.T("Reopen issue"),
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
@@ -52,6 +54,8 @@ func NewCmdReopen(f *cmdutil.Factory, runF func(*ReopenOptions) error) *cobra.Co
 		},
 	}
 
+	cmd.Flags().StringVarP(&opts.Comment, "comment", "c", "", "Add a reopening comment")
+
 	return cmd
 }
 
@@ -73,6 +77,17 @@ func reopenRun(opts *ReopenOptions) error {
 		return nil
 	}
 
+	if opts.Comment != "" {
+		apiClient := api.NewClientFromHTTP(httpClient)
+		_, err = api.CommentCreate(apiClient, baseRepo.RepoHost(), api.CommentCreateInput{
+			Body:      opts.Comment,
+			SubjectId: issue.ID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	err = apiReopen(httpClient, baseRepo, issue)
 	if err != nil {
 		return err
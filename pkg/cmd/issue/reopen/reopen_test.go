@@ -87,6 +87,48 @@ func TestIssueReopen(t *testing.T) {
 	}
 }
 
+func TestIssueReopen_withComment(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "THE-ID", "number": 2, "state": "CLOSED", "title": "The title of the issue"}
+			} } }`),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation CommentCreate\b`),
+		httpmock.GraphQLMutation(`{"data": {"addComment": {"commentEdge": {"node": {
+			"url": "https://github.com/OWNER/REPO/issues/2#issuecomment-1"
+		}}}}}`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "reopening comment", inputs["body"])
+				assert.Equal(t, "THE-ID", inputs["subjectId"])
+			}),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation IssueReopen\b`),
+		httpmock.GraphQLMutation(`{"id": "THE-ID"}`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "THE-ID", inputs["issueId"])
+			}),
+	)
+
+	output, err := runCommand(http, true, "2 --comment 'reopening comment'")
+	if err != nil {
+		t.Fatalf("error running command `issue reopen`: %v", err)
+	}
+
+	r := regexp.MustCompile(`Reopened issue #2 \(The title of the issue\)`)
+
+	if !r.MatchString(output.Stderr()) {
+		t.Fatalf("output did not match regexp /%s/\n> output\n%q\n", r, output.Stderr())
+	}
+}
+
 func TestIssueReopen_alreadyOpen(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -7,6 +7,7 @@ import (
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	issueShared "github.com/cli/cli/v2/pkg/cmd/issue/shared"
 	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -32,7 +33,7 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 
 	cmd := &cobra.Command{
 		Use:   "status",
-		Short: "Show status of relevant issues",
+		Short: i18n.T("Show status of relevant issues"),
 		Args:  cmdutil.NoArgsQuoteReminder,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
@@ -106,31 +107,31 @@ func statusRun(opts *StatusOptions) error {
 	out := opts.IO.Out
 
 	fmt.Fprintln(out, "")
-	fmt.Fprintf(out, "Relevant issues in %s\n", ghrepo.FullName(baseRepo))
+	fmt.Fprintf(out, i18n.T("Relevant issues in %s\n"), ghrepo.FullName(baseRepo))
 	fmt.Fprintln(out, "")
 
-	prShared.PrintHeader(opts.IO, "Issues assigned to you")
+	prShared.PrintHeader(opts.IO, i18n.T("Issues assigned to you"))
 	if issuePayload.Assigned.TotalCount > 0 {
 		issueShared.PrintIssues(opts.IO, "  ", issuePayload.Assigned.TotalCount, issuePayload.Assigned.Issues)
 	} else {
-		message := "  There are no issues assigned to you"
+		message := i18n.T("  There are no issues assigned to you")
 		prShared.PrintMessage(opts.IO, message)
 	}
 	fmt.Fprintln(out)
 
-	prShared.PrintHeader(opts.IO, "Issues mentioning you")
+	prShared.PrintHeader(opts.IO, i18n.T("Issues mentioning you"))
 	if issuePayload.Mentioned.TotalCount > 0 {
 		issueShared.PrintIssues(opts.IO, "  ", issuePayload.Mentioned.TotalCount, issuePayload.Mentioned.Issues)
 	} else {
-		prShared.PrintMessage(opts.IO, "  There are no issues mentioning you")
+		prShared.PrintMessage(opts.IO, i18n.T("  There are no issues mentioning you"))
 	}
 	fmt.Fprintln(out)
 
-	prShared.PrintHeader(opts.IO, "Issues opened by you")
+	prShared.PrintHeader(opts.IO, i18n.T("Issues opened by you"))
 	if issuePayload.Authored.TotalCount > 0 {
 		issueShared.PrintIssues(opts.IO, "  ", issuePayload.Authored.TotalCount, issuePayload.Authored.Issues)
 	} else {
-		prShared.PrintMessage(opts.IO, "  There are no issues opened by you")
+		prShared.PrintMessage(opts.IO, i18n.T("  There are no issues opened by you"))
 	}
 	fmt.Fprintln(out)
 
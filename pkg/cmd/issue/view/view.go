@@ -11,6 +11,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	issueShared "github.com/cli/cli/v2/pkg/cmd/issue/shared"
 	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -31,10 +32,13 @@ type ViewOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 	Browser    browser
 
-	SelectorArg string
-	WebMode     bool
-	Comments    bool
-	Exporter    cmdutil.Exporter
+	SelectorArg  string
+	WebMode      bool
+	CommentID    string
+	Comments     bool
+	CommentOrder string
+	CommentLast  int
+	Exporter     cmdutil.Exporter
 
 	Now func() time.Time
 }
@@ -49,7 +53,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 	cmd := &cobra.Command{
 		Use:   "view {<number> | <url>}",
-		Short: "View an issue",
+		Short: i18n.T("View an issue"),
 		Long: heredoc.Doc(`
 			Display the title, body, and other information about an issue.
 
@@ -64,6 +68,20 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 				opts.SelectorArg = args[0]
 			}
 
+			if opts.CommentID != "" && !opts.WebMode {
+				return cmdutil.FlagErrorf("the `--comment` flag is only supported with `--web`")
+			}
+
+			if (cmd.Flags().Changed("order") || cmd.Flags().Changed("last")) && !opts.Comments {
+				return cmdutil.FlagErrorf("the `--order` and `--last` flags are only supported with `--comments`")
+			}
+			if opts.CommentOrder != "asc" && opts.CommentOrder != "desc" {
+				return cmdutil.FlagErrorf("invalid value for --order: %q", opts.CommentOrder)
+			}
+			if opts.CommentLast < 0 {
+				return cmdutil.FlagErrorf("invalid value for --last: %v", opts.CommentLast)
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -72,7 +90,10 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open an issue in the browser")
+	cmd.Flags().StringVar(&opts.CommentID, "comment", "", "Open the browser at a specific comment `id`")
 	cmd.Flags().BoolVarP(&opts.Comments, "comments", "c", false, "View issue comments")
+	cmd.Flags().StringVar(&opts.CommentOrder, "order", "asc", "Order of comments: {asc|desc}")
+	cmd.Flags().IntVar(&opts.CommentLast, "last", 0, "Show only the latest `n` comments")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.IssueFields)
 
 	return cmd
@@ -81,6 +102,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 var defaultFields = []string{
 	"number", "url", "state", "createdAt", "title", "body", "author", "milestone",
 	"assignees", "labels", "projectCards", "reactionGroups", "lastComment",
+	"closedByPullRequestsReferences",
 }
 
 func viewRun(opts *ViewOptions) error {
@@ -115,7 +137,7 @@ func viewRun(opts *ViewOptions) error {
 	}
 
 	if opts.WebMode {
-		openURL := issue.URL
+		openURL := prShared.BrowserURL(issue.URL, "", opts.CommentID)
 		if opts.IO.IsStdoutTTY() {
 			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(openURL))
 		}
@@ -137,7 +159,7 @@ func viewRun(opts *ViewOptions) error {
 	}
 
 	if opts.Comments {
-		fmt.Fprint(opts.IO.Out, prShared.RawCommentList(issue.Comments, api.PullRequestReviews{}))
+		fmt.Fprint(opts.IO.Out, prShared.RawCommentList(issue.Comments, api.PullRequestReviews{}, opts.CommentOrder, opts.CommentLast))
 		return nil
 	}
 
@@ -155,7 +177,7 @@ func findIssue(client *http.Client, baseRepoFn func() (ghrepo.Interface, error),
 	}
 
 	if fieldSet.Contains("comments") {
-		err = preloadIssueComments(client, repo, issue)
+		err = prShared.FetchComments(client, repo, issue.ID, &issue.Comments)
 	}
 	return issue, err
 }
@@ -224,6 +246,10 @@ func printHumanIssuePreview(opts *ViewOptions, issue *api.Issue) error {
 		fmt.Fprint(out, cs.Bold("Milestone: "))
 		fmt.Fprintln(out, issue.Milestone.Title)
 	}
+	if linked := issueLinkedPRList(*issue); linked != "" {
+		fmt.Fprint(out, cs.Bold("Closed by: "))
+		fmt.Fprintln(out, linked)
+	}
 
 	// Body
 	var md string
@@ -241,7 +267,7 @@ func printHumanIssuePreview(opts *ViewOptions, issue *api.Issue) error {
 	// Comments
 	if issue.Comments.TotalCount > 0 {
 		preview := !opts.Comments
-		comments, err := prShared.CommentList(opts.IO, issue.Comments, api.PullRequestReviews{}, preview)
+		comments, err := prShared.CommentList(opts.IO, issue.Comments, api.PullRequestReviews{}, preview, opts.CommentOrder, opts.CommentLast)
 		if err != nil {
 			return err
 		}
@@ -297,6 +323,23 @@ func issueProjectList(issue api.Issue) string {
 	return list
 }
 
+func issueLinkedPRList(issue api.Issue) string {
+	if len(issue.ClosedByPullRequestsReferences.Nodes) == 0 {
+		return ""
+	}
+
+	refs := make([]string, 0, len(issue.ClosedByPullRequestsReferences.Nodes))
+	for _, pr := range issue.ClosedByPullRequestsReferences.Nodes {
+		refs = append(refs, fmt.Sprintf("%s#%d", pr.Repository.NameWithOwner, pr.Number))
+	}
+
+	list := strings.Join(refs, ", ")
+	if issue.ClosedByPullRequestsReferences.TotalCount > len(issue.ClosedByPullRequestsReferences.Nodes) {
+		list += ", …"
+	}
+	return list
+}
+
 func issueLabelList(issue *api.Issue, cs *iostreams.ColorScheme) string {
 	if len(issue.Labels.Nodes) == 0 {
 		return ""
@@ -99,6 +99,49 @@ func TestIssueView_web(t *testing.T) {
 	browser.Verify(t, "https://github.com/OWNER/REPO/issues/123")
 }
 
+func TestIssueView_web_comment(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+	browser := &cmdutil.TestBrowser{}
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+				"number": 123,
+				"url": "https://github.com/OWNER/REPO/issues/123"
+			} } } }
+		`))
+
+	_, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	err := viewRun(&ViewOptions{
+		IO:      io,
+		Browser: browser,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		WebMode:     true,
+		CommentID:   "456",
+		SelectorArg: "123",
+	})
+	if err != nil {
+		t.Errorf("error running command `issue view`: %v", err)
+	}
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "Opening github.com/OWNER/REPO/issues/123 in your browser.\n", stderr.String())
+	browser.Verify(t, "https://github.com/OWNER/REPO/issues/123#issuecomment-456")
+}
+
 func TestIssueView_nontty_Preview(t *testing.T) {
 	tests := map[string]struct {
 		fixture         string
@@ -340,8 +383,8 @@ func TestIssueView_tty_Comments(t *testing.T) {
 		"with comments flag": {
 			cli: "123 --comments",
 			fixtures: map[string]string{
-				"IssueByNumber":    "./fixtures/issueView_previewSingleComment.json",
-				"CommentsForIssue": "./fixtures/issueView_previewFullComments.json",
+				"IssueByNumber":      "./fixtures/issueView_previewSingleComment.json",
+				"CommentsForSubject": "./fixtures/issueView_previewFullComments.json",
 			},
 			expectedOutputs: []string{
 				`some title #123`,
@@ -361,6 +404,28 @@ func TestIssueView_tty_Comments(t *testing.T) {
 				`View this issue on GitHub: https://github.com/OWNER/REPO/issues/123`,
 			},
 		},
+		"with comments flag and last": {
+			cli: "123 --comments --last 2",
+			fixtures: map[string]string{
+				"IssueByNumber":      "./fixtures/issueView_previewSingleComment.json",
+				"CommentsForSubject": "./fixtures/issueView_previewFullComments.json",
+			},
+			expectedOutputs: []string{
+				`some title #123`,
+				`sam-spam • This comment has been marked as spam`,
+				`marseilles \(Collaborator\) • Jan  1, 2020 • Newest comment`,
+				`Comment 5`,
+				`View this issue on GitHub: https://github.com/OWNER/REPO/issues/123`,
+			},
+		},
+		"with order flag but no comments flag": {
+			cli:      "123 --order desc",
+			wantsErr: true,
+		},
+		"with invalid order value": {
+			cli:      "123 --comments --order newest",
+			wantsErr: true,
+		},
 		"with invalid comments flag": {
 			cli:      "123 --comments 3",
 			wantsErr: true,
@@ -411,8 +476,8 @@ func TestIssueView_nontty_Comments(t *testing.T) {
 		"with comments flag": {
 			cli: "123 --comments",
 			fixtures: map[string]string{
-				"IssueByNumber":    "./fixtures/issueView_previewSingleComment.json",
-				"CommentsForIssue": "./fixtures/issueView_previewFullComments.json",
+				"IssueByNumber":      "./fixtures/issueView_previewSingleComment.json",
+				"CommentsForSubject": "./fixtures/issueView_previewFullComments.json",
 			},
 			expectedOutputs: []string{
 				`author:\tmonalisa`,
@@ -0,0 +1,204 @@
+package auditlog
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdAuditLog(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    AuditLogOptions
+		wantsErr string
+	}{
+		{
+			name:     "no arguments",
+			wantsErr: "cannot export audit log: organization argument required",
+		},
+		{
+			name: "organization",
+			cli:  "acme-inc",
+			wants: AuditLogOptions{
+				Organization: "acme-inc",
+				Format:       "json",
+				Limit:        1000,
+			},
+		},
+		{
+			name: "filters and format",
+			cli:  `acme-inc --since 2022-01-01 --phrase "action:repo.destroy" --format csv -o audit.csv -L 50`,
+			wants: AuditLogOptions{
+				Organization: "acme-inc",
+				Since:        "2022-01-01",
+				Phrase:       "action:repo.destroy",
+				Format:       "csv",
+				OutputFile:   "audit.csv",
+				Limit:        50,
+			},
+		},
+		{
+			name:     "bad format",
+			cli:      "acme-inc --format xml",
+			wantsErr: `invalid format "xml": must be one of json, csv`,
+		},
+		{
+			name:     "bad limit",
+			cli:      "acme-inc -L 0",
+			wantsErr: "invalid limit: 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *AuditLogOptions
+			cmd := NewCmdAuditLog(f, func(opts *AuditLogOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Organization, gotOpts.Organization)
+			assert.Equal(t, tt.wants.Since, gotOpts.Since)
+			assert.Equal(t, tt.wants.Phrase, gotOpts.Phrase)
+			assert.Equal(t, tt.wants.Format, gotOpts.Format)
+			assert.Equal(t, tt.wants.OutputFile, gotOpts.OutputFile)
+			assert.Equal(t, tt.wants.Limit, gotOpts.Limit)
+		})
+	}
+}
+
+func TestAuditLogRun(t *testing.T) {
+	entries := `[
+		{"@timestamp": 1651406400000, "action": "repo.create", "actor": "hubot", "org": "acme-inc", "repo": "acme-inc/widgets"},
+		{"@timestamp": 1651320000000, "action": "repo.destroy", "actor": "mona", "org": "acme-inc", "repo": "acme-inc/gizmos"}
+	]`
+
+	tests := []struct {
+		name      string
+		opts      AuditLogOptions
+		httpStubs func(*httpmock.Registry)
+		wantQuery string
+		wantOut   string
+	}{
+		{
+			name: "json",
+			opts: AuditLogOptions{
+				Organization: "acme-inc",
+				Format:       "json",
+				Limit:        1000,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/acme-inc/audit-log"),
+					httpmock.StringResponse(entries))
+			},
+			wantOut: `{"@timestamp":1651406400000,"action":"repo.create","actor":"hubot","org":"acme-inc","repo":"acme-inc/widgets"}
+{"@timestamp":1651320000000,"action":"repo.destroy","actor":"mona","org":"acme-inc","repo":"acme-inc/gizmos"}
+`,
+		},
+		{
+			name: "csv",
+			opts: AuditLogOptions{
+				Organization: "acme-inc",
+				Format:       "csv",
+				Limit:        1000,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/acme-inc/audit-log"),
+					httpmock.StringResponse(entries))
+			},
+			wantOut: `@timestamp,action,actor,org,repo
+1651406400000,repo.create,hubot,acme-inc,acme-inc/widgets
+1651320000000,repo.destroy,mona,acme-inc,acme-inc/gizmos
+`,
+		},
+		{
+			name: "since becomes a phrase term",
+			opts: AuditLogOptions{
+				Organization: "acme-inc",
+				Format:       "json",
+				Since:        "2022-01-01",
+				Phrase:       "action:repo.destroy",
+				Limit:        1000,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/acme-inc/audit-log"),
+					httpmock.StringResponse(`[]`))
+			},
+			wantQuery: "per_page=100&phrase=action%3Arepo.destroy+created%3A%3E%3D2022-01-01",
+		},
+		{
+			name: "limit truncates a page",
+			opts: AuditLogOptions{
+				Organization: "acme-inc",
+				Format:       "json",
+				Limit:        1,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/acme-inc/audit-log"),
+					httpmock.StringResponse(entries))
+			},
+			wantOut: `{"@timestamp":1651406400000,"action":"repo.create","actor":"hubot","org":"acme-inc","repo":"acme-inc/widgets"}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+
+			opts := tt.opts
+			opts.IO = io
+			opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.Config = func() (config.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+
+			err := auditLogRun(&opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+			if tt.wantQuery != "" {
+				assert.Equal(t, tt.wantQuery, reg.Requests[0].URL.RawQuery)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package org
+
+import (
+	auditLogCmd "github.com/cli/cli/v2/pkg/cmd/org/auditlog"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdOrg(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "org <command>",
+		Short: "Work with GitHub organizations",
+	}
+
+	cmd.AddCommand(auditLogCmd.NewCmdAuditLog(f, nil))
+
+	return cmd
+}
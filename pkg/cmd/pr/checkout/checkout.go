@@ -12,6 +12,7 @@ import (
 	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -34,8 +35,15 @@ type CheckoutOptions struct {
 	Force             bool
 	Detach            bool
 	BranchName        string
+	Worktree          bool
+	WorktreePath      string
+	RemoveWorktree    bool
 }
 
+// worktreeAutoPath names the worktree directory after the branch when
+// `--worktree` is given without a value
+const worktreeAutoPath = "auto"
+
 func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobra.Command {
 	opts := &CheckoutOptions{
 		IO:         f.IOStreams,
@@ -47,7 +55,7 @@ func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobr
 
 	cmd := &cobra.Command{
 		Use:   "checkout {<number> | <url> | <branch>}",
-		Short: "Check out a pull request in git",
+		Short: i18n.T("Check out a pull request in git"),
 		Args:  cmdutil.ExactArgs(1, "argument required"),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Finder = shared.NewFinder(f)
@@ -56,6 +64,18 @@ func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobr
 				opts.SelectorArg = args[0]
 			}
 
+			if opts.Detach && opts.BranchName != "" {
+				return cmdutil.FlagErrorf("specify only one of `--branch` or `--detach`")
+			}
+
+			opts.Worktree = cmd.Flags().Changed("worktree")
+			if opts.Worktree && opts.Detach {
+				return cmdutil.FlagErrorf("specify only one of `--worktree` or `--detach`")
+			}
+			if opts.RemoveWorktree && !opts.Worktree {
+				return cmdutil.FlagErrorf("`--remove` requires `--worktree`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -67,6 +87,9 @@ func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobr
 	cmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "Reset the existing local branch to the latest state of the pull request")
 	cmd.Flags().BoolVarP(&opts.Detach, "detach", "", false, "Checkout PR with a detached HEAD")
 	cmd.Flags().StringVarP(&opts.BranchName, "branch", "b", "", "Local branch name to use (default: the name of the head branch)")
+	cmd.Flags().StringVar(&opts.WorktreePath, "worktree", "", "Check out the PR in a new git worktree at `path` (default: a directory named after the branch)")
+	cmd.Flags().Lookup("worktree").NoOptDefVal = worktreeAutoPath
+	cmd.Flags().BoolVar(&opts.RemoveWorktree, "remove", false, "Remove the git worktree created for the PR")
 
 	return cmd
 }
@@ -92,6 +115,10 @@ func checkoutRun(opts *CheckoutOptions) error {
 		return err
 	}
 	baseRemote, _ := remotes.FindByRepo(baseRepo.RepoOwner(), baseRepo.RepoName())
+	// a same-named repository on a different host is not the repository the PR belongs to
+	if baseRemote != nil && !strings.EqualFold(baseRemote.RepoHost(), baseRepo.RepoHost()) {
+		baseRemote = nil
+	}
 	baseURLOrName := ghrepo.FormatRemoteURL(baseRepo, protocol)
 	if baseRemote != nil {
 		baseURLOrName = baseRemote.Name
@@ -102,12 +129,19 @@ func checkoutRun(opts *CheckoutOptions) error {
 		headRemote = nil
 	} else if pr.IsCrossRepository {
 		headRemote, _ = remotes.FindByRepo(pr.HeadRepositoryOwner.Login, pr.HeadRepository.Name)
+		if headRemote != nil && !strings.EqualFold(headRemote.RepoHost(), baseRepo.RepoHost()) {
+			headRemote = nil
+		}
 	}
 
 	if strings.HasPrefix(pr.HeadRefName, "-") {
 		return fmt.Errorf("invalid branch name: %q", pr.HeadRefName)
 	}
 
+	if opts.Worktree {
+		return checkoutWorktree(opts, pr, headRemote, baseURLOrName)
+	}
+
 	var cmdQueue [][]string
 
 	if headRemote != nil {
@@ -139,6 +173,76 @@ func checkoutRun(opts *CheckoutOptions) error {
 	return nil
 }
 
+func checkoutWorktree(opts *CheckoutOptions, pr *api.PullRequest, headRemote *context.Remote, baseURLOrName string) error {
+	localBranch := pr.HeadRefName
+	if opts.BranchName != "" {
+		localBranch = opts.BranchName
+	}
+	cs := opts.IO.ColorScheme()
+
+	if opts.RemoveWorktree {
+		worktrees, err := git.Worktrees()
+		if err != nil {
+			return err
+		}
+		for _, wt := range worktrees {
+			if wt.Branch == localBranch {
+				if err := git.RemoveWorktree(wt.Path); err != nil {
+					return err
+				}
+				fmt.Fprintf(opts.IO.ErrOut, "%s Removed worktree %s\n", cs.SuccessIcon(), wt.Path)
+				return nil
+			}
+		}
+		return fmt.Errorf("no worktree found for branch %q", localBranch)
+	}
+
+	branchExisted := localBranchExists(localBranch)
+
+	var cmdQueue [][]string
+	var remoteBranch string
+	if headRemote != nil {
+		remoteBranch = fmt.Sprintf("%s/%s", headRemote.Name, pr.HeadRefName)
+		refSpec := fmt.Sprintf("+refs/heads/%s:refs/remotes/%s", pr.HeadRefName, remoteBranch)
+		cmdQueue = append(cmdQueue, []string{"git", "fetch", headRemote.Name, refSpec})
+	} else if !branchExisted {
+		ref := fmt.Sprintf("refs/pull/%d/head", pr.Number)
+		cmdQueue = append(cmdQueue, []string{"git", "fetch", baseURLOrName, fmt.Sprintf("%s:%s", ref, localBranch)})
+	}
+
+	if err := executeCmds(cmdQueue); err != nil {
+		return err
+	}
+
+	worktrees, err := git.Worktrees()
+	if err != nil {
+		return err
+	}
+	for _, wt := range worktrees {
+		if wt.Branch == localBranch {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Using existing worktree for branch %q at %s\n", cs.SuccessIcon(), localBranch, wt.Path)
+			return nil
+		}
+	}
+
+	dir := opts.WorktreePath
+	if dir == worktreeAutoPath {
+		dir = strings.ReplaceAll(localBranch, "/", "-")
+	}
+
+	if headRemote != nil && !branchExisted {
+		err = git.AddTrackedWorktree(dir, localBranch, remoteBranch)
+	} else {
+		err = git.AddWorktree(dir, localBranch)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Created worktree for pull request #%d at %s\n", cs.SuccessIcon(), pr.Number, dir)
+	return nil
+}
+
 func cmdsForExistingRemote(remote *context.Remote, pr *api.PullRequest, opts *CheckoutOptions) [][]string {
 	var cmds [][]string
 	remoteBranch := fmt.Sprintf("%s/%s", remote.Name, pr.HeadRefName)
@@ -101,6 +101,34 @@ func Test_checkoutRun(t *testing.T) {
 				cs.Register(`git config branch\.feature\.merge refs/pull/123/head`, 0, "")
 			},
 		},
+		{
+			name: "from URL with a different host than the local remotes",
+			opts: &CheckoutOptions{
+				SelectorArg: "https://ghe.example.com/OWNER/REPO/pull/123",
+				Finder: func() shared.PRFinder {
+					baseRepo, pr := stubPR("ghe.example.com/OWNER/REPO:master", "ghe.example.com/OWNER/REPO:feature")
+					finder := shared.NewMockFinder("https://ghe.example.com/OWNER/REPO/pull/123", pr, baseRepo)
+					return finder
+				}(),
+				Config: func() (config.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+				Branch: func() (string, error) {
+					return "main", nil
+				},
+			},
+			remotes: map[string]string{
+				"origin": "OWNER/REPO",
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git fetch https://ghe\.example\.com/OWNER/REPO\.git refs/pull/123/head:feature`, 0, "")
+				cs.Register(`git config branch\.feature\.merge`, 1, "")
+				cs.Register(`git checkout feature`, 0, "")
+				cs.Register(`git config branch\.feature\.remote https://ghe\.example\.com/OWNER/REPO\.git`, 0, "")
+				cs.Register(`git config branch\.feature\.pushRemote https://ghe\.example\.com/OWNER/REPO\.git`, 0, "")
+				cs.Register(`git config branch\.feature\.merge refs/pull/123/head`, 0, "")
+			},
+		},
 		{
 			name: "with local branch rename and existing git remote",
 			opts: &CheckoutOptions{
@@ -157,6 +185,118 @@ func Test_checkoutRun(t *testing.T) {
 				cs.Register(`git config branch\.foobar\.merge refs/heads/feature`, 0, "")
 			},
 		},
+		{
+			name: "with worktree and existing git remote",
+			opts: &CheckoutOptions{
+				SelectorArg:  "123",
+				Worktree:     true,
+				WorktreePath: worktreeAutoPath,
+				Finder: func() shared.PRFinder {
+					baseRepo, pr := stubPR("OWNER/REPO:master", "OWNER/REPO:feature")
+					finder := shared.NewMockFinder("123", pr, baseRepo)
+					return finder
+				}(),
+				Config: func() (config.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+				Branch: func() (string, error) {
+					return "main", nil
+				},
+			},
+			remotes: map[string]string{
+				"origin": "OWNER/REPO",
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git show-ref --verify -- refs/heads/feature`, 1, "")
+				cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature`, 0, "")
+				cs.Register(`git worktree list --porcelain`, 0, "")
+				cs.Register(`git worktree add --track -b feature feature origin/feature`, 0, "")
+			},
+			wantStderr: "✓ Created worktree for pull request #123 at feature\n",
+		},
+		{
+			name: "with worktree that already exists for the branch",
+			opts: &CheckoutOptions{
+				SelectorArg:  "123",
+				Worktree:     true,
+				WorktreePath: worktreeAutoPath,
+				Finder: func() shared.PRFinder {
+					baseRepo, pr := stubPR("OWNER/REPO:master", "OWNER/REPO:feature")
+					finder := shared.NewMockFinder("123", pr, baseRepo)
+					return finder
+				}(),
+				Config: func() (config.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+				Branch: func() (string, error) {
+					return "main", nil
+				},
+			},
+			remotes: map[string]string{
+				"origin": "OWNER/REPO",
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git show-ref --verify -- refs/heads/feature`, 0, "")
+				cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature`, 0, "")
+				cs.Register(`git worktree list --porcelain`, 0, "worktree /tmp/review-feature\nHEAD abcdef0123\nbranch refs/heads/feature\n")
+			},
+			wantStderr: "✓ Using existing worktree for branch \"feature\" at /tmp/review-feature\n",
+		},
+		{
+			name: "with worktree remove",
+			opts: &CheckoutOptions{
+				SelectorArg:    "123",
+				Worktree:       true,
+				WorktreePath:   worktreeAutoPath,
+				RemoveWorktree: true,
+				Finder: func() shared.PRFinder {
+					baseRepo, pr := stubPR("OWNER/REPO:master", "OWNER/REPO:feature")
+					finder := shared.NewMockFinder("123", pr, baseRepo)
+					return finder
+				}(),
+				Config: func() (config.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+				Branch: func() (string, error) {
+					return "main", nil
+				},
+			},
+			remotes: map[string]string{
+				"origin": "OWNER/REPO",
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git worktree list --porcelain`, 0, "worktree /tmp/review-feature\nHEAD abcdef0123\nbranch refs/heads/feature\n")
+				cs.Register(`git worktree remove /tmp/review-feature`, 0, "")
+			},
+			wantStderr: "✓ Removed worktree /tmp/review-feature\n",
+		},
+		{
+			name: "with worktree remove and no matching worktree",
+			opts: &CheckoutOptions{
+				SelectorArg:    "123",
+				Worktree:       true,
+				WorktreePath:   worktreeAutoPath,
+				RemoveWorktree: true,
+				Finder: func() shared.PRFinder {
+					baseRepo, pr := stubPR("OWNER/REPO:master", "OWNER/REPO:feature")
+					finder := shared.NewMockFinder("123", pr, baseRepo)
+					return finder
+				}(),
+				Config: func() (config.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+				Branch: func() (string, error) {
+					return "main", nil
+				},
+			},
+			remotes: map[string]string{
+				"origin": "OWNER/REPO",
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git worktree list --porcelain`, 0, "")
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -277,6 +417,26 @@ func TestPRCheckout_sameRepo(t *testing.T) {
 	assert.Equal(t, "", output.Stderr())
 }
 
+func TestPRCheckout_removeWithoutWorktree(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	shared.RunCommandFinder("123", nil, nil)
+
+	_, err := runCommand(http, nil, "master", `123 --remove`)
+	assert.EqualError(t, err, "`--remove` requires `--worktree`")
+}
+
+func TestPRCheckout_worktreeWithDetach(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	shared.RunCommandFinder("123", nil, nil)
+
+	_, err := runCommand(http, nil, "master", `123 --worktree --detach`)
+	assert.EqualError(t, err, "specify only one of `--worktree` or `--detach`")
+}
+
 func TestPRCheckout_existingBranch(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -2,12 +2,16 @@ package checks
 
 import (
 	"fmt"
+	"net/http"
+	"reflect"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -20,24 +24,37 @@ type browser interface {
 }
 
 type ChecksOptions struct {
-	IO      *iostreams.IOStreams
-	Browser browser
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Browser    browser
 
-	Finder shared.PRFinder
+	Finder   shared.PRFinder
+	Exporter cmdutil.Exporter
 
 	SelectorArg string
 	WebMode     bool
+	Required    bool
+}
+
+var checkFields = []string{
+	"bucket",
+	"completedAt",
+	"link",
+	"name",
+	"startedAt",
+	"state",
 }
 
 func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Command {
 	opts := &ChecksOptions{
-		IO:      f.IOStreams,
-		Browser: f.Browser,
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+		Browser:    f.Browser,
 	}
 
 	cmd := &cobra.Command{
 		Use:   "checks [<number> | <url> | <branch>]",
-		Short: "Show CI status for a single pull request",
+		Short: i18n.T("Show CI status for a single pull request"),
 		Long: heredoc.Doc(`
 			Show CI status for a single pull request.
 
@@ -65,10 +82,38 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 	}
 
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the web browser to show details about checks")
+	cmd.Flags().BoolVar(&opts.Required, "required", false, "Only show checks that are required")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, checkFields)
 
 	return cmd
 }
 
+// check serves as an exportable, flattened view of an api.CheckContext
+type check struct {
+	Name        string    `json:"name"`
+	State       string    `json:"state"`
+	StartedAt   time.Time `json:"startedAt"`
+	CompletedAt time.Time `json:"completedAt"`
+	Link        string    `json:"link"`
+	Bucket      string    `json:"bucket"`
+
+	Elapsed   string              `json:"-"`
+	Mark      string              `json:"-"`
+	MarkColor func(string) string `json:"-"`
+}
+
+func (c check) ExportData(fields []string) map[string]interface{} {
+	v := reflect.ValueOf(c)
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		sf := v.FieldByNameFunc(func(s string) bool {
+			return strings.EqualFold(f, s)
+		})
+		data[f] = sf.Interface()
+	}
+	return data
+}
+
 func checksRun(opts *ChecksOptions) error {
 	findOptions := shared.FindOptions{
 		Selector: opts.SelectorArg,
@@ -76,6 +121,10 @@ func checksRun(opts *ChecksOptions) error {
 	}
 	if opts.WebMode {
 		findOptions.Fields = []string{"number"}
+	} else if opts.Required {
+		// the required-ness of a check can only be resolved relative to a pull request ID,
+		// which is not known until after the lookup; see fetchRequiredStatusChecks
+		findOptions.Fields = []string{"number", "baseRefName", "id"}
 	}
 	pr, baseRepo, err := opts.Finder.Find(findOptions)
 	if err != nil {
@@ -92,6 +141,16 @@ func checksRun(opts *ChecksOptions) error {
 		return opts.Browser.Browse(openURL)
 	}
 
+	if opts.Required {
+		httpClient, err := opts.HttpClient()
+		if err != nil {
+			return err
+		}
+		if err := fetchRequiredStatusChecks(httpClient, baseRepo, pr); err != nil {
+			return err
+		}
+	}
+
 	if len(pr.StatusCheckRollup.Nodes) == 0 {
 		return fmt.Errorf("no commit found on the pull request")
 	}
@@ -106,21 +165,16 @@ func checksRun(opts *ChecksOptions) error {
 	skipping := 0
 	pending := 0
 
-	type output struct {
-		mark      string
-		bucket    string
-		name      string
-		elapsed   string
-		link      string
-		markColor func(string) string
-	}
-
 	cs := opts.IO.ColorScheme()
 
-	outputs := []output{}
+	outputs := []check{}
 
 	checkContexts := pr.StatusCheckRollup.Nodes[0].Commit.StatusCheckRollup.Contexts.Nodes
 	for _, c := range eliminateDuplicates(checkContexts) {
+		if opts.Required && !c.IsRequired {
+			continue
+		}
+
 		mark := "✓"
 		bucket := "pass"
 		state := c.State
@@ -140,11 +194,16 @@ func checksRun(opts *ChecksOptions) error {
 			markColor = cs.Gray
 			skipping++
 			bucket = "skipping"
-		case "ERROR", "FAILURE", "CANCELLED", "TIMED_OUT", "ACTION_REQUIRED":
+		case "ERROR", "FAILURE", "TIMED_OUT", "ACTION_REQUIRED":
 			mark = "X"
 			markColor = cs.Red
 			failing++
 			bucket = "fail"
+		case "CANCELLED":
+			mark = "X"
+			markColor = cs.Red
+			failing++
+			bucket = "cancel"
 		default: // "EXPECTED", "REQUESTED", "WAITING", "QUEUED", "PENDING", "IN_PROGRESS", "STALE"
 			mark = "*"
 			markColor = cs.Yellow
@@ -172,16 +231,30 @@ func checksRun(opts *ChecksOptions) error {
 			name = c.Context
 		}
 
-		outputs = append(outputs, output{mark, bucket, name, elapsed, link, markColor})
+		outputs = append(outputs, check{
+			Name:        name,
+			State:       state,
+			StartedAt:   c.StartedAt,
+			CompletedAt: c.CompletedAt,
+			Link:        link,
+			Bucket:      bucket,
+			Elapsed:     elapsed,
+			Mark:        mark,
+			MarkColor:   markColor,
+		})
+	}
+
+	if opts.Required && len(outputs) == 0 {
+		return fmt.Errorf("no required checks reported on the '%s' branch", pr.BaseRefName)
 	}
 
 	sort.Slice(outputs, func(i, j int) bool {
-		b0 := outputs[i].bucket
-		n0 := outputs[i].name
-		l0 := outputs[i].link
-		b1 := outputs[j].bucket
-		n1 := outputs[j].name
-		l1 := outputs[j].link
+		b0 := outputs[i].Bucket
+		n0 := outputs[i].Name
+		l0 := outputs[i].Link
+		b1 := outputs[j].Bucket
+		n1 := outputs[j].Name
+		l1 := outputs[j].Link
 
 		if b0 == b1 {
 			if n0 == n1 {
@@ -193,23 +266,27 @@ func checksRun(opts *ChecksOptions) error {
 		return (b0 == "fail") || (b0 == "pending" && b1 == "success")
 	})
 
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, outputs)
+	}
+
 	tp := utils.NewTablePrinter(opts.IO)
 
 	for _, o := range outputs {
 		if isTerminal {
-			tp.AddField(o.mark, nil, o.markColor)
-			tp.AddField(o.name, nil, nil)
-			tp.AddField(o.elapsed, nil, nil)
-			tp.AddField(o.link, nil, nil)
+			tp.AddField(o.Mark, nil, o.MarkColor)
+			tp.AddField(o.Name, nil, nil)
+			tp.AddField(o.Elapsed, nil, nil)
+			tp.AddField(o.Link, nil, nil)
 		} else {
-			tp.AddField(o.name, nil, nil)
-			tp.AddField(o.bucket, nil, nil)
-			if o.elapsed == "" {
+			tp.AddField(o.Name, nil, nil)
+			tp.AddField(o.Bucket, nil, nil)
+			if o.Elapsed == "" {
 				tp.AddField("0", nil, nil)
 			} else {
-				tp.AddField(o.elapsed, nil, nil)
+				tp.AddField(o.Elapsed, nil, nil)
 			}
-			tp.AddField(o.link, nil, nil)
+			tp.AddField(o.Link, nil, nil)
 		}
 
 		tp.EndRow()
@@ -248,6 +325,54 @@ func checksRun(opts *ChecksOptions) error {
 	return nil
 }
 
+// fetchRequiredStatusChecks re-fetches the status check rollup for a pull request, resolving
+// whether each check is required by branch protection of the base branch.
+func fetchRequiredStatusChecks(httpClient *http.Client, repo ghrepo.Interface, pr *api.PullRequest) error {
+	type response struct {
+		Node *api.PullRequest
+	}
+
+	query := fmt.Sprintf(`
+	query PullRequestRequiredChecks($id: ID!, $endCursor: String) {
+		node(id: $id) {
+			...on PullRequest {
+				%s
+			}
+		}
+	}`, api.RequiredStatusCheckRollupGraphQL("$id", "$endCursor"))
+
+	variables := map[string]interface{}{
+		"id": pr.ID,
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	for {
+		var resp response
+		err := apiClient.GraphQL(repo.RepoHost(), query, variables, &resp)
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Node.StatusCheckRollup.Nodes) == 0 {
+			return nil
+		}
+
+		result := resp.Node.StatusCheckRollup.Nodes[0].Commit.StatusCheckRollup.Contexts
+		if len(pr.StatusCheckRollup.Nodes) == 0 {
+			pr.StatusCheckRollup = resp.Node.StatusCheckRollup
+		} else {
+			rollup := &pr.StatusCheckRollup.Nodes[0].Commit.StatusCheckRollup.Contexts
+			rollup.Nodes = append(rollup.Nodes, result.Nodes...)
+			rollup.PageInfo = result.PageInfo
+		}
+
+		if !result.PageInfo.HasNextPage {
+			return nil
+		}
+		variables["endCursor"] = result.PageInfo.EndCursor
+	}
+}
+
 func eliminateDuplicates(checkContexts []api.CheckContext) []api.CheckContext {
 	// To return the most recent check, sort in descending order by StartedAt.
 	sort.Slice(checkContexts, func(i, j int) bool { return checkContexts[i].StartedAt.After(checkContexts[j].StartedAt) })
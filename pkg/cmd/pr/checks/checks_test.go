@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"io"
+	"net/http"
 	"os"
 	"reflect"
 	"testing"
@@ -14,6 +15,7 @@ import (
 	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/assert"
@@ -38,6 +40,13 @@ func TestNewCmdChecks(t *testing.T) {
 				SelectorArg: "1234",
 			},
 		},
+		{
+			name: "required flag",
+			cli:  "--required",
+			wants: ChecksOptions{
+				Required: true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -64,18 +73,21 @@ func TestNewCmdChecks(t *testing.T) {
 			assert.NoError(t, err)
 
 			assert.Equal(t, tt.wants.SelectorArg, gotOpts.SelectorArg)
+			assert.Equal(t, tt.wants.Required, gotOpts.Required)
 		})
 	}
 }
 
 func Test_checksRun(t *testing.T) {
 	tests := []struct {
-		name    string
-		fixture string
-		prJSON  string
-		nontty  bool
-		wantOut string
-		wantErr string
+		name      string
+		fixture   string
+		prJSON    string
+		nontty    bool
+		required  bool
+		httpStubs func(*httpmock.Registry)
+		wantOut   string
+		wantErr   string
 	}{
 		{
 			name:    "no commits",
@@ -154,6 +166,32 @@ func Test_checksRun(t *testing.T) {
 			wantOut: "All checks were successful\n0 failing, 1 successful, 2 skipped, and 0 pending checks\n\n✓  cool tests  1m26s  sweet link\n-  rad tests   1m26s  sweet link\n-  skip tests  1m26s  sweet link\n",
 			wantErr: "",
 		},
+		{
+			name:     "only required",
+			required: true,
+			prJSON:   `{ "number": 123, "id": "pr123", "baseRefName": "master" }`,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestRequiredChecks\b`),
+					httpmock.FileResponse("./fixtures/onlyRequired.json"))
+			},
+			wantOut: "All checks were successful\n0 failing, 1 successful, 0 skipped, and 0 pending checks\n\n✓  cool tests  1m26s  sweet link\n",
+			wantErr: "",
+		},
+		{
+			name:     "no required checks",
+			required: true,
+			prJSON:   `{ "number": 123, "id": "pr123", "baseRefName": "master" }`,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestRequiredChecks\b`),
+					httpmock.StringResponse(`{ "data": { "node": { "statusCheckRollup": { "nodes": [{ "commit": { "oid": "abc", "statusCheckRollup": { "contexts": { "nodes": [
+						{ "conclusion": "SUCCESS", "status": "COMPLETED", "name": "cool tests", "isRequired": false }
+					], "pageInfo": { "hasNextPage": false } } } } }] } } } }`))
+			},
+			wantOut: "",
+			wantErr: "no required checks reported on the 'master' branch",
+		},
 	}
 
 	for _, tt := range tests {
@@ -178,6 +216,16 @@ func Test_checksRun(t *testing.T) {
 				IO:          ios,
 				SelectorArg: "123",
 				Finder:      shared.NewMockFinder("123", response, ghrepo.New("OWNER", "REPO")),
+				Required:    tt.required,
+			}
+
+			if tt.httpStubs != nil {
+				reg := &httpmock.Registry{}
+				defer reg.Verify(t)
+				tt.httpStubs(reg)
+				opts.HttpClient = func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				}
 			}
 
 			err := checksRun(opts)
@@ -3,6 +3,7 @@ package comment
 import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -16,13 +17,14 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*shared.CommentableOptions) err
 		InteractiveEditSurvey: shared.CommentableInteractiveEditSurvey(f.Config, f.IOStreams),
 		ConfirmSubmitSurvey:   shared.CommentableConfirmSubmitSurvey,
 		OpenInBrowser:         f.Browser.Browse,
+		RestoreCmd:            "gh pr comment",
 	}
 
 	var bodyFile string
 
 	cmd := &cobra.Command{
 		Use:   "comment [<number> | <url> | <branch>]",
-		Short: "Create a new pr comment",
+		Short: i18n.T("Create a new pr comment"),
 		Long: heredoc.Doc(`
 			Create a new pr comment.
 
@@ -47,10 +49,16 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*shared.CommentableOptions) err
 			opts.RetrieveCommentable = func() (shared.Commentable, ghrepo.Interface, error) {
 				return finder.Find(shared.FindOptions{
 					Selector: selector,
-					Fields:   []string{"id", "url"},
+					Fields:   []string{"id", "url", "number"},
 				})
 			}
-			return shared.CommentablePreRun(cmd, opts)
+			if err := shared.CommentablePreRun(cmd, opts); err != nil {
+				return err
+			}
+			if opts.ReplyToID != 0 && opts.InputType == shared.InputTypeWeb {
+				return cmdutil.FlagErrorf("`--reply-to` is not supported with `--web`")
+			}
+			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if bodyFile != "" {
@@ -70,8 +78,11 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*shared.CommentableOptions) err
 
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Supply a body. Will prompt for one otherwise.")
 	cmd.Flags().StringVarP(&bodyFile, "body-file", "F", "", "Read body text from `file` (use \"-\" to read from standard input)")
+	cmd.Flags().StringArrayVar(&opts.Attachments, "attach", nil, "Upload a `file` and append a link to it to the comment body")
 	cmd.Flags().BoolP("editor", "e", false, "Add body using editor")
 	cmd.Flags().BoolP("web", "w", false, "Add body in browser")
+	cmd.Flags().StringVar(&opts.RecoverFile, "recover", "", "Recover input from a failed run of comment")
+	cmd.Flags().IntVar(&opts.ReplyToID, "reply-to", 0, "Reply to the review comment with the given `id`")
 
 	return cmd
 }
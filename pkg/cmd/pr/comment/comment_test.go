@@ -128,6 +128,23 @@ func TestNewCmdComment(t *testing.T) {
 			},
 			wantsErr: false,
 		},
+		{
+			name:  "reply to review comment",
+			input: "1 --body test --reply-to 456",
+			output: shared.CommentableOptions{
+				Interactive: false,
+				InputType:   shared.InputTypeInline,
+				Body:        "test",
+				ReplyToID:   456,
+			},
+			wantsErr: false,
+		},
+		{
+			name:     "reply-to and web flags",
+			input:    "1 --web --reply-to 456",
+			output:   shared.CommentableOptions{},
+			wantsErr: true,
+		},
 		{
 			name:     "body and body-file flags",
 			input:    "1 --body 'test' --body-file 'test-file.txt'",
@@ -201,6 +218,7 @@ func TestNewCmdComment(t *testing.T) {
 			assert.Equal(t, tt.output.Interactive, gotOpts.Interactive)
 			assert.Equal(t, tt.output.InputType, gotOpts.InputType)
 			assert.Equal(t, tt.output.Body, gotOpts.Body)
+			assert.Equal(t, tt.output.ReplyToID, gotOpts.ReplyToID)
 		})
 	}
 }
@@ -220,7 +238,7 @@ func Test_commentRun(t *testing.T) {
 				InputType:   0,
 				Body:        "",
 
-				InteractiveEditSurvey: func() (string, error) { return "comment body", nil },
+				InteractiveEditSurvey: func(string) (string, error) { return "comment body", nil },
 				ConfirmSubmitSurvey:   func() (bool, error) { return true, nil },
 			},
 			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
@@ -246,7 +264,7 @@ func Test_commentRun(t *testing.T) {
 				InputType:   shared.InputTypeEditor,
 				Body:        "",
 
-				EditSurvey: func() (string, error) { return "comment body", nil },
+				EditSurvey: func(string) (string, error) { return "comment body", nil },
 			},
 			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
 				mockCommentCreate(t, reg)
@@ -265,6 +283,25 @@ func Test_commentRun(t *testing.T) {
 			},
 			stdout: "https://github.com/OWNER/REPO/pull/123#issuecomment-456\n",
 		},
+		{
+			name: "reply to review comment",
+			input: &shared.CommentableOptions{
+				Interactive: false,
+				InputType:   shared.InputTypeInline,
+				Body:        "comment body",
+				ReplyToID:   456,
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/pulls/123/comments/456/replies"),
+					httpmock.RESTPayload(201, `{
+						"html_url": "https://github.com/OWNER/REPO/pull/123#discussion_r789"
+					}`, func(payload map[string]interface{}) {
+						assert.Equal(t, "comment body", payload["body"])
+					}))
+			},
+			stdout: "https://github.com/OWNER/REPO/pull/123#discussion_r789\n",
+		},
 	}
 	for _, tt := range tests {
 		io, _, stdout, stderr := iostreams.Test()
@@ -16,6 +16,7 @@ import (
 	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -44,15 +45,18 @@ type CreateOptions struct {
 	RootDirOverride string
 	RepoOverride    string
 
-	Autofill    bool
-	WebMode     bool
-	RecoverFile string
+	Autofill     bool
+	WebMode      bool
+	RecoverFile  string
+	EditExisting bool
 
-	IsDraft    bool
-	Title      string
-	Body       string
-	BaseBranch string
-	HeadBranch string
+	IsDraft     bool
+	Title       string
+	Body        string
+	Template    string
+	Attachments []string
+	BaseBranch  string
+	HeadBranch  string
 
 	Reviewers []string
 	Assignees []string
@@ -92,7 +96,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 	cmd := &cobra.Command{
 		Use:   "create",
-		Short: "Create a pull request",
+		Short: i18n.T("Create a pull request"),
 		Long: heredoc.Docf(`
 			Create a pull request on GitHub.
 
@@ -142,6 +146,12 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			if cmd.Flags().Changed("no-maintainer-edit") && opts.WebMode {
 				return errors.New("the `--no-maintainer-edit` flag is not supported with `--web`")
 			}
+			if len(opts.Attachments) > 0 && opts.WebMode {
+				return errors.New("the `--attach` flag is not supported with `--web`")
+			}
+			if opts.EditExisting && opts.WebMode {
+				return errors.New("the `--edit-existing` flag is not supported with `--web`")
+			}
 
 			opts.BodyProvided = cmd.Flags().Changed("body")
 			if bodyFile != "" {
@@ -153,6 +163,13 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				opts.BodyProvided = true
 			}
 
+			if opts.Template != "" && opts.WebMode {
+				return errors.New("the `--template` flag is not supported with `--web`")
+			}
+			if opts.Template != "" && opts.BodyProvided {
+				return errors.New("`--template` is not supported when using `--body` or `--body-file`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -164,7 +181,9 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	fl.BoolVarP(&opts.IsDraft, "draft", "d", false, "Mark pull request as a draft")
 	fl.StringVarP(&opts.Title, "title", "t", "", "Title for the pull request")
 	fl.StringVarP(&opts.Body, "body", "b", "", "Body for the pull request")
+	fl.StringVarP(&opts.Template, "template", "T", "", "Use a pull request template by `name`")
 	fl.StringVarP(&bodyFile, "body-file", "F", "", "Read body text from `file`")
+	fl.StringArrayVar(&opts.Attachments, "attach", nil, "Upload a `file` and append a link to it to the body")
 	fl.StringVarP(&opts.BaseBranch, "base", "B", "", "The `branch` into which you want your code merged")
 	fl.StringVarP(&opts.HeadBranch, "head", "H", "", "The `branch` that contains commits for your pull request (default: current branch)")
 	fl.BoolVarP(&opts.WebMode, "web", "w", false, "Open the web browser to create a pull request")
@@ -176,6 +195,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	fl.StringVarP(&opts.Milestone, "milestone", "m", "", "Add the pull request to a milestone by `name`")
 	fl.Bool("no-maintainer-edit", false, "Disable maintainer's ability to modify pull request")
 	fl.StringVar(&opts.RecoverFile, "recover", "", "Recover input from a failed run of create")
+	fl.BoolVar(&opts.EditExisting, "edit-existing", false, "Update the existing pull request for the branch instead of failing")
 
 	return cmd
 }
@@ -209,8 +229,19 @@ func createRun(opts *CreateOptions) (err error) {
 			return
 		}
 		if !utils.ValidURL(openURL) {
-			err = fmt.Errorf("cannot open in browser: maximum URL length exceeded")
-			return
+			// fall back to preserving the body outside of the URL
+			err = shared.PreserveBodyForWeb(opts.IO, state)
+			if err != nil {
+				return
+			}
+			openURL, err = generateCompareURL(*ctx, *state)
+			if err != nil {
+				return
+			}
+			if !utils.ValidURL(openURL) {
+				err = fmt.Errorf("cannot open in browser: maximum URL length exceeded")
+				return
+			}
 		}
 		return previewPR(*opts, openURL)
 	}
@@ -223,28 +254,59 @@ func createRun(opts *CreateOptions) (err error) {
 		state.Body = opts.Body
 	}
 
+	// with --template in a non-interactive context, the selected template
+	// becomes the body so that scripted creation needs no prompting
+	if opts.Template != "" && !opts.IO.CanPrompt() {
+		tpl := shared.NewTemplateManager(client.HTTP(), ctx.BaseRepo, opts.RootDirOverride, opts.RepoOverride == "", true)
+		var template shared.Template
+		template, err = tpl.Select(opts.Template)
+		if err != nil {
+			return
+		}
+		state.Body = string(template.Body())
+		opts.BodyProvided = true
+	}
+
+	cs := opts.IO.ColorScheme()
+
 	existingPR, _, err := opts.Finder.Find(shared.FindOptions{
 		Selector:   ctx.HeadBranchLabel,
 		BaseBranch: ctx.BaseBranch,
 		States:     []string{"OPEN"},
-		Fields:     []string{"url"},
+		Fields:     []string{"id", "url"},
 	})
 	var notFound *shared.NotFoundError
 	if err != nil && !errors.As(err, &notFound) {
 		return fmt.Errorf("error checking for existing pull request: %w", err)
 	}
 	if err == nil {
+		editExisting := opts.EditExisting
+		if !editExisting && opts.IO.CanPrompt() {
+			fmt.Fprintf(opts.IO.ErrOut, "%s A pull request for branch %q into branch %q already exists:\n%s\n",
+				cs.WarningIcon(), ctx.HeadBranchLabel, ctx.BaseBranch, existingPR.URL)
+			err = prompt.SurveyAskOne(&survey.Confirm{
+				Message: "Edit the existing pull request instead?",
+				Default: false,
+			}, &editExisting)
+			if err != nil {
+				return fmt.Errorf("could not prompt: %w", err)
+			}
+			if !editExisting {
+				return cmdutil.CancelError
+			}
+		}
+		if editExisting {
+			return editExistingPR(opts, ctx, existingPR)
+		}
 		return fmt.Errorf("a pull request for branch %q into branch %q already exists:\n%s",
 			ctx.HeadBranchLabel, ctx.BaseBranch, existingPR.URL)
 	}
 
-	message := "\nCreating pull request for %s into %s in %s\n\n"
+	message := i18n.T("\nCreating pull request for %s into %s in %s\n\n")
 	if state.Draft {
-		message = "\nCreating draft pull request for %s into %s in %s\n\n"
+		message = i18n.T("\nCreating draft pull request for %s into %s in %s\n\n")
 	}
 
-	cs := opts.IO.ColorScheme()
-
 	if opts.IO.CanPrompt() {
 		fmt.Fprintf(opts.IO.ErrOut, message,
 			cs.Cyan(ctx.HeadBranchLabel),
@@ -286,7 +348,11 @@ func createRun(opts *CreateOptions) (err error) {
 		if opts.RecoverFile == "" {
 			tpl := shared.NewTemplateManager(client.HTTP(), ctx.BaseRepo, opts.RootDirOverride, opts.RepoOverride == "", true)
 			var template shared.Template
-			template, err = tpl.Choose()
+			if opts.Template != "" {
+				template, err = tpl.Select(opts.Template)
+			} else {
+				template, err = tpl.Choose()
+			}
 			if err != nil {
 				return
 			}
@@ -317,6 +383,7 @@ func createRun(opts *CreateOptions) (err error) {
 	}
 
 	if action == shared.MetadataAction {
+		state.SuggestedReviewers = shared.SuggestedReviewers(client, ctx.BaseRepo, ctx.BaseTrackingBranch, ctx.HeadBranch)
 		fetcher := &shared.MetadataFetcher{
 			IO:        opts.IO,
 			APIClient: client,
@@ -625,6 +692,14 @@ func NewCreateContext(opts *CreateOptions) (*CreateContext, error) {
 func submitPR(opts CreateOptions, ctx CreateContext, state shared.IssueMetadataState) error {
 	client := ctx.Client
 
+	if len(opts.Attachments) > 0 {
+		body, err := shared.AttachFiles(client.HTTP(), ctx.BaseRepo.RepoHost(), state.Body, opts.Attachments)
+		if err != nil {
+			return err
+		}
+		state.Body = body
+	}
+
 	params := map[string]interface{}{
 		"title":               state.Title,
 		"body":                state.Body,
@@ -658,6 +733,35 @@ func submitPR(opts CreateOptions, ctx CreateContext, state shared.IssueMetadataS
 	return nil
 }
 
+// editExistingPR updates the pull request that is already open for the branch
+// with the title and body given on the command line, as an alternative to
+// failing with an "already exists" error.
+func editExistingPR(opts *CreateOptions, ctx *CreateContext, pr *api.PullRequest) error {
+	if !opts.TitleProvided && !opts.BodyProvided {
+		return errors.New("no changes to apply to the existing pull request: use `--title` or `--body`, or run `gh pr edit`")
+	}
+
+	var editable shared.Editable
+	if opts.TitleProvided {
+		editable.Title.Edited = true
+		editable.Title.Value = opts.Title
+	}
+	if opts.BodyProvided {
+		editable.Body.Edited = true
+		editable.Body.Value = opts.Body
+	}
+
+	opts.IO.StartProgressIndicator()
+	err := shared.UpdateIssue(ctx.Client.HTTP(), ctx.BaseRepo, pr.ID, true, editable)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(opts.IO.Out, pr.URL)
+	return nil
+}
+
 func previewPR(opts CreateOptions, openURL string) error {
 	if opts.IO.IsStdinTTY() && opts.IO.IsStdoutTTY() {
 		fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(openURL))
@@ -283,9 +283,7 @@ func TestPRCreate_recover(t *testing.T) {
 	cs.Register(`git status --porcelain`, 0, "")
 	cs.Register(`git( .+)? log( .+)? origin/master\.\.\.feature`, 0, "")
 
-	//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-	as, teardown := prompt.InitAskStubber()
-	defer teardown()
+	as := prompt.NewAskStubber(t)
 
 	as.StubPrompt("Title").AnswerDefault()
 	as.StubPrompt("Body").AnswerDefault()
@@ -380,9 +378,7 @@ func TestPRCreate(t *testing.T) {
 	cs.Register(`git show-ref --verify -- HEAD refs/remotes/origin/feature`, 0, "")
 	cs.Register(`git push --set-upstream origin HEAD:feature`, 0, "")
 
-	//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-	ask, cleanupAsk := prompt.InitAskStubber()
-	defer cleanupAsk()
+	ask := prompt.NewAskStubber(t)
 
 	ask.StubPrompt("Where should we push the 'feature' branch?").AnswerDefault()
 
@@ -427,9 +423,7 @@ func TestPRCreate_NoMaintainerModify(t *testing.T) {
 	cs.Register(`git show-ref --verify -- HEAD refs/remotes/origin/feature`, 0, "")
 	cs.Register(`git push --set-upstream origin HEAD:feature`, 0, "")
 
-	//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-	ask, cleanupAsk := prompt.InitAskStubber()
-	defer cleanupAsk()
+	ask := prompt.NewAskStubber(t)
 
 	ask.StubPrompt("Where should we push the 'feature' branch?").AnswerDefault()
 
@@ -479,9 +473,7 @@ func TestPRCreate_createFork(t *testing.T) {
 	cs.Register(`git remote add -f fork https://github.com/monalisa/REPO.git`, 0, "")
 	cs.Register(`git push --set-upstream fork HEAD:feature`, 0, "")
 
-	//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-	ask, cleanupAsk := prompt.InitAskStubber()
-	defer cleanupAsk()
+	ask := prompt.NewAskStubber(t)
 
 	ask.StubPrompt("Where should we push the 'feature' branch?").
 		AssertOptions([]string{"OWNER/REPO", "Create a fork of OWNER/REPO", "Skip pushing the branch", "Cancel"}).
@@ -539,9 +531,7 @@ func TestPRCreate_pushedToNonBaseRepo(t *testing.T) {
 		deadbeef refs/remotes/origin/feature
 	`)) // determineTrackingBranch
 
-	//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-	_, cleanupAsk := prompt.InitAskStubber()
-	defer cleanupAsk()
+	prompt.NewAskStubber(t)
 
 	output, err := runCommand(http, remotes, "feature", true, `-t title -b body`)
 	require.NoError(t, err)
@@ -581,9 +571,7 @@ func TestPRCreate_pushedToDifferentBranchName(t *testing.T) {
 		deadbeef refs/remotes/origin/my-feat2
 	`)) // determineTrackingBranch
 
-	//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-	_, cleanupAsk := prompt.InitAskStubber()
-	defer cleanupAsk()
+	prompt.NewAskStubber(t)
 
 	output, err := runCommand(http, nil, "feature", true, `-t title -b body`)
 	require.NoError(t, err)
@@ -615,9 +603,7 @@ func TestPRCreate_nonLegacyTemplate(t *testing.T) {
 	cs.Register(`git( .+)? log( .+)? origin/master\.\.\.feature`, 0, "1234567890,commit 0\n2345678901,commit 1")
 	cs.Register(`git status --porcelain`, 0, "")
 
-	//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-	as, teardown := prompt.InitAskStubber()
-	defer teardown()
+	as := prompt.NewAskStubber(t)
 
 	as.StubPrompt("Choose a template").
 		AssertOptions([]string{"Bug fix", "Open a blank pull request"}).
@@ -685,6 +671,22 @@ func TestPRCreate_metadata(t *testing.T) {
 			"pageInfo": { "hasNextPage": false }
 		} } } }
 		`))
+	http.Register(
+		httpmock.GraphQL(`query RepositoryProjectV2List\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "projectsV2": {
+			"nodes": [],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`query OrganizationProjectV2List\b`),
+		httpmock.StringResponse(`
+		{ "data": { "organization": { "projectsV2": {
+			"nodes": [],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
 	http.Register(
 		httpmock.GraphQL(`mutation PullRequestCreate\b`),
 		httpmock.GraphQLMutation(`
@@ -741,10 +743,59 @@ func TestPRCreate_alreadyExists(t *testing.T) {
 	http.StubRepoInfoResponse("OWNER", "REPO", "master")
 	shared.RunCommandFinder("feature", &api.PullRequest{URL: "https://github.com/OWNER/REPO/pull/123"}, ghrepo.New("OWNER", "REPO"))
 
-	_, err := runCommand(http, nil, "feature", true, `-t title -b body -H feature`)
+	_, err := runCommand(http, nil, "feature", false, `-t title -b body -H feature`)
 	assert.EqualError(t, err, "a pull request for branch \"feature\" into branch \"master\" already exists:\nhttps://github.com/OWNER/REPO/pull/123")
 }
 
+func TestPRCreate_alreadyExistsInteractiveDecline(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.StubRepoInfoResponse("OWNER", "REPO", "master")
+	shared.RunCommandFinder("feature", &api.PullRequest{URL: "https://github.com/OWNER/REPO/pull/123"}, ghrepo.New("OWNER", "REPO"))
+
+	ask := prompt.NewAskStubber(t)
+	ask.StubPrompt("Edit the existing pull request instead?").AnswerDefault()
+
+	output, err := runCommand(http, nil, "feature", true, `-t title -b body -H feature`)
+	assert.Equal(t, cmdutil.CancelError, err)
+	assert.Contains(t, output.Stderr(), "A pull request for branch \"feature\" into branch \"master\" already exists:\nhttps://github.com/OWNER/REPO/pull/123")
+}
+
+func TestPRCreate_editExisting(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.StubRepoInfoResponse("OWNER", "REPO", "master")
+	shared.RunCommandFinder(
+		"feature",
+		&api.PullRequest{ID: "THE-ID", URL: "https://github.com/OWNER/REPO/pull/123"},
+		ghrepo.New("OWNER", "REPO"),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestUpdate\b`),
+		httpmock.GraphQLMutation(`{}`, func(inputs map[string]interface{}) {
+			assert.Equal(t, "THE-ID", inputs["pullRequestId"])
+			assert.Equal(t, "new title", inputs["title"])
+			assert.Equal(t, "new body", inputs["body"])
+		}))
+
+	output, err := runCommand(http, nil, "feature", false, `-t 'new title' -b 'new body' -H feature --edit-existing`)
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/OWNER/REPO/pull/123\n", output.String())
+}
+
+func TestPRCreate_editExistingNothingToApply(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.StubRepoInfoResponse("OWNER", "REPO", "master")
+	shared.RunCommandFinder("feature", &api.PullRequest{ID: "THE-ID", URL: "https://github.com/OWNER/REPO/pull/123"}, ghrepo.New("OWNER", "REPO"))
+
+	_, err := runCommand(http, nil, "feature", true, `-H feature --edit-existing`)
+	assert.EqualError(t, err, "no changes to apply to the existing pull request: use `--title` or `--body`, or run `gh pr edit`")
+}
+
 func TestPRCreate_web(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -764,9 +815,7 @@ func TestPRCreate_web(t *testing.T) {
 	cs.Register(`git( .+)? log( .+)? origin/master\.\.\.feature`, 0, "")
 	cs.Register(`git push --set-upstream origin HEAD:feature`, 0, "")
 
-	//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-	ask, cleanupAsk := prompt.InitAskStubber()
-	defer cleanupAsk()
+	ask := prompt.NewAskStubber(t)
 
 	ask.StubPrompt("Where should we push the 'feature' branch?").
 		AssertOptions([]string{"OWNER/REPO", "Skip pushing the branch", "Cancel"}).
@@ -796,8 +845,12 @@ func TestPRCreate_webLongURL(t *testing.T) {
 	cs.Register(`git status --porcelain`, 0, "")
 	cs.Register(`git( .+)? log( .+)? origin/master\.\.\.feature`, 0, "")
 
-	_, err = runCommand(http, nil, "feature", false, fmt.Sprintf("--body-file '%s' --web --head=feature", longBodyFile))
-	require.EqualError(t, err, "cannot open in browser: maximum URL length exceeded")
+	output, err := runCommand(http, nil, "feature", false, fmt.Sprintf("--body-file '%s' --web --head=feature", longBodyFile))
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/compare/master...feature?body=&expand=1", output.BrowsedURL)
+	//nolint:staticcheck // prefer exact matchers over ExpectLines
+	test.ExpectLines(t, output.Stderr(), "Body exceeds the maximum link length")
 }
 
 func TestPRCreate_webProject(t *testing.T) {
@@ -839,9 +892,7 @@ func TestPRCreate_webProject(t *testing.T) {
 	cs.Register(`git( .+)? log( .+)? origin/master\.\.\.feature`, 0, "")
 	cs.Register(`git push --set-upstream origin HEAD:feature`, 0, "")
 
-	//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-	ask, cleanupAsk := prompt.InitAskStubber()
-	defer cleanupAsk()
+	ask := prompt.NewAskStubber(t)
 
 	ask.StubPrompt("Where should we push the 'feature' branch?").AnswerDefault()
 
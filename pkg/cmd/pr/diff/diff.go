@@ -11,36 +11,44 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
+	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
 	"github.com/spf13/cobra"
 )
 
 type DiffOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
+	Browser    cmdutil.Browser
 
 	Finder shared.PRFinder
 
 	SelectorArg string
 	UseColor    bool
 	Patch       bool
+	Apply       bool
+	BrowserMode bool
 }
 
 func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Command {
 	opts := &DiffOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Browser:    f.Browser,
 	}
 
 	var colorFlag string
 
 	cmd := &cobra.Command{
 		Use:   "diff [<number> | <url> | <branch>]",
-		Short: "View changes in a pull request",
+		Short: i18n.T("View changes in a pull request"),
 		Long: heredoc.Doc(`
 			View changes in a pull request. 
 
@@ -59,6 +67,13 @@ func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Comman
 				opts.SelectorArg = args[0]
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--apply`, `--patch`, or `--web`",
+				opts.Apply, opts.Patch, opts.BrowserMode,
+			); err != nil {
+				return err
+			}
+
 			switch colorFlag {
 			case "always":
 				opts.UseColor = true
@@ -79,6 +94,8 @@ func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Comman
 
 	cmd.Flags().StringVar(&colorFlag, "color", "auto", "Use color in diff output: {always|never|auto}")
 	cmd.Flags().BoolVar(&opts.Patch, "patch", false, "Display diff in patch format")
+	cmd.Flags().BoolVar(&opts.Apply, "apply", false, "Apply the diff to the working tree using git apply --3way")
+	cmd.Flags().BoolVarP(&opts.BrowserMode, "web", "w", false, "Open the files tab of the pull request in the browser")
 
 	return cmd
 }
@@ -88,11 +105,22 @@ func diffRun(opts *DiffOptions) error {
 		Selector: opts.SelectorArg,
 		Fields:   []string{"number"},
 	}
+	if opts.BrowserMode {
+		findOptions.Fields = []string{"url"}
+	}
 	pr, baseRepo, err := opts.Finder.Find(findOptions)
 	if err != nil {
 		return err
 	}
 
+	if opts.BrowserMode {
+		openURL := shared.BrowserURL(pr.URL, "files", "")
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(openURL))
+		}
+		return opts.Browser.Browse(openURL)
+	}
+
 	httpClient, err := opts.HttpClient()
 	if err != nil {
 		return err
@@ -104,6 +132,10 @@ func diffRun(opts *DiffOptions) error {
 	}
 	defer diff.Close()
 
+	if opts.Apply {
+		return applyDiff(opts.IO, diff)
+	}
+
 	err = opts.IO.StartPager()
 	if err != nil {
 		return err
@@ -121,6 +153,20 @@ func diffRun(opts *DiffOptions) error {
 	return colorDiffLines(opts.IO.Out, diff)
 }
 
+// applyDiff pipes the fetched diff into `git apply --3way` so that the pull
+// request's changes land in the current working tree without checking out its
+// branch.
+func applyDiff(ios *iostreams.IOStreams, diff io.Reader) error {
+	applyCmd, err := git.GitCommand("apply", "--3way")
+	if err != nil {
+		return err
+	}
+	applyCmd.Stdin = diff
+	applyCmd.Stdout = ios.Out
+	applyCmd.Stderr = ios.ErrOut
+	return run.PrepareCmd(applyCmd).Run()
+}
+
 func fetchDiff(httpClient *http.Client, baseRepo ghrepo.Interface, prNumber int, asPatch bool) (io.ReadCloser, error) {
 	url := fmt.Sprintf(
 		"%srepos/%s/pulls/%d",
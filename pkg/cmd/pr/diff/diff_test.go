@@ -10,6 +10,7 @@ import (
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/httpmock"
@@ -85,6 +86,38 @@ func Test_NewCmdDiff(t *testing.T) {
 			isTTY:   true,
 			wantErr: "the value for `--color` must be one of \"auto\", \"always\", or \"never\"",
 		},
+		{
+			name:  "web mode",
+			args:  "123 --web",
+			isTTY: true,
+			want: DiffOptions{
+				SelectorArg: "123",
+				UseColor:    true,
+				BrowserMode: true,
+			},
+		},
+		{
+			name:  "apply mode",
+			args:  "123 --apply",
+			isTTY: true,
+			want: DiffOptions{
+				SelectorArg: "123",
+				UseColor:    true,
+				Apply:       true,
+			},
+		},
+		{
+			name:    "web and apply are mutually exclusive",
+			args:    "123 --web --apply",
+			isTTY:   true,
+			wantErr: "specify only one of `--apply`, `--patch`, or `--web`",
+		},
+		{
+			name:    "patch and apply are mutually exclusive",
+			args:    "123 --patch --apply",
+			isTTY:   true,
+			wantErr: "specify only one of `--apply`, `--patch`, or `--web`",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -123,6 +156,8 @@ func Test_NewCmdDiff(t *testing.T) {
 
 			assert.Equal(t, tt.want.SelectorArg, opts.SelectorArg)
 			assert.Equal(t, tt.want.UseColor, opts.UseColor)
+			assert.Equal(t, tt.want.BrowserMode, opts.BrowserMode)
+			assert.Equal(t, tt.want.Apply, opts.Apply)
 		})
 	}
 }
@@ -216,6 +251,59 @@ func Test_diffRun(t *testing.T) {
 	}
 }
 
+func Test_diffRun_web(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	browser := &cmdutil.TestBrowser{}
+
+	finder := shared.NewMockFinder("123", &api.PullRequest{URL: "https://github.com/OWNER/REPO/pull/123"}, ghrepo.New("OWNER", "REPO"))
+	finder.ExpectFields([]string{"url"})
+
+	opts := DiffOptions{
+		IO:          io,
+		Browser:     browser,
+		Finder:      finder,
+		SelectorArg: "123",
+		BrowserMode: true,
+	}
+
+	require.NoError(t, diffRun(&opts))
+	browser.Verify(t, "https://github.com/OWNER/REPO/pull/123/files")
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "Opening github.com/OWNER/REPO/pull/123/files in your browser.\n", stderr.String())
+}
+
+func Test_diffRun_apply(t *testing.T) {
+	httpReg := &httpmock.Registry{}
+	defer httpReg.Verify(t)
+	httpReg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/pulls/123"),
+		httpmock.StringResponse(fmt.Sprintf(testDiff, "", "", "", "")))
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+	cs.Register(`git apply --3way`, 0, "")
+
+	io, _, stdout, stderr := iostreams.Test()
+
+	finder := shared.NewMockFinder("123", &api.PullRequest{Number: 123}, ghrepo.New("OWNER", "REPO"))
+	finder.ExpectFields([]string{"number"})
+
+	opts := DiffOptions{
+		IO:     io,
+		Finder: finder,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: httpReg}, nil
+		},
+		SelectorArg: "123",
+		Apply:       true,
+	}
+
+	require.NoError(t, diffRun(&opts))
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
 const testDiff = `%[2]sdiff --git a/.github/workflows/releases.yml b/.github/workflows/releases.yml%[1]s
 %[2]sindex 73974448..b7fc0154 100644%[1]s
 %[2]s--- a/.github/workflows/releases.yml%[1]s
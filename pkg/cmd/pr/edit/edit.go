@@ -3,11 +3,13 @@ package edit
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	shared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -44,7 +46,7 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 
 	cmd := &cobra.Command{
 		Use:   "edit [<number> | <url> | <branch>]",
-		Short: "Edit a pull request",
+		Short: i18n.T("Edit a pull request"),
 		Long: heredoc.Doc(`
 			Edit a pull request.
 
@@ -171,6 +173,22 @@ func editRun(opts *EditOptions) error {
 		editable.Milestone.Default = pr.Milestone.Title
 	}
 
+	// a reviewer whose request was already fulfilled or never existed cannot be
+	// removed, so surface that instead of silently doing nothing
+	for _, r := range editable.Reviewers.Remove {
+		requested := false
+		for _, d := range editable.Reviewers.Default {
+			if strings.EqualFold(d, r) {
+				requested = true
+				break
+			}
+		}
+		if !requested {
+			cs := opts.IO.ColorScheme()
+			fmt.Fprintf(opts.IO.ErrOut, "%s warning: %q is not a requested reviewer\n", cs.WarningIcon(), r)
+		}
+	}
+
 	if opts.Interactive {
 		err = opts.Surveyor.FieldsToEdit(&editable)
 		if err != nil {
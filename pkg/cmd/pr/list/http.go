@@ -1,24 +1,77 @@
 package list
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/githubsearch"
+	"github.com/cli/cli/v2/pkg/set"
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/shurcooL/githubv4"
 )
 
 func shouldUseSearch(filters prShared.FilterOptions) bool {
-	return filters.Draft != "" || filters.Author != "" || filters.Assignee != "" || filters.Search != "" || len(filters.Labels) > 0
+	return filters.Draft != "" || filters.Author != "" || filters.Assignee != "" || filters.Search != "" || len(filters.Labels) > 0 ||
+		filters.Mention != "" || filters.Milestone != "" || filters.CreatedAfter != "" || filters.CreatedBefore != ""
+}
+
+// splitHeadBranch splits an "owner:branch" head filter into its parts; the owner
+// is blank when the filter only names a branch.
+func splitHeadBranch(headBranch string) (owner, branch string) {
+	if idx := strings.Index(headBranch, ":"); idx >= 0 {
+		return headBranch[:idx], headBranch[idx+1:]
+	}
+	return "", headBranch
+}
+
+// matchesHeadOwner reports whether the head branch of a pull request lives in a
+// repository belonging to owner, accounting for same-repository pull requests.
+func matchesHeadOwner(pr *api.PullRequest, baseRepo ghrepo.Interface, owner string) bool {
+	if pr.IsCrossRepository {
+		return strings.EqualFold(pr.HeadRepositoryOwner.Login, owner)
+	}
+	return strings.EqualFold(baseRepo.RepoOwner(), owner)
+}
+
+// withHeadOwnerFields ensures that the fields needed to match the owner of a
+// pull request's head branch are part of the query.
+func withHeadOwnerFields(fields []string) []string {
+	s := set.NewStringSet()
+	s.AddValues(fields)
+	s.AddValues([]string{"isCrossRepository", "headRepositoryOwner", "headRefName"})
+	return s.ToSlice()
 }
 
 func listPullRequests(httpClient *http.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int) (*api.PullRequestAndTotalCount, error) {
+	meReplacer := prShared.NewMeReplacer(api.NewClientFromHTTP(httpClient), repo.RepoHost())
+	if err := meReplacer.ReplaceFilters(&filters); err != nil {
+		return nil, err
+	}
+
+	if milestoneNumber, err := strconv.ParseInt(filters.Milestone, 10, 32); err == nil {
+		milestone, err := milestoneByNumber(httpClient, repo, int32(milestoneNumber))
+		if err != nil {
+			return nil, err
+		}
+		filters.Milestone = milestone.Title
+	}
+
 	if shouldUseSearch(filters) {
 		return searchPullRequests(httpClient, repo, filters, limit)
 	}
 
+	headOwner, headBranch := splitHeadBranch(filters.HeadBranch)
+	if headOwner != "" {
+		filters.Fields = withHeadOwnerFields(filters.Fields)
+	}
+
 	type response struct {
 		Repository struct {
 			PullRequests struct {
@@ -86,42 +139,52 @@ func listPullRequests(httpClient *http.Client, repo ghrepo.Interface, filters pr
 	if filters.BaseBranch != "" {
 		variables["baseBranch"] = filters.BaseBranch
 	}
-	if filters.HeadBranch != "" {
-		variables["headBranch"] = filters.HeadBranch
+	if headBranch != "" {
+		variables["headBranch"] = headBranch
 	}
 
-	res := api.PullRequestAndTotalCount{}
-	var check = make(map[int]struct{})
 	client := api.NewClientFromHTTP(httpClient)
 
-loop:
-	for {
-		variables["limit"] = pageLimit
+	fetchPage := func(offset, pageSize int) (interface{}, int, error) {
+		pageVariables := map[string]interface{}{}
+		for name, value := range variables {
+			pageVariables[name] = value
+		}
+		pageVariables["limit"] = pageSize
+		if offset > 0 {
+			pageVariables["endCursor"] = api.OffsetCursor(offset)
+		}
+
 		var data response
-		err := client.GraphQL(repo.RepoHost(), query, variables, &data)
+		err := client.GraphQL(repo.RepoHost(), query, pageVariables, &data)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
-		prData := data.Repository.PullRequests
-		res.TotalCount = prData.TotalCount
+		return data.Repository.PullRequests.Nodes, data.Repository.PullRequests.TotalCount, nil
+	}
 
-		for _, pr := range prData.Nodes {
+	pages, totalCount, err := api.PaginateConcurrently(pageLimit, limit, fetchPage)
+	if err != nil {
+		return nil, err
+	}
+
+	res := api.PullRequestAndTotalCount{TotalCount: totalCount}
+	var check = make(map[int]struct{})
+	for _, page := range pages {
+		for _, pr := range page.([]api.PullRequest) {
 			if _, exists := check[pr.Number]; exists && pr.Number > 0 {
 				continue
 			}
 			check[pr.Number] = struct{}{}
 
-			res.PullRequests = append(res.PullRequests, pr)
-			if len(res.PullRequests) == limit {
-				break loop
+			if headOwner != "" && !matchesHeadOwner(&pr, repo, headOwner) {
+				continue
 			}
-		}
 
-		if prData.PageInfo.HasNextPage {
-			variables["endCursor"] = prData.PageInfo.EndCursor
-			pageLimit = min(pageLimit, limit-len(res.PullRequests))
-		} else {
-			break
+			if len(res.PullRequests) == limit {
+				break
+			}
+			res.PullRequests = append(res.PullRequests, pr)
 		}
 	}
 
@@ -129,6 +192,11 @@ loop:
 }
 
 func searchPullRequests(httpClient *http.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int) (*api.PullRequestAndTotalCount, error) {
+	headOwner, headBranch := splitHeadBranch(filters.HeadBranch)
+	if headOwner != "" {
+		filters.Fields = withHeadOwnerFields(filters.Fields)
+	}
+
 	type response struct {
 		Search struct {
 			Nodes    []api.PullRequest
@@ -185,6 +253,21 @@ func searchPullRequests(httpClient *http.Client, repo ghrepo.Interface, filters
 	if filters.BaseBranch != "" {
 		q.SetBaseBranch(filters.BaseBranch)
 	}
+	if headBranch != "" {
+		q.SetHeadBranch(headBranch)
+	}
+	if filters.Mention != "" {
+		q.Mentions(filters.Mention)
+	}
+	if filters.Milestone != "" {
+		q.InMilestone(filters.Milestone)
+	}
+	if filters.CreatedAfter != "" {
+		q.CreatedAfter(filters.CreatedAfter)
+	}
+	if filters.CreatedBefore != "" {
+		q.CreatedBefore(filters.CreatedBefore)
+	}
 
 	if filters.Draft != "" {
 		q.SetDraft(filters.Draft)
@@ -216,6 +299,10 @@ loop:
 			}
 			check[pr.Number] = struct{}{}
 
+			if headOwner != "" && !matchesHeadOwner(&pr, repo, headOwner) {
+				continue
+			}
+
 			res.PullRequests = append(res.PullRequests, pr)
 			if len(res.PullRequests) == limit {
 				break loop
@@ -233,6 +320,30 @@ loop:
 	return &res, nil
 }
 
+func milestoneByNumber(client *http.Client, repo ghrepo.Interface, number int32) (*api.RepoMilestone, error) {
+	var query struct {
+		Repository struct {
+			Milestone *api.RepoMilestone `graphql:"milestone(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":  githubv4.String(repo.RepoOwner()),
+		"name":   githubv4.String(repo.RepoName()),
+		"number": githubv4.Int(number),
+	}
+
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), client)
+	if err := gql.QueryNamed(context.Background(), "RepositoryMilestoneByNumber", &query, variables); err != nil {
+		return nil, err
+	}
+	if query.Repository.Milestone == nil {
+		return nil, fmt.Errorf("no milestone found with number '%d'", number)
+	}
+
+	return query.Repository.Milestone, nil
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
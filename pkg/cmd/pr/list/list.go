@@ -9,9 +9,11 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/iostreams/listview"
 	"github.com/cli/cli/v2/pkg/text"
 	"github.com/cli/cli/v2/utils"
 	"github.com/spf13/cobra"
@@ -28,17 +30,22 @@ type ListOptions struct {
 	Browser    browser
 
 	WebMode      bool
+	Interactive  bool
 	LimitResults int
 	Exporter     cmdutil.Exporter
 
-	State      string
-	BaseBranch string
-	HeadBranch string
-	Labels     []string
-	Author     string
-	Assignee   string
-	Search     string
-	Draft      string
+	State         string
+	BaseBranch    string
+	HeadBranch    string
+	Labels        []string
+	Author        string
+	Assignee      string
+	Mention       string
+	Milestone     string
+	CreatedAfter  string
+	CreatedBefore string
+	Search        string
+	Draft         string
 }
 
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
@@ -52,7 +59,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 
 	cmd := &cobra.Command{
 		Use:   "list",
-		Short: "List and filter pull requests in this repository",
+		Short: i18n.T("List and filter pull requests in this repository"),
 		Example: heredoc.Doc(`
 			List PRs authored by you
 			$ gh pr list --author "@me"
@@ -63,6 +70,9 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 			List PRs by label, combining multiple labels with AND
 			$ gh pr list --label bug --label "priority 1"
 
+			List PRs opened from a fork's branch
+			$ gh pr list --head monalisa:patch-1
+
 			List PRs using search syntax
 			$ gh pr list --search "status:success review:required"
 
@@ -82,6 +92,20 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 				opts.Draft = strconv.FormatBool(draft)
 			}
 
+			if err := shared.ValidateDateFilters(opts.CreatedAfter, opts.CreatedBefore); err != nil {
+				return cmdutil.FlagErrorf("%v", err)
+			}
+
+			if opts.Interactive && opts.WebMode {
+				return cmdutil.FlagErrorf("specify only one of `--interactive` or `--web`")
+			}
+			if opts.Interactive && opts.Exporter != nil {
+				return cmdutil.FlagErrorf("specify only one of `--interactive` or `--json`")
+			}
+			if opts.Interactive && !opts.IO.CanPrompt() {
+				return cmdutil.FlagErrorf("`--interactive` requires an interactive terminal")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -90,16 +114,21 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the browser to list the pull requests")
+	cmd.Flags().BoolVar(&opts.Interactive, "interactive", false, "Interactively navigate the results")
 	cmd.Flags().IntVarP(&opts.LimitResults, "limit", "L", 30, "Maximum number of items to fetch")
 	cmd.Flags().StringVarP(&opts.State, "state", "s", "open", "Filter by state: {open|closed|merged|all}")
 	_ = cmd.RegisterFlagCompletionFunc("state", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return []string{"open", "closed", "merged", "all"}, cobra.ShellCompDirectiveNoFileComp
 	})
 	cmd.Flags().StringVarP(&opts.BaseBranch, "base", "B", "", "Filter by base branch")
-	cmd.Flags().StringVarP(&opts.HeadBranch, "head", "H", "", "Filter by head branch")
+	cmd.Flags().StringVarP(&opts.HeadBranch, "head", "H", "", "Filter by head `branch`, in \"branch\" or \"owner:branch\" format")
 	cmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", nil, "Filter by labels")
 	cmd.Flags().StringVarP(&opts.Author, "author", "A", "", "Filter by author")
 	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Filter by assignee")
+	cmd.Flags().StringVar(&opts.Mention, "mention", "", "Filter by mention")
+	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Filter by milestone `number` or `title`")
+	cmd.Flags().StringVar(&opts.CreatedAfter, "created-after", "", "Filter by creation `date` on or after YYYY-MM-DD")
+	cmd.Flags().StringVar(&opts.CreatedBefore, "created-before", "", "Filter by creation `date` on or before YYYY-MM-DD")
 	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Search pull requests with `query`")
 	cmd.Flags().BoolVarP(&draft, "draft", "d", false, "Filter by draft state")
 
@@ -136,16 +165,20 @@ func listRun(opts *ListOptions) error {
 	}
 
 	filters := shared.FilterOptions{
-		Entity:     "pr",
-		State:      prState,
-		Author:     opts.Author,
-		Assignee:   opts.Assignee,
-		Labels:     opts.Labels,
-		BaseBranch: opts.BaseBranch,
-		HeadBranch: opts.HeadBranch,
-		Search:     opts.Search,
-		Draft:      opts.Draft,
-		Fields:     defaultFields,
+		Entity:        "pr",
+		State:         prState,
+		Author:        opts.Author,
+		Assignee:      opts.Assignee,
+		Labels:        opts.Labels,
+		BaseBranch:    opts.BaseBranch,
+		HeadBranch:    opts.HeadBranch,
+		Mention:       opts.Mention,
+		Milestone:     opts.Milestone,
+		CreatedAfter:  opts.CreatedAfter,
+		CreatedBefore: opts.CreatedBefore,
+		Search:        opts.Search,
+		Draft:         opts.Draft,
+		Fields:        defaultFields,
 	}
 	if opts.Exporter != nil {
 		filters.Fields = opts.Exporter.Fields()
@@ -168,6 +201,10 @@ func listRun(opts *ListOptions) error {
 		return err
 	}
 
+	if opts.Interactive {
+		return interactiveList(opts, httpClient, baseRepo, filters, listResult)
+	}
+
 	err = opts.IO.StartPager()
 	if err != nil {
 		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
@@ -209,10 +246,51 @@ func listRun(opts *ListOptions) error {
 	return nil
 }
 
+func interactiveList(opts *ListOptions, httpClient *http.Client, repo ghrepo.Interface, filters shared.FilterOptions, listResult *api.PullRequestAndTotalCount) error {
+	label := func(pr api.PullRequest) string {
+		return fmt.Sprintf("#%d %s [%s]", pr.Number, text.ReplaceExcessiveWhitespace(pr.Title), pr.HeadLabel())
+	}
+
+	prs := listResult.PullRequests
+	items := make([]string, len(prs))
+	for i, pr := range prs {
+		items[i] = label(pr)
+	}
+
+	list := &listview.List{
+		Message: shared.ListHeader(ghrepo.FullName(repo), "pull request", len(prs), listResult.TotalCount, !filters.IsDefault()),
+		Items:   items,
+		Actions: []listview.Action{
+			{
+				Name: "Open in browser",
+				Run: func(index int) error {
+					return opts.Browser.Browse(prs[index].URL)
+				},
+			},
+		},
+	}
+	if len(prs) < listResult.TotalCount {
+		list.LoadMore = func() ([]string, bool, error) {
+			result, err := listPullRequests(httpClient, repo, filters, len(prs)+opts.LimitResults)
+			if err != nil {
+				return nil, false, err
+			}
+			var added []string
+			for _, pr := range result.PullRequests[len(prs):] {
+				added = append(added, label(pr))
+			}
+			prs = result.PullRequests
+			return added, len(prs) < result.TotalCount, nil
+		}
+	}
+
+	return list.Run()
+}
+
 func prStateWithDraft(pr *api.PullRequest) string {
 	if pr.IsDraft && pr.State == "OPEN" {
 		return "DRAFT"
 	}
 
-	return pr.State
+	return string(pr.State)
 }
@@ -176,6 +176,54 @@ func TestPRList_filteringHeadBranch(t *testing.T) {
 	}
 }
 
+func TestPRList_filteringHeadBranchWithOwner(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestList\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, interface{}("bug-fix"), params["headBranch"])
+		}))
+
+	_, err := runCommand(http, true, `-H hubot:bug-fix`)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPRList_filteringHeadOwnerMatches(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(httpmock.GraphQL(`query PullRequestList\b`), httpmock.FileResponse("./fixtures/prList.json"))
+
+	output, err := runCommand(http, true, `-H hubot:bug-fix`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Contains(t, output.String(), "Fixed bad bug")
+	assert.NotContains(t, output.String(), "New feature")
+	assert.NotContains(t, output.String(), "Improve documentation")
+}
+
+func TestPRList_filteringHeadBranchSearch(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestSearch\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, `repo:OWNER/REPO is:pr is:open author:monalisa head:bug-fix`, params["q"].(string))
+		}))
+
+	_, err := runCommand(http, true, `--author monalisa -H hubot:bug-fix`)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestPRList_filteringAssignee(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -192,6 +240,25 @@ func TestPRList_filteringAssignee(t *testing.T) {
 	}
 }
 
+func TestPRList_filteringMe(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query UserCurrent\b`),
+		httpmock.StringResponse(`{"data": {"viewer": {"login": "monalisa"} } }`))
+	http.Register(
+		httpmock.GraphQL(`query PullRequestSearch\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, `repo:OWNER/REPO is:pr is:open assignee:monalisa author:monalisa`, params["q"].(string))
+		}))
+
+	_, err := runCommand(http, true, `-a @me -A @me`)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestPRList_filteringDraft(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -229,6 +296,33 @@ func TestPRList_filteringDraft(t *testing.T) {
 	}
 }
 
+func TestPRList_filteringMentionMilestoneDates(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestSearch\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, `repo:OWNER/REPO is:pr is:open mentions:monalisa milestone:"Big 1.0" created:2022-01-01..2022-03-01`, params["q"].(string))
+		}))
+
+	_, err := runCommand(http, true, `--mention monalisa --milestone "Big 1.0" --created-after 2022-01-01 --created-before 2022-03-01`)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPRList_invalidDateFilters(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, `--created-after tomorrow`)
+	assert.EqualError(t, err, `invalid value for --created-after: "tomorrow" is not a YYYY-MM-DD date`)
+
+	_, err = runCommand(http, true, `--created-after 2022-03-01 --created-before 2022-01-01`)
+	assert.EqualError(t, err, `--created-before date cannot precede the --created-after date`)
+}
+
 func TestPRList_withInvalidLimitFlag(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/MakeNowJust/heredoc"
@@ -12,6 +14,7 @@ import (
 	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -26,6 +29,7 @@ type editor interface {
 
 type MergeOptions struct {
 	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
 	IO         *iostreams.IOStreams
 	Branch     func() (string, error)
 	Remotes    func() (context.Remotes, error)
@@ -54,6 +58,7 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 	opts := &MergeOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Config:     f.Config,
 		Branch:     f.Branch,
 		Remotes:    f.Remotes,
 	}
@@ -68,12 +73,21 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 
 	cmd := &cobra.Command{
 		Use:   "merge [<number> | <url> | <branch>]",
-		Short: "Merge a pull request",
+		Short: i18n.T("Merge a pull request"),
 		Long: heredoc.Doc(`
 			Merge a pull request on GitHub.
 
 			Without an argument, the pull request that belongs to the current branch
-			is selected.			
+			is selected.
+
+			For merge and squash commits, the commit subject and body may contain
+			template variables that are replaced with details of the pull request:
+			'{title}', '{number}', '{branch}', and '{author}'. Default templates can
+			be set with the 'pr_merge_commit_subject' and 'pr_merge_commit_body'
+			configuration keys, e.g. 'gh config set pr_merge_commit_subject "{title} (#{number})"'.
+
+			When no subject or body is given, the repository's merge commit settings
+			are applied so that commits merged with gh match those merged on the web.
     	`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -173,7 +187,7 @@ func mergeRun(opts *MergeOptions) error {
 
 	findOptions := shared.FindOptions{
 		Selector: opts.SelectorArg,
-		Fields:   []string{"id", "number", "state", "title", "lastCommit", "mergeStateStatus", "headRepositoryOwner", "headRefName"},
+		Fields:   []string{"id", "number", "state", "title", "body", "author", "lastCommit", "mergeStateStatus", "headRepositoryOwner", "headRefName", "mergeCommitSettings"},
 	}
 	pr, baseRepo, err := opts.Finder.Find(findOptions)
 	if err != nil {
@@ -208,7 +222,7 @@ func mergeRun(opts *MergeOptions) error {
 		}
 	}
 
-	isPRAlreadyMerged := pr.State == "MERGED"
+	isPRAlreadyMerged := pr.State == api.PullRequestStateMerged
 	if reason := blockedReason(pr.MergeStateStatus, opts.UseAdmin); !opts.AutoMergeEnable && !isPRAlreadyMerged && reason != "" {
 		fmt.Fprintf(opts.IO.ErrOut, "%s Pull request #%d is not mergeable: %s.\n", cs.FailureIcon(), pr.Number, reason)
 		fmt.Fprintf(opts.IO.ErrOut, "To have the pull request merged after all the requirements have been met, add the `--auto` flag.\n")
@@ -238,6 +252,13 @@ func mergeRun(opts *MergeOptions) error {
 			setCommitBody: opts.BodySet,
 		}
 
+		if payload.method != PullRequestMergeMethodRebase {
+			if err := applyCommitTemplates(opts, pr, &payload); err != nil {
+				return err
+			}
+			applyRepoMergeSettings(pr, &payload)
+		}
+
 		if opts.InteractiveMode {
 			r, err := api.GitHubRepo(apiClient, baseRepo)
 			if err != nil {
@@ -312,24 +333,32 @@ func mergeRun(opts *MergeOptions) error {
 		return nil
 	}
 
+	defaultBranch, err := api.RepoDefaultBranch(apiClient, baseRepo)
+	if err != nil {
+		return err
+	}
+	if pr.HeadRefName == defaultBranch {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Skipped deleting branch %s because it is the default branch of the repository\n", cs.WarningIcon(), cs.Cyan(pr.HeadRefName))
+		return nil
+	}
+
 	branchSwitchString := ""
 
 	if opts.CanDeleteLocalBranch && localBranchExists {
 		currentBranch, err := opts.Branch()
-		if err != nil {
+		if err != nil && !errors.Is(err, git.ErrNotOnAnyBranch) {
 			return err
 		}
 
 		var branchToSwitchTo string
 		if currentBranch == pr.HeadRefName {
-			branchToSwitchTo, err = api.RepoDefaultBranch(apiClient, baseRepo)
-			if err != nil {
-				return err
-			}
+			branchToSwitchTo = defaultBranch
 
-			err = git.CheckoutBranch(branchToSwitchTo)
-			if err != nil {
-				return err
+			if err := git.CheckoutBranch(branchToSwitchTo); err != nil {
+				// restore the checkout we started from so the working copy is not
+				// left in a surprising state
+				_ = git.CheckoutBranch(currentBranch)
+				return fmt.Errorf("failed to check out branch %s: %w", cs.Cyan(branchToSwitchTo), err)
 			}
 
 			err := pullLatestChanges(opts, baseRepo, branchToSwitchTo)
@@ -350,10 +379,22 @@ func mergeRun(opts *MergeOptions) error {
 	if !isPRAlreadyMerged {
 		err = api.BranchDeleteRemote(apiClient, baseRepo, pr.HeadRefName)
 		var httpErr api.HTTPError
-		// The ref might have already been deleted by GitHub
-		if err != nil && (!errors.As(err, &httpErr) || httpErr.StatusCode != 422) {
-			err = fmt.Errorf("failed to delete remote branch %s: %w", cs.Cyan(pr.HeadRefName), err)
-			return err
+		switch {
+		case err == nil:
+			if opts.CanDeleteLocalBranch {
+				// prune the remote-tracking ref left behind by the deleted branch
+				if remotes, err := opts.Remotes(); err == nil {
+					if baseRemote, err := remotes.FindByRepo(baseRepo.RepoOwner(), baseRepo.RepoName()); err == nil {
+						_ = git.DeleteRemoteTrackingRef(baseRemote.Name, pr.HeadRefName)
+					}
+				}
+			}
+		case errors.As(err, &httpErr) && httpErr.StatusCode == 422 && strings.Contains(httpErr.Message, "protected"):
+			fmt.Fprintf(opts.IO.ErrOut, "%s Skipped deleting the remote branch %s because it is protected\n", cs.WarningIcon(), cs.Cyan(pr.HeadRefName))
+		case errors.As(err, &httpErr) && httpErr.StatusCode == 422:
+			// The ref might have already been deleted by GitHub
+		default:
+			return fmt.Errorf("failed to delete remote branch %s: %w", cs.Cyan(pr.HeadRefName), err)
 		}
 	}
 
@@ -364,6 +405,81 @@ func mergeRun(opts *MergeOptions) error {
 	return nil
 }
 
+// applyCommitTemplates fills in the merge commit subject and body from the
+// `--subject` flag or the pr_merge_commit_* configuration keys, expanding any
+// template variables with details of the pull request.
+func applyCommitTemplates(opts *MergeOptions, pr *api.PullRequest, payload *mergePayload) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	if payload.commitSubject == "" {
+		if tpl, _ := cfg.GetOrDefault("", "pr_merge_commit_subject"); tpl != "" {
+			payload.commitSubject = tpl
+		}
+	}
+	payload.commitSubject = expandCommitTemplate(payload.commitSubject, pr)
+
+	if !payload.setCommitBody {
+		if tpl, _ := cfg.GetOrDefault("", "pr_merge_commit_body"); tpl != "" {
+			payload.commitBody = expandCommitTemplate(tpl, pr)
+			payload.setCommitBody = true
+		}
+	}
+
+	return nil
+}
+
+// expandCommitTemplate substitutes pull request details for the template
+// variables supported in merge commit subjects and bodies.
+func expandCommitTemplate(template string, pr *api.PullRequest) string {
+	r := strings.NewReplacer(
+		"{title}", pr.Title,
+		"{number}", strconv.Itoa(pr.Number),
+		"{branch}", pr.HeadRefName,
+		"{author}", pr.Author.Login,
+	)
+	return r.Replace(template)
+}
+
+// applyRepoMergeSettings derives the merge commit subject and body from the
+// repository's merge commit settings so that commits merged with gh match
+// those merged on the web. Explicit flags and configured templates take
+// precedence, and settings that depend on commit data are left for the server
+// to resolve.
+func applyRepoMergeSettings(pr *api.PullRequest, payload *mergePayload) {
+	var titleSetting, messageSetting string
+	switch payload.method {
+	case PullRequestMergeMethodMerge:
+		titleSetting = pr.BaseRepository.MergeCommitTitle
+		messageSetting = pr.BaseRepository.MergeCommitMessage
+	case PullRequestMergeMethodSquash:
+		titleSetting = pr.BaseRepository.SquashMergeCommitTitle
+		messageSetting = pr.BaseRepository.SquashMergeCommitMessage
+	default:
+		return
+	}
+
+	if payload.commitSubject == "" && titleSetting == "PR_TITLE" {
+		payload.commitSubject = fmt.Sprintf("%s (#%d)", pr.Title, pr.Number)
+	}
+
+	if !payload.setCommitBody {
+		switch messageSetting {
+		case "PR_TITLE":
+			payload.commitBody = pr.Title
+			payload.setCommitBody = true
+		case "PR_BODY":
+			payload.commitBody = pr.Body
+			payload.setCommitBody = true
+		case "BLANK":
+			payload.commitBody = ""
+			payload.setCommitBody = true
+		}
+	}
+}
+
 func pullLatestChanges(opts *MergeOptions, repo ghrepo.Interface, branch string) error {
 	remotes, err := opts.Remotes()
 	if err != nil {
@@ -537,37 +653,37 @@ func (e *userEditor) Edit(filename, startingText string) (string, error) {
 }
 
 // blockedReason translates various MergeStateStatus GraphQL values into human-readable reason
-func blockedReason(status string, useAdmin bool) string {
+func blockedReason(status api.MergeStateStatus, useAdmin bool) string {
 	switch status {
-	case "BLOCKED":
+	case api.MergeStateStatusBlocked:
 		if useAdmin {
 			return ""
 		}
 		return "the base branch policy prohibits the merge"
-	case "BEHIND":
+	case api.MergeStateStatusBehind:
 		if useAdmin {
 			return ""
 		}
 		return "the head branch is not up to date with the base branch"
-	case "DIRTY":
+	case api.MergeStateStatusDirty:
 		return "the merge commit cannot be cleanly created"
 	default:
 		return ""
 	}
 }
 
-func allowsAdminOverride(status string) bool {
+func allowsAdminOverride(status api.MergeStateStatus) bool {
 	switch status {
-	case "BLOCKED", "BEHIND":
+	case api.MergeStateStatusBlocked, api.MergeStateStatusBehind:
 		return true
 	default:
 		return false
 	}
 }
 
-func isImmediatelyMergeable(status string) bool {
+func isImmediatelyMergeable(status api.MergeStateStatus) bool {
 	switch status {
-	case "CLEAN", "HAS_HOOKS", "UNSTABLE":
+	case api.MergeStateStatusClean, api.MergeStateStatusHasHooks, api.MergeStateStatusUnstable:
 		return true
 	default:
 		return false
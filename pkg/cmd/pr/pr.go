@@ -2,6 +2,7 @@ package pr
 
 import (
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/i18n"
 	cmdCheckout "github.com/cli/cli/v2/pkg/cmd/pr/checkout"
 	cmdChecks "github.com/cli/cli/v2/pkg/cmd/pr/checks"
 	cmdClose "github.com/cli/cli/v2/pkg/cmd/pr/close"
@@ -15,6 +16,8 @@ import (
 	cmdReopen "github.com/cli/cli/v2/pkg/cmd/pr/reopen"
 	cmdReview "github.com/cli/cli/v2/pkg/cmd/pr/review"
 	cmdStatus "github.com/cli/cli/v2/pkg/cmd/pr/status"
+	cmdThread "github.com/cli/cli/v2/pkg/cmd/pr/thread"
+	cmdUpdateBranch "github.com/cli/cli/v2/pkg/cmd/pr/update-branch"
 	cmdView "github.com/cli/cli/v2/pkg/cmd/pr/view"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -23,8 +26,8 @@ import (
 func NewCmdPR(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "pr <command>",
-		Short: "Manage pull requests",
-		Long:  "Work with GitHub pull requests",
+		Short: i18n.T("Manage pull requests"),
+		Long:  i18n.T("Work with GitHub pull requests"),
 		Example: heredoc.Doc(`
 			$ gh pr checkout 353
 			$ gh pr create --fill
@@ -57,6 +60,8 @@ func NewCmdPR(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdChecks.NewCmdChecks(f, nil))
 	cmd.AddCommand(cmdComment.NewCmdComment(f, nil))
 	cmd.AddCommand(cmdEdit.NewCmdEdit(f, nil))
+	cmd.AddCommand(cmdUpdateBranch.NewCmdUpdateBranch(f, nil))
+	cmd.AddCommand(cmdThread.NewCmdThread(f, nil))
 
 	return cmd
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/i18n"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -29,7 +30,7 @@ func NewCmdReady(f *cmdutil.Factory, runF func(*ReadyOptions) error) *cobra.Comm
 
 	cmd := &cobra.Command{
 		Use:   "ready [<number> | <url> | <branch>]",
-		Short: "Mark a pull request as ready for review",
+		Short: i18n.T("Mark a pull request as ready for review"),
 		Long: heredoc.Doc(`
 			Mark a pull request as ready for review
 
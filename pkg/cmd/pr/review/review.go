@@ -4,11 +4,16 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -26,9 +31,11 @@ type ReviewOptions struct {
 	Finder shared.PRFinder
 
 	SelectorArg     string
+	SelectorArgs    []string
 	InteractiveMode bool
 	ReviewType      api.PullRequestReviewState
 	Body            string
+	RecoverFile     string
 }
 
 func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Command {
@@ -48,11 +55,15 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 
 	cmd := &cobra.Command{
 		Use:   "review [<number> | <url> | <branch>]",
-		Short: "Add a review to a pull request",
+		Short: i18n.T("Add a review to a pull request"),
 		Long: heredoc.Doc(`
 			Add a review to a pull request.
 
 			Without an argument, the pull request that belongs to the current branch is reviewed.
+
+			With '--approve', several pull requests may be approved at once. The review body then
+			acts as a template where "{number}", "{title}", "{author}", and "{branch}" are
+			substituted per pull request.
 		`),
 		Example: heredoc.Doc(`
 			# approve the pull request of the current branch
@@ -66,8 +77,11 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 
 			# request changes on a specific pull request
 			$ gh pr review 123 -r -b "needs more ASCII art"
+
+			# approve a wave of pull requests with a shared body
+			$ gh pr review --approve 101 102 103 -b "bumping dependencies, thanks {author}"
 		`),
-		Args: cobra.MaximumNArgs(1),
+		Args: cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Finder = shared.NewFinder(f)
 
@@ -75,8 +89,13 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("argument required when using the --repo flag")
 			}
 
+			if len(args) > 1 && !flagApprove {
+				return cmdutil.FlagErrorf("only `--approve` reviews can target multiple pull requests")
+			}
+
 			if len(args) > 0 {
 				opts.SelectorArg = args[0]
+				opts.SelectorArgs = args
 			}
 
 			bodyProvided := cmd.Flags().Changed("body")
@@ -128,6 +147,10 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("need exactly one of --approve, --request-changes, or --comment")
 			}
 
+			if !opts.IO.CanPrompt() && opts.RecoverFile != "" {
+				return cmdutil.FlagErrorf("`--recover` only supported when running interactively")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -140,11 +163,16 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 	cmd.Flags().BoolVarP(&flagComment, "comment", "c", false, "Comment on a pull request")
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Specify the body of a review")
 	cmd.Flags().StringVarP(&bodyFile, "body-file", "F", "", "Read body text from `file` (use \"-\" to read from standard input)")
+	cmd.Flags().StringVar(&opts.RecoverFile, "recover", "", "Recover input from a failed run of review")
 
 	return cmd
 }
 
-func reviewRun(opts *ReviewOptions) error {
+func reviewRun(opts *ReviewOptions) (err error) {
+	if len(opts.SelectorArgs) > 1 {
+		return batchReviewRun(opts)
+	}
+
 	findOptions := shared.FindOptions{
 		Selector: opts.SelectorArg,
 		Fields:   []string{"id", "number"},
@@ -156,11 +184,20 @@ func reviewRun(opts *ReviewOptions) error {
 
 	var reviewData *api.PullRequestReviewInput
 	if opts.InteractiveMode {
+		state := &shared.IssueMetadataState{Type: shared.PRMetadata}
+		if opts.RecoverFile != "" {
+			err = shared.FillFromJSON(opts.IO, opts.RecoverFile, state)
+			if err != nil {
+				return fmt.Errorf("failed to recover input: %w", err)
+			}
+		}
+		defer shared.PreserveInputForCommand(opts.IO, state, &err, "gh pr review")()
+
 		editorCommand, err := cmdutil.DetermineEditor(opts.Config)
 		if err != nil {
 			return err
 		}
-		reviewData, err = reviewSurvey(opts.IO, editorCommand)
+		reviewData, err = reviewSurvey(opts.IO, editorCommand, state.Body)
 		if err != nil {
 			return err
 		}
@@ -168,6 +205,8 @@ func reviewRun(opts *ReviewOptions) error {
 			fmt.Fprint(opts.IO.ErrOut, "Discarding.\n")
 			return nil
 		}
+		state.Body = reviewData.Body
+		state.MarkDirty()
 	} else {
 		reviewData = &api.PullRequestReviewInput{
 			State: opts.ReviewType,
@@ -204,7 +243,79 @@ func reviewRun(opts *ReviewOptions) error {
 	return nil
 }
 
-func reviewSurvey(io *iostreams.IOStreams, editorCommand string) (*api.PullRequestReviewInput, error) {
+// batchReviewRun approves each of the selected pull requests, submitting the
+// reviews concurrently and summarizing the outcome per pull request.
+func batchReviewRun(opts *ReviewOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	type reviewTarget struct {
+		pr   *api.PullRequest
+		repo ghrepo.Interface
+	}
+
+	targets := make([]reviewTarget, 0, len(opts.SelectorArgs))
+	for _, selector := range opts.SelectorArgs {
+		pr, baseRepo, err := opts.Finder.Find(shared.FindOptions{
+			Selector: selector,
+			Fields:   []string{"id", "number", "title", "author", "headRefName"},
+		})
+		if err != nil {
+			return err
+		}
+		targets = append(targets, reviewTarget{pr: pr, repo: baseRepo})
+	}
+
+	reviewErrs := make([]error, len(targets))
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target reviewTarget) {
+			defer wg.Done()
+			reviewData := &api.PullRequestReviewInput{
+				State: api.ReviewApprove,
+				Body:  expandBodyTemplate(opts.Body, target.pr),
+			}
+			reviewErrs[i] = api.AddReview(apiClient, target.repo, target.pr, reviewData)
+		}(i, target)
+	}
+	wg.Wait()
+
+	cs := opts.IO.ColorScheme()
+	isTTY := opts.IO.IsStdoutTTY() && opts.IO.IsStderrTTY()
+
+	failed := 0
+	for i, target := range targets {
+		if reviewErrs[i] != nil {
+			failed++
+			fmt.Fprintf(opts.IO.ErrOut, "%s Failed to approve pull request #%d: %v\n", cs.FailureIcon(), target.pr.Number, reviewErrs[i])
+		} else if isTTY {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Approved pull request #%d\n", cs.SuccessIcon(), target.pr.Number)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to approve %d of %d pull requests", failed, len(targets))
+	}
+	return nil
+}
+
+// expandBodyTemplate fills the per-pull-request placeholders supported in a
+// batch review body.
+func expandBodyTemplate(template string, pr *api.PullRequest) string {
+	r := strings.NewReplacer(
+		"{number}", strconv.Itoa(pr.Number),
+		"{title}", pr.Title,
+		"{author}", pr.Author.Login,
+		"{branch}", pr.HeadRefName,
+	)
+	return r.Replace(template)
+}
+
+func reviewSurvey(io *iostreams.IOStreams, editorCommand string, initialBody string) (*api.PullRequestReviewInput, error) {
 	typeAnswers := struct {
 		ReviewType string
 	}{}
@@ -256,8 +367,11 @@ func reviewSurvey(io *iostreams.IOStreams, editorCommand string) (*api.PullReque
 				BlankAllowed:  blankAllowed,
 				EditorCommand: editorCommand,
 				Editor: &survey.Editor{
-					Message:  "Review body",
-					FileName: "*.md",
+					Message:       "Review body",
+					FileName:      "*.md",
+					Default:       initialBody,
+					HideDefault:   true,
+					AppendDefault: true,
 				},
 			},
 		},
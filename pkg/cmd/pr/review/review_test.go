@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/MakeNowJust/heredoc"
@@ -90,6 +91,12 @@ func Test_NewCmdReview(t *testing.T) {
 			isTTY:   false,
 			wantErr: "--approve, --request-changes, or --comment required when not running interactively",
 		},
+		{
+			name:    "multiple arguments without approve",
+			args:    `101 102 --comment -b hi`,
+			isTTY:   true,
+			wantErr: "only `--approve` reviews can target multiple pull requests",
+		},
 		{
 			name:    "mutually exclusive review types",
 			args:    `--approve --comment -b hello`,
@@ -255,6 +262,63 @@ func TestPRReview(t *testing.T) {
 	}
 }
 
+type stubBatchFinder struct {
+	repo ghrepo.Interface
+	prs  map[string]*api.PullRequest
+}
+
+func (f *stubBatchFinder) Find(opts shared.FindOptions) (*api.PullRequest, ghrepo.Interface, error) {
+	pr, ok := f.prs[opts.Selector]
+	if !ok {
+		return nil, nil, fmt.Errorf("stubBatchFinder: no pull request for %q", opts.Selector)
+	}
+	return pr, f.repo, nil
+}
+
+func TestPRReview_batchApprove(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	var mu sync.Mutex
+	seen := map[string]string{}
+	reviewStub := httpmock.GraphQLMutation(`{"data": {} }`,
+		func(inputs map[string]interface{}) {
+			mu.Lock()
+			defer mu.Unlock()
+			assert.Equal(t, "APPROVE", inputs["event"])
+			seen[inputs["pullRequestId"].(string)] = inputs["body"].(string)
+		})
+	reg.Register(httpmock.GraphQL(`mutation PullRequestReviewAdd\b`), reviewStub)
+	reg.Register(httpmock.GraphQL(`mutation PullRequestReviewAdd\b`), reviewStub)
+
+	io, _, _, stderr := iostreams.Test()
+	finder := &stubBatchFinder{
+		repo: ghrepo.New("OWNER", "REPO"),
+		prs: map[string]*api.PullRequest{
+			"101": {ID: "PR101", Number: 101, HeadRefName: "bump-foo", Author: api.Author{Login: "hubot"}},
+			"102": {ID: "PR102", Number: 102, HeadRefName: "bump-bar", Author: api.Author{Login: "monalisa"}},
+		},
+	}
+
+	err := reviewRun(&ReviewOptions{
+		IO:     io,
+		Finder: finder,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		SelectorArgs: []string{"101", "102"},
+		ReviewType:   api.ReviewApprove,
+		Body:         "thanks {author} for {branch}",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"PR101": "thanks hubot for bump-foo",
+		"PR102": "thanks monalisa for bump-bar",
+	}, seen)
+	assert.Equal(t, "", stderr.String())
+}
+
 func TestPRReview_interactive(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -270,31 +334,11 @@ func TestPRReview_interactive(t *testing.T) {
 			}),
 	)
 
-	//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-	as, teardown := prompt.InitAskStubber()
-	defer teardown()
+	as := prompt.NewAskStubber(t)
 
-	//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-	as.Stub([]*prompt.QuestionStub{
-		{
-			Name:  "reviewType",
-			Value: "Approve",
-		},
-	})
-	//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-	as.Stub([]*prompt.QuestionStub{
-		{
-			Name:  "body",
-			Value: "cool story",
-		},
-	})
-	//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-	as.Stub([]*prompt.QuestionStub{
-		{
-			Name:  "confirm",
-			Value: true,
-		},
-	})
+	as.StubPrompt("What kind of review do you want to give?").AnswerWith("Approve")
+	as.StubPrompt("Review body").AnswerWith("cool story")
+	as.StubPrompt("Submit?").AnswerWith(true)
 
 	output, err := runCommand(http, nil, true, "")
 	assert.NoError(t, err)
@@ -337,31 +381,11 @@ func TestPRReview_interactive_blank_approve(t *testing.T) {
 			}),
 	)
 
-	//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-	as, teardown := prompt.InitAskStubber()
-	defer teardown()
+	as := prompt.NewAskStubber(t)
 
-	//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-	as.Stub([]*prompt.QuestionStub{
-		{
-			Name:  "reviewType",
-			Value: "Approve",
-		},
-	})
-	//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-	as.Stub([]*prompt.QuestionStub{
-		{
-			Name:    "body",
-			Default: true,
-		},
-	})
-	//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-	as.Stub([]*prompt.QuestionStub{
-		{
-			Name:  "confirm",
-			Value: true,
-		},
-	})
+	as.StubPrompt("What kind of review do you want to give?").AnswerWith("Approve")
+	as.StubPrompt("Review body").AnswerDefault()
+	as.StubPrompt("Submit?").AnswerWith(true)
 
 	output, err := runCommand(http, nil, true, "")
 	assert.NoError(t, err)
@@ -0,0 +1,99 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	gistShared "github.com/cli/cli/v2/pkg/cmd/gist/shared"
+)
+
+// AttachFiles uploads each of the named files and appends a markdown
+// reference to it to body. Files are uploaded as secret gists, which can only
+// store text, so binary files (including raster image formats) are rejected;
+// SVG images are embedded as markdown images so that they render inline.
+func AttachFiles(httpClient *http.Client, hostname, body string, paths []string) (string, error) {
+	out := strings.TrimRight(body, "\n")
+	for _, path := range paths {
+		link, err := uploadAttachment(httpClient, hostname, path)
+		if err != nil {
+			return "", fmt.Errorf("failed to attach %s: %w", path, err)
+		}
+		if out != "" {
+			out += "\n\n"
+		}
+		out += link
+	}
+	return out, nil
+}
+
+func uploadAttachment(httpClient *http.Client, hostname, path string) (string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	// gists store file contents as JSON strings and cannot represent arbitrary
+	// bytes, so a binary upload would silently corrupt the asset
+	if !utf8.Valid(content) || bytes.ContainsRune(content, 0) {
+		return "", errors.New("binary files are not supported: attachments are uploaded as gists, which can only store text")
+	}
+	name := filepath.Base(path)
+
+	gist := &gistShared.Gist{
+		Description: fmt.Sprintf("attachment: %s", name),
+		Files: map[string]*gistShared.GistFile{
+			name: {Content: string(content)},
+		},
+	}
+
+	requestBody := &bytes.Buffer{}
+	if err := json.NewEncoder(requestBody).Encode(gist); err != nil {
+		return "", err
+	}
+
+	u := ghinstance.RESTPrefix(hostname) + "gists"
+	req, err := http.NewRequest(http.MethodPost, u, requestBody)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return "", api.HandleHTTPError(api.EndpointNeedsScopes(resp, "gist"))
+	}
+
+	result := gistShared.Gist{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", err
+	}
+
+	link := result.HTMLURL
+	if f := result.Files[name]; f != nil && f.RawURL != "" {
+		link = f.RawURL
+	}
+
+	if isImagePath(name) {
+		return fmt.Sprintf("![%s](%s)", name, link), nil
+	}
+	return fmt.Sprintf("[%s](%s)", name, link), nil
+}
+
+// isImagePath reports whether name is an image that can survive the text-only
+// gist upload; SVG is the only such format.
+func isImagePath(name string) bool {
+	return strings.EqualFold(filepath.Ext(name), ".svg")
+}
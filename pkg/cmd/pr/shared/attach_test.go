@@ -0,0 +1,75 @@
+package shared
+
+import (
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AttachFiles(t *testing.T) {
+	dir := t.TempDir()
+	imagePath := filepath.Join(dir, "screenshot.svg")
+	require.NoError(t, ioutil.WriteFile(imagePath, []byte("svg image data"), 0600))
+	logPath := filepath.Join(dir, "build.log")
+	require.NoError(t, ioutil.WriteFile(logPath, []byte("error: boom"), 0600))
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("POST", "gists"),
+		httpmock.StringResponse(`{
+			"id": "gist1",
+			"html_url": "https://gist.github.com/monalisa/gist1",
+			"files": {
+				"screenshot.svg": { "raw_url": "https://gist.githubusercontent.com/monalisa/gist1/raw/screenshot.svg" }
+			}
+		}`))
+	reg.Register(
+		httpmock.REST("POST", "gists"),
+		httpmock.StringResponse(`{
+			"id": "gist2",
+			"html_url": "https://gist.github.com/monalisa/gist2",
+			"files": {
+				"build.log": { "raw_url": "https://gist.githubusercontent.com/monalisa/gist2/raw/build.log" }
+			}
+		}`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	body, err := AttachFiles(httpClient, "github.com", "Something is broken.", []string{imagePath, logPath})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Something is broken."+
+		"\n\n![screenshot.svg](https://gist.githubusercontent.com/monalisa/gist1/raw/screenshot.svg)"+
+		"\n\n[build.log](https://gist.githubusercontent.com/monalisa/gist2/raw/build.log)", body)
+
+	bodyBytes, _ := ioutil.ReadAll(reg.Requests[0].Body)
+	assert.Contains(t, string(bodyBytes), `"content":"svg image data"`)
+}
+
+func Test_AttachFiles_binaryFile(t *testing.T) {
+	dir := t.TempDir()
+	imagePath := filepath.Join(dir, "screenshot.png")
+	require.NoError(t, ioutil.WriteFile(imagePath, []byte{0x89, 'P', 'N', 'G', 0x00, 0xff, 0xfe}, 0600))
+
+	reg := &httpmock.Registry{}
+	httpClient := &http.Client{Transport: reg}
+
+	_, err := AttachFiles(httpClient, "github.com", "", []string{imagePath})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "binary files are not supported")
+}
+
+func Test_AttachFiles_missingFile(t *testing.T) {
+	reg := &httpmock.Registry{}
+	httpClient := &http.Client{Transport: reg}
+
+	_, err := AttachFiles(httpClient, "github.com", "", []string{"does-not-exist.png"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to attach does-not-exist.png")
+}
@@ -0,0 +1,261 @@
+package shared
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
+)
+
+// CodeownersRule is a single pattern line from a CODEOWNERS file.
+type CodeownersRule struct {
+	Pattern string
+	Owners  []string
+}
+
+// ParseCodeowners reads rules from a CODEOWNERS file in declaration order.
+// Blank lines and comments are skipped; owner handles keep their "@" stripped.
+func ParseCodeowners(r io.Reader) []CodeownersRule {
+	var rules []CodeownersRule
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		owners := make([]string, 0, len(fields)-1)
+		for _, o := range fields[1:] {
+			owners = append(owners, strings.TrimPrefix(o, "@"))
+		}
+		rules = append(rules, CodeownersRule{Pattern: fields[0], Owners: owners})
+	}
+	return rules
+}
+
+// Matches reports whether a repository-relative path is covered by the rule,
+// following gitignore-style semantics: a leading "/" anchors the pattern to
+// the repository root, a trailing "/" matches everything under a directory,
+// and patterns without a slash match by base name anywhere in the tree.
+func (r CodeownersRule) Matches(path string) bool {
+	re, err := codeownersPatternRE(r.Pattern)
+	if err != nil {
+		return false
+	}
+	return re.MatchString(path)
+}
+
+func codeownersPatternRE(pattern string) (*regexp.Regexp, error) {
+	anchored := strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+	matchesDir := strings.HasSuffix(pattern, "/")
+	pattern = strings.Trim(pattern, "/")
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	if !anchored {
+		sb.WriteString(`(.*/)?`)
+	}
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				sb.WriteString(`.*`)
+				i++
+			} else {
+				sb.WriteString(`[^/]*`)
+			}
+		case '?':
+			sb.WriteString(`[^/]`)
+		default:
+			sb.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	if matchesDir {
+		sb.WriteString(`/.*`)
+	} else {
+		// a pattern naming a directory also owns everything within it
+		sb.WriteString(`(/.*)?`)
+	}
+	sb.WriteString("$")
+	return regexp.Compile(sb.String())
+}
+
+// OwnersForPaths resolves the owners for a set of changed paths. As on
+// GitHub, the last matching rule wins for each path; owners are returned
+// in first-seen order without duplicates.
+func OwnersForPaths(rules []CodeownersRule, paths []string) []string {
+	var owners []string
+	seen := map[string]bool{}
+	for _, path := range paths {
+		for i := len(rules) - 1; i >= 0; i-- {
+			if !rules[i].Matches(path) {
+				continue
+			}
+			for _, o := range rules[i].Owners {
+				if !seen[o] {
+					seen[o] = true
+					owners = append(owners, o)
+				}
+			}
+			break
+		}
+	}
+	return owners
+}
+
+// prioritizeReviewers moves options whose login or team slug appears in
+// suggestions to the front of the list, in suggestion order. Options are
+// either user display names like "login (Name)" or team handles like
+// "owner/slug".
+func prioritizeReviewers(options, suggestions []string) []string {
+	if len(suggestions) == 0 {
+		return options
+	}
+	optionLogin := func(option string) string {
+		if idx := strings.Index(option, " ("); idx >= 0 {
+			option = option[:idx]
+		}
+		return strings.ToLower(option)
+	}
+	byLogin := map[string]string{}
+	for _, option := range options {
+		byLogin[optionLogin(option)] = option
+	}
+	var prioritized []string
+	promoted := map[string]bool{}
+	for _, s := range suggestions {
+		if option, ok := byLogin[strings.ToLower(s)]; ok && !promoted[option] {
+			promoted[option] = true
+			prioritized = append(prioritized, option)
+		}
+	}
+	for _, option := range options {
+		if !promoted[option] {
+			prioritized = append(prioritized, option)
+		}
+	}
+	return prioritized
+}
+
+// ChangedPaths lists the repository-relative paths modified between two refs.
+func ChangedPaths(baseRef, headRef string) ([]string, error) {
+	diffCmd, err := git.GitCommand("diff", "--name-only", baseRef+"..."+headRef)
+	if err != nil {
+		return nil, err
+	}
+	output, err := run.PrepareCmd(diffCmd).Output()
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, line := range strings.Split(string(output), "\n") {
+		if line != "" {
+			paths = append(paths, line)
+		}
+	}
+	return paths, nil
+}
+
+// SuggestedReviewers computes reviewer suggestions for a new pull request:
+// owners matching the changed paths in the local CODEOWNERS file first,
+// falling back to the most frequent reviewers of recently merged pull
+// requests. Suggestions are best-effort; failures yield no suggestions.
+func SuggestedReviewers(client *api.Client, repo ghrepo.Interface, baseRef, headRef string) []string {
+	if rules := localCodeownersRules(); len(rules) > 0 {
+		paths, err := ChangedPaths(baseRef, headRef)
+		if err == nil {
+			if owners := OwnersForPaths(rules, paths); len(owners) > 0 {
+				return owners
+			}
+		}
+	}
+	return frequentReviewers(client, repo)
+}
+
+func localCodeownersRules() []CodeownersRule {
+	dir, err := git.ToplevelDir()
+	if err != nil {
+		return nil
+	}
+	for _, loc := range []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"} {
+		f, err := os.Open(filepath.Join(dir, filepath.FromSlash(loc)))
+		if err != nil {
+			continue
+		}
+		defer f.Close()
+		return ParseCodeowners(f)
+	}
+	return nil
+}
+
+func frequentReviewers(client *api.Client, repo ghrepo.Interface) []string {
+	var response struct {
+		Repository struct {
+			PullRequests struct {
+				Nodes []struct {
+					Reviews struct {
+						Nodes []struct {
+							Author struct {
+								Login string
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	query := `
+	query RecentReviewers($owner: String!, $repo: String!) {
+		repository(owner: $owner, name: $repo) {
+			pullRequests(last: 25, states: [MERGED]) {
+				nodes {
+					reviews(last: 10) {
+						nodes {
+							author { login }
+						}
+					}
+				}
+			}
+		}
+	}`
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+	}
+	if err := client.GraphQL(repo.RepoHost(), query, variables, &response); err != nil {
+		return nil
+	}
+
+	counts := map[string]int{}
+	var logins []string
+	for _, pr := range response.Repository.PullRequests.Nodes {
+		for _, review := range pr.Reviews.Nodes {
+			login := review.Author.Login
+			if login == "" {
+				continue
+			}
+			if counts[login] == 0 {
+				logins = append(logins, login)
+			}
+			counts[login]++
+		}
+	}
+	sort.SliceStable(logins, func(i, j int) bool {
+		return counts[logins[i]] > counts[logins[j]]
+	})
+	if len(logins) > 8 {
+		logins = logins[:8]
+	}
+	return logins
+}
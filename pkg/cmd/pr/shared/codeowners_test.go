@@ -0,0 +1,78 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCodeowners(t *testing.T) {
+	contents := `
+	# default owners
+	*        @octocat
+	/docs/   @hubot @octo-org/docs-team
+	*.go     gopher@example.com
+
+	orphaned-pattern-without-owners
+	`
+	rules := ParseCodeowners(strings.NewReader(contents))
+	assert.Equal(t, []CodeownersRule{
+		{Pattern: "*", Owners: []string{"octocat"}},
+		{Pattern: "/docs/", Owners: []string{"hubot", "octo-org/docs-team"}},
+		{Pattern: "*.go", Owners: []string{"gopher@example.com"}},
+	}, rules)
+}
+
+func TestCodeownersRule_Matches(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{pattern: "*", path: "anything/at/all.txt", want: true},
+		{pattern: "*.go", path: "cmd/gh/main.go", want: true},
+		{pattern: "*.go", path: "main.gone", want: false},
+		{pattern: "/docs/", path: "docs/index.md", want: true},
+		{pattern: "/docs/", path: "pkg/docs/index.md", want: false},
+		{pattern: "docs/", path: "pkg/docs/index.md", want: true},
+		{pattern: "/build/logs/", path: "build/logs/archive/out.log", want: true},
+		{pattern: "apps/", path: "apps", want: false},
+		{pattern: "/scripts", path: "scripts/build.sh", want: true},
+		{pattern: "internal/**/testdata", path: "internal/config/testdata/fixture.yml", want: true},
+		{pattern: "README.md", path: "pkg/cmd/README.md", want: true},
+		{pattern: "README.md", path: "README.markdown", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			rule := CodeownersRule{Pattern: tt.pattern}
+			assert.Equal(t, tt.want, rule.Matches(tt.path))
+		})
+	}
+}
+
+func TestOwnersForPaths(t *testing.T) {
+	rules := ParseCodeowners(strings.NewReader(`
+	*       @octocat
+	*.go    @gopher
+	/docs/  @hubot
+	`))
+
+	// the last matching rule wins for each path
+	assert.Equal(t, []string{"gopher"}, OwnersForPaths(rules, []string{"api/client.go"}))
+	assert.Equal(t, []string{"hubot", "octocat"}, OwnersForPaths(rules, []string{"docs/index.md", "LICENSE"}))
+	assert.Equal(t, []string{"gopher"}, OwnersForPaths(rules, []string{"a.go", "b.go"}))
+	assert.Nil(t, OwnersForPaths(nil, []string{"a.go"}))
+}
+
+func Test_prioritizeReviewers(t *testing.T) {
+	options := []string{"hubot (Hubot)", "monalisa (Mona Lisa)", "octocat", "OWNER/core"}
+
+	assert.Equal(t, options, prioritizeReviewers(options, nil))
+	assert.Equal(t,
+		[]string{"octocat", "OWNER/core", "hubot (Hubot)", "monalisa (Mona Lisa)"},
+		prioritizeReviewers(options, []string{"octocat", "owner/core", "somebody-else"}))
+	assert.Equal(t,
+		[]string{"monalisa (Mona Lisa)", "hubot (Hubot)", "octocat", "OWNER/core"},
+		prioritizeReviewers(options, []string{"MonaLisa", "monalisa"}))
+}
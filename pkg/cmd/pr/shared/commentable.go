@@ -35,13 +35,21 @@ type CommentableOptions struct {
 	IO                    *iostreams.IOStreams
 	HttpClient            func() (*http.Client, error)
 	RetrieveCommentable   func() (Commentable, ghrepo.Interface, error)
-	EditSurvey            func() (string, error)
-	InteractiveEditSurvey func() (string, error)
+	EditSurvey            func(string) (string, error)
+	InteractiveEditSurvey func(string) (string, error)
 	ConfirmSubmitSurvey   func() (bool, error)
 	OpenInBrowser         func(string) error
 	Interactive           bool
 	InputType             InputType
 	Body                  string
+	Attachments           []string
+	RecoverFile           string
+	// ReplyToID is the ID of a review comment to reply to, turning the comment
+	// into a threaded review reply (pull requests only)
+	ReplyToID int
+	// RestoreCmd names the command to suggest for resuming a failed run,
+	// e.g. "gh pr comment"
+	RestoreCmd string
 }
 
 func CommentablePreRun(cmd *cobra.Command, opts *CommentableOptions) error {
@@ -63,6 +71,10 @@ func CommentablePreRun(cmd *cobra.Command, opts *CommentableOptions) error {
 		inputFlags++
 	}
 
+	if opts.RecoverFile != "" && !opts.IO.CanPrompt() {
+		return cmdutil.FlagErrorf("`--recover` only supported when running interactively")
+	}
+
 	if inputFlags == 0 {
 		if !opts.IO.CanPrompt() {
 			return cmdutil.FlagErrorf("`--body`, `--body-file` or `--web` required when not running interactively")
@@ -76,10 +88,25 @@ func CommentablePreRun(cmd *cobra.Command, opts *CommentableOptions) error {
 		return cmdutil.FlagErrorf("specify only one of `--body`, `--body-file`, `--editor`, or `--web`")
 	}
 
+	if len(opts.Attachments) > 0 && opts.InputType == InputTypeWeb {
+		return cmdutil.FlagErrorf("the `--attach` flag is not supported with `--web`")
+	}
+
 	return nil
 }
 
-func CommentableRun(opts *CommentableOptions) error {
+func CommentableRun(opts *CommentableOptions) (err error) {
+	state := &IssueMetadataState{}
+	if opts.RecoverFile != "" {
+		err = FillFromJSON(opts.IO, opts.RecoverFile, state)
+		if err != nil {
+			return fmt.Errorf("failed to recover input: %w", err)
+		}
+	}
+	if opts.RestoreCmd != "" {
+		defer PreserveInputForCommand(opts.IO, state, &err, opts.RestoreCmd)()
+	}
+
 	commentable, repo, err := opts.RetrieveCommentable()
 	if err != nil {
 		return err
@@ -95,14 +122,16 @@ func CommentableRun(opts *CommentableOptions) error {
 	case InputTypeEditor:
 		var body string
 		if opts.Interactive {
-			body, err = opts.InteractiveEditSurvey()
+			body, err = opts.InteractiveEditSurvey(state.Body)
 		} else {
-			body, err = opts.EditSurvey()
+			body, err = opts.EditSurvey(state.Body)
 		}
 		if err != nil {
 			return err
 		}
 		opts.Body = body
+		state.Body = body
+		state.MarkDirty()
 	}
 
 	if opts.Interactive {
@@ -120,8 +149,26 @@ func CommentableRun(opts *CommentableOptions) error {
 		return err
 	}
 	apiClient := api.NewClientFromHTTP(httpClient)
-	params := api.CommentCreateInput{Body: opts.Body, SubjectId: commentable.Identifier()}
-	url, err := api.CommentCreate(apiClient, repo.RepoHost(), params)
+
+	if len(opts.Attachments) > 0 {
+		body, err := AttachFiles(httpClient, repo.RepoHost(), opts.Body, opts.Attachments)
+		if err != nil {
+			return err
+		}
+		opts.Body = body
+	}
+
+	var url string
+	if opts.ReplyToID != 0 {
+		pr, ok := commentable.(*api.PullRequest)
+		if !ok {
+			return errors.New("`--reply-to` is only supported for pull request review comments")
+		}
+		url, err = api.CommentReplyCreate(apiClient, repo, pr.Number, opts.ReplyToID, opts.Body)
+	} else {
+		params := api.CommentCreateInput{Body: opts.Body, SubjectId: commentable.Identifier()}
+		url, err = api.CommentCreate(apiClient, repo.RepoHost(), params)
+	}
 	if err != nil {
 		return err
 	}
@@ -139,8 +186,8 @@ func CommentableConfirmSubmitSurvey() (bool, error) {
 	return confirm, err
 }
 
-func CommentableInteractiveEditSurvey(cf func() (config.Config, error), io *iostreams.IOStreams) func() (string, error) {
-	return func() (string, error) {
+func CommentableInteractiveEditSurvey(cf func() (config.Config, error), io *iostreams.IOStreams) func(string) (string, error) {
+	return func(initialValue string) (string, error) {
 		editorCommand, err := cmdutil.DetermineEditor(cf)
 		if err != nil {
 			return "", err
@@ -151,17 +198,17 @@ func CommentableInteractiveEditSurvey(cf func() (config.Config, error), io *iost
 		cs := io.ColorScheme()
 		fmt.Fprintf(io.Out, "- %s to draft your comment in %s... ", cs.Bold("Press Enter"), cs.Bold(editorCommand))
 		_ = waitForEnter(io.In)
-		return surveyext.Edit(editorCommand, "*.md", "", io.In, io.Out, io.ErrOut)
+		return surveyext.Edit(editorCommand, "*.md", initialValue, io.In, io.Out, io.ErrOut)
 	}
 }
 
-func CommentableEditSurvey(cf func() (config.Config, error), io *iostreams.IOStreams) func() (string, error) {
-	return func() (string, error) {
+func CommentableEditSurvey(cf func() (config.Config, error), io *iostreams.IOStreams) func(string) (string, error) {
+	return func(initialValue string) (string, error) {
 		editorCommand, err := cmdutil.DetermineEditor(cf)
 		if err != nil {
 			return "", err
 		}
-		return surveyext.Edit(editorCommand, "*.md", "", io.In, io.Out, io.ErrOut)
+		return surveyext.Edit(editorCommand, "*.md", initialValue, io.In, io.Out, io.ErrOut)
 	}
 }
 
@@ -1,15 +1,21 @@
 package shared
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/pkg/markdown"
 	"github.com/cli/cli/v2/utils"
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/shurcooL/githubv4"
 )
 
 type Comment interface {
@@ -25,8 +31,58 @@ type Comment interface {
 	Status() string
 }
 
-func RawCommentList(comments api.Comments, reviews api.PullRequestReviews) string {
-	sortedComments := sortComments(comments, reviews)
+// FetchComments loads the full comment list for an issue or pull request,
+// requesting further pages as needed. When the preloaded preview of comments
+// is already complete it is refetched from the start so that all pages end up
+// in comments.Nodes.
+func FetchComments(client *http.Client, repo ghrepo.Interface, subjectID string, comments *api.Comments) error {
+	type response struct {
+		Node struct {
+			Issue struct {
+				Comments *api.Comments `graphql:"comments(first: 100, after: $endCursor)"`
+			} `graphql:"...on Issue"`
+			PullRequest struct {
+				Comments *api.Comments `graphql:"comments(first: 100, after: $endCursor)"`
+			} `graphql:"...on PullRequest"`
+		} `graphql:"node(id: $id)"`
+	}
+
+	variables := map[string]interface{}{
+		"id":        githubv4.ID(subjectID),
+		"endCursor": (*githubv4.String)(nil),
+	}
+	if comments.PageInfo.HasNextPage {
+		variables["endCursor"] = githubv4.String(comments.PageInfo.EndCursor)
+	} else {
+		comments.Nodes = comments.Nodes[0:0]
+	}
+
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), client)
+	for {
+		var query response
+		err := gql.QueryNamed(context.Background(), "CommentsForSubject", &query, variables)
+		if err != nil {
+			return err
+		}
+
+		page := query.Node.Issue.Comments
+		if page == nil {
+			page = query.Node.PullRequest.Comments
+		}
+
+		comments.Nodes = append(comments.Nodes, page.Nodes...)
+		if !page.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = githubv4.String(page.PageInfo.EndCursor)
+	}
+
+	comments.PageInfo.HasNextPage = false
+	return nil
+}
+
+func RawCommentList(comments api.Comments, reviews api.PullRequestReviews, order string, last int) string {
+	sortedComments := filterComments(sortComments(comments, reviews), order, last)
 	var b strings.Builder
 	for _, comment := range sortedComments {
 		fmt.Fprint(&b, formatRawComment(comment))
@@ -49,8 +105,8 @@ func formatRawComment(comment Comment) string {
 	return b.String()
 }
 
-func CommentList(io *iostreams.IOStreams, comments api.Comments, reviews api.PullRequestReviews, preview bool) (string, error) {
-	sortedComments := sortComments(comments, reviews)
+func CommentList(io *iostreams.IOStreams, comments api.Comments, reviews api.PullRequestReviews, preview bool, order string, last int) (string, error) {
+	sortedComments := filterComments(sortComments(comments, reviews), order, last)
 	if preview && len(sortedComments) > 0 {
 		sortedComments = sortedComments[len(sortedComments)-1:]
 	}
@@ -66,13 +122,16 @@ func CommentList(io *iostreams.IOStreams, comments api.Comments, reviews api.Pul
 	}
 
 	for i, comment := range sortedComments {
-		last := i+1 == retrievedCount
-		cmt, err := formatComment(io, comment, last)
+		newest := i+1 == retrievedCount
+		if order == "desc" {
+			newest = i == 0
+		}
+		cmt, err := formatComment(io, comment, newest)
 		if err != nil {
 			return "", err
 		}
 		fmt.Fprint(&b, cmt)
-		if last {
+		if i+1 == retrievedCount {
 			fmt.Fprintln(&b)
 		}
 	}
@@ -138,6 +197,23 @@ func formatComment(io *iostreams.IOStreams, comment Comment, newest bool) (strin
 	return b.String(), nil
 }
 
+// filterComments applies the requested presentation order ("asc", "desc", or
+// "" for ascending) and latest-n limit to a chronologically sorted comment
+// list.
+func filterComments(sorted []Comment, order string, last int) []Comment {
+	if last > 0 && len(sorted) > last {
+		sorted = sorted[len(sorted)-last:]
+	}
+	if order == "desc" {
+		reversed := make([]Comment, len(sorted))
+		for i, c := range sorted {
+			reversed[len(sorted)-1-i] = c
+		}
+		sorted = reversed
+	}
+	return sorted
+}
+
 func sortComments(cs api.Comments, rs api.PullRequestReviews) []Comment {
 	comments := cs.Nodes
 	reviews := rs.Nodes
@@ -0,0 +1,54 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RawCommentList_orderAndLast(t *testing.T) {
+	makeComment := func(login, body string, created time.Time) api.Comment {
+		return api.Comment{
+			Author:    api.Author{Login: login},
+			Body:      body,
+			CreatedAt: created,
+		}
+	}
+
+	now := time.Now()
+	comments := api.Comments{
+		TotalCount: 3,
+		Nodes: []api.Comment{
+			makeComment("monalisa", "first comment", now.Add(-3*time.Hour)),
+			makeComment("hubot", "second comment", now.Add(-2*time.Hour)),
+			makeComment("octocat", "third comment", now.Add(-time.Hour)),
+		},
+	}
+
+	out := RawCommentList(comments, api.PullRequestReviews{}, "asc", 0)
+	assert.True(t, strings.Index(out, "first comment") < strings.Index(out, "third comment"))
+
+	out = RawCommentList(comments, api.PullRequestReviews{}, "desc", 0)
+	assert.True(t, strings.Index(out, "third comment") < strings.Index(out, "first comment"))
+
+	out = RawCommentList(comments, api.PullRequestReviews{}, "asc", 2)
+	assert.NotContains(t, out, "first comment")
+	assert.Contains(t, out, "second comment")
+	assert.Contains(t, out, "third comment")
+
+	out = RawCommentList(comments, api.PullRequestReviews{}, "desc", 1)
+	assert.Equal(t, []string{"octocat"}, commentAuthors(out))
+}
+
+func commentAuthors(raw string) []string {
+	var authors []string
+	for _, line := range strings.Split(raw, "\n") {
+		if strings.HasPrefix(line, "author:\t") {
+			authors = append(authors, strings.TrimPrefix(line, "author:\t"))
+		}
+	}
+	return authors
+}
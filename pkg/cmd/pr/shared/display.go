@@ -15,14 +15,14 @@ func StateTitleWithColor(cs *iostreams.ColorScheme, pr api.PullRequest) string {
 	if pr.State == "OPEN" && pr.IsDraft {
 		return prStateColorFunc(strings.Title(strings.ToLower("Draft")))
 	}
-	return prStateColorFunc(strings.Title(strings.ToLower(pr.State)))
+	return prStateColorFunc(strings.Title(strings.ToLower(string(pr.State))))
 }
 
 func ColorForPR(pr api.PullRequest) string {
 	if pr.State == "OPEN" && pr.IsDraft {
 		return "gray"
 	}
-	return ColorForState(pr.State)
+	return ColorForState(string(pr.State))
 }
 
 // ColorForState returns a color constant for a PR/Issue state
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -89,6 +90,12 @@ func (e Editable) ReviewerIds() (*[]string, *[]string, error) {
 	if err != nil {
 		return nil, nil, err
 	}
+	// Leave team review requests untouched when the set of teams has not changed:
+	// re-sending the same team IDs causes GitHub to re-request a review from every
+	// team on the list.
+	if sameReviewerTeams(e.Reviewers.Default, teamReviewers) {
+		return &userIds, nil, nil
+	}
 	teamIds, err := e.Metadata.TeamsToIDs(teamReviewers)
 	if err != nil {
 		return nil, nil, err
@@ -96,6 +103,30 @@ func (e Editable) ReviewerIds() (*[]string, *[]string, error) {
 	return &userIds, &teamIds, nil
 }
 
+func sameReviewerTeams(defaults, teams []string) bool {
+	defaultTeams := []string{}
+	for _, r := range defaults {
+		if strings.ContainsRune(r, '/') {
+			defaultTeams = append(defaultTeams, strings.ToLower(r))
+		}
+	}
+	if len(defaultTeams) != len(teams) {
+		return false
+	}
+	loweredTeams := make([]string, len(teams))
+	for i, t := range teams {
+		loweredTeams[i] = strings.ToLower(t)
+	}
+	sort.Strings(defaultTeams)
+	sort.Strings(loweredTeams)
+	for i, t := range loweredTeams {
+		if defaultTeams[i] != t {
+			return false
+		}
+	}
+	return true
+}
+
 func (e Editable) AssigneeIds(client *api.Client, repo ghrepo.Interface) (*[]string, error) {
 	if !e.Assignees.Edited {
 		return nil, nil
@@ -131,7 +162,10 @@ func (e Editable) ProjectIds() (*[]string, error) {
 		s.RemoveValues(e.Projects.Remove)
 		e.Projects.Value = s.ToSlice()
 	}
-	p, err := e.Metadata.ProjectsToIDs(e.Projects.Value)
+	p, v2, err := e.Metadata.ProjectsToIDs(e.Projects.Value)
+	if err == nil && len(v2) > 0 {
+		err = fmt.Errorf("editing projects created under the new Projects experience is not supported")
+	}
 	return &p, err
 }
 
@@ -38,6 +38,41 @@ func UpdateIssue(httpClient *http.Client, repo ghrepo.Interface, id string, isPR
 		}
 	}
 
+	// Assignee changes requested via `--add-assignee`/`--remove-assignee` go through discrete
+	// mutations as well, so that edits made by others in the meantime aren't clobbered.
+	granularAssignees := options.Assignees.Edited && (len(options.Assignees.Add) > 0 || len(options.Assignees.Remove) > 0)
+	if granularAssignees {
+		apiClient := api.NewClientFromHTTP(httpClient)
+		meReplacer := NewMeReplacer(apiClient, repo.RepoHost())
+		if len(options.Assignees.Add) > 0 {
+			wg.Go(func() error {
+				logins, err := meReplacer.ReplaceSlice(options.Assignees.Add)
+				if err != nil {
+					return err
+				}
+				ids, err := options.Metadata.MembersToIDs(logins)
+				if err != nil {
+					return err
+				}
+				return addAssignees(httpClient, id, repo, ids)
+			})
+		}
+		if len(options.Assignees.Remove) > 0 {
+			wg.Go(func() error {
+				logins, err := meReplacer.ReplaceSlice(options.Assignees.Remove)
+				if err != nil {
+					return err
+				}
+				ids, err := options.Metadata.MembersToIDs(logins)
+				if err != nil {
+					return err
+				}
+				return removeAssignees(httpClient, id, repo, ids)
+			})
+		}
+		options.Assignees.Edited = false
+	}
+
 	if dirtyExcludingLabels(options) {
 		wg.Go(func() error {
 			return replaceIssueFields(httpClient, repo, id, isPR, options)
@@ -117,6 +152,40 @@ func addLabels(httpClient *http.Client, id string, repo ghrepo.Interface, labels
 	return gql.MutateNamed(context.Background(), "LabelAdd", &mutation, variables)
 }
 
+func addAssignees(httpClient *http.Client, id string, repo ghrepo.Interface, assignees []string) error {
+	params := githubv4.AddAssigneesToAssignableInput{
+		AssignableID: id,
+		AssigneeIDs:  *ghIds(&assignees),
+	}
+
+	var mutation struct {
+		AddAssigneesToAssignable struct {
+			Typename string `graphql:"__typename"`
+		} `graphql:"addAssigneesToAssignable(input: $input)"`
+	}
+
+	variables := map[string]interface{}{"input": params}
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), httpClient)
+	return gql.MutateNamed(context.Background(), "AssigneeAdd", &mutation, variables)
+}
+
+func removeAssignees(httpClient *http.Client, id string, repo ghrepo.Interface, assignees []string) error {
+	params := githubv4.RemoveAssigneesFromAssignableInput{
+		AssignableID: id,
+		AssigneeIDs:  *ghIds(&assignees),
+	}
+
+	var mutation struct {
+		RemoveAssigneesFromAssignable struct {
+			Typename string `graphql:"__typename"`
+		} `graphql:"removeAssigneesFromAssignable(input: $input)"`
+	}
+
+	variables := map[string]interface{}{"input": params}
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), httpClient)
+	return gql.MutateNamed(context.Background(), "AssigneeRemove", &mutation, variables)
+}
+
 func removeLabels(httpClient *http.Client, id string, repo ghrepo.Interface, labels []string) error {
 	params := githubv4.RemoveLabelsFromLabelableInput{
 		LabelableID: id,
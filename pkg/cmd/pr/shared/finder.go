@@ -43,6 +43,13 @@ type finder struct {
 	repo       ghrepo.Interface
 	prNumber   int
 	branchName string
+
+	// cache of the last successful lookup, so that several Find calls within
+	// one command invocation don't re-fetch the same pull request
+	cachedSelector string
+	cachedFields   []string
+	cachedPR       *api.PullRequest
+	cachedRepo     ghrepo.Interface
 }
 
 func NewFinder(factory *cmdutil.Factory) PRFinder {
@@ -88,6 +95,21 @@ func (f *finder) Find(opts FindOptions) (*api.PullRequest, ghrepo.Interface, err
 		return nil, nil, errors.New("Find error: no fields specified")
 	}
 
+	if f.cachedPR != nil && f.cachedSelector == opts.Selector {
+		cachedFields := set.NewStringSet()
+		cachedFields.AddValues(f.cachedFields)
+		cached := true
+		for _, field := range opts.Fields {
+			if !cachedFields.Contains(field) {
+				cached = false
+				break
+			}
+		}
+		if cached {
+			return f.cachedPR, f.cachedRepo, nil
+		}
+	}
+
 	if repo, prNumber, err := f.parseURL(opts.Selector); err == nil {
 		f.prNumber = prNumber
 		f.repo = repo
@@ -170,7 +192,16 @@ func (f *finder) Find(opts FindOptions) (*api.PullRequest, ghrepo.Interface, err
 		})
 	}
 
-	return pr, f.repo, g.Wait()
+	if err := g.Wait(); err != nil {
+		return pr, f.repo, err
+	}
+
+	f.cachedSelector = opts.Selector
+	f.cachedFields = fields.ToSlice()
+	f.cachedPR = pr
+	f.cachedRepo = f.repo
+
+	return pr, f.repo, nil
 }
 
 var pullURLRE = regexp.MustCompile(`^/([^/]+)/([^/]+)/pull/(\d+)`)
@@ -377,38 +408,10 @@ func preloadPrComments(client *http.Client, repo ghrepo.Interface, pr *api.PullR
 		return nil
 	}
 
-	type response struct {
-		Node struct {
-			PullRequest struct {
-				Comments api.Comments `graphql:"comments(first: 100, after: $endCursor)"`
-			} `graphql:"...on PullRequest"`
-		} `graphql:"node(id: $id)"`
-	}
-
-	variables := map[string]interface{}{
-		"id":        githubv4.ID(pr.ID),
-		"endCursor": githubv4.String(pr.Comments.PageInfo.EndCursor),
-	}
-
-	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), client)
-
-	for {
-		var query response
-		err := gql.QueryNamed(context.Background(), "CommentsForPullRequest", &query, variables)
-		if err != nil {
-			return err
-		}
-
-		pr.Comments.Nodes = append(pr.Comments.Nodes, query.Node.PullRequest.Comments.Nodes...)
-		pr.Comments.TotalCount = len(pr.Comments.Nodes)
-
-		if !query.Node.PullRequest.Comments.PageInfo.HasNextPage {
-			break
-		}
-		variables["endCursor"] = githubv4.String(query.Node.PullRequest.Comments.PageInfo.EndCursor)
+	if err := FetchComments(client, repo, pr.ID, &pr.Comments); err != nil {
+		return err
 	}
-
-	pr.Comments.PageInfo.HasNextPage = false
+	pr.Comments.TotalCount = len(pr.Comments.Nodes)
 	return nil
 }
 
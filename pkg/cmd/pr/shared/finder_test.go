@@ -457,3 +457,39 @@ func TestFind(t *testing.T) {
 		})
 	}
 }
+
+func TestFind_caching(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	// one stub only: a repeat lookup must be served from the cache
+	reg.Register(
+		httpmock.GraphQL(`query PullRequestByNumber\b`),
+		httpmock.StringResponse(`{"data":{"repository":{
+			"pullRequest":{"number":13}
+		}}}`))
+
+	f := finder{
+		httpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		baseRepoFn: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	pr, _, err := f.Find(FindOptions{Selector: "13", Fields: []string{"id", "number"}})
+	if err != nil {
+		t.Fatalf("Find() error: %v", err)
+	}
+
+	cachedPR, repo, err := f.Find(FindOptions{Selector: "13", Fields: []string{"number"}})
+	if err != nil {
+		t.Fatalf("Find() error on cached lookup: %v", err)
+	}
+	if cachedPR != pr {
+		t.Error("expected the cached PullRequest to be returned")
+	}
+	if repoURL := ghrepo.GenerateRepoURL(repo, ""); repoURL != "https://github.com/OWNER/REPO" {
+		t.Errorf("unexpected repo %s", repoURL)
+	}
+}
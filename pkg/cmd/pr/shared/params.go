@@ -3,11 +3,14 @@ package shared
 import (
 	"fmt"
 	"net/url"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/githubsearch"
+	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/google/shlex"
 )
 
@@ -44,6 +47,27 @@ func WithPrAndIssueQueryParams(client *api.Client, baseRepo ghrepo.Interface, ba
 	return u.String(), nil
 }
 
+// PreserveBodyForWeb moves a body that makes the prefill URL exceed the
+// maximum length browsers accept out of state and into a temporary file that
+// the user can paste into the web form
+func PreserveBodyForWeb(io *iostreams.IOStreams, state *IssueMetadataState) error {
+	tmpfile, err := io.TempFile(os.TempDir(), "gh-body*.md")
+	if err != nil {
+		return err
+	}
+	defer tmpfile.Close()
+
+	if _, err := tmpfile.WriteString(state.Body); err != nil {
+		return err
+	}
+
+	cs := io.ColorScheme()
+	fmt.Fprintf(io.ErrOut, "%s Body exceeds the maximum link length; paste it into the web form from %s\n", cs.WarningIcon(), tmpfile.Name())
+
+	state.Body = ""
+	return nil
+}
+
 // Ensure that tb.MetadataResult object exists and contains enough pre-fetched API data to be able
 // to resolve all object listed in tb to GraphQL IDs.
 func fillMetadata(client *api.Client, baseRepo ghrepo.Interface, tb *IssueMetadataState) error {
@@ -104,11 +128,14 @@ func AddMetadataToIssueParams(client *api.Client, baseRepo ghrepo.Interface, par
 	}
 	params["labelIds"] = labelIDs
 
-	projectIDs, err := tb.MetadataResult.ProjectsToIDs(tb.Projects)
+	projectIDs, projectV2IDs, err := tb.MetadataResult.ProjectsToIDs(tb.Projects)
 	if err != nil {
 		return fmt.Errorf("could not add to project: %w", err)
 	}
 	params["projectIds"] = projectIDs
+	if len(projectV2IDs) > 0 {
+		params["projectV2Ids"] = projectV2IDs
+	}
 
 	if len(tb.Milestones) > 0 {
 		milestoneID, err := tb.MetadataResult.MilestoneToID(tb.Milestones[0])
@@ -148,18 +175,41 @@ func AddMetadataToIssueParams(client *api.Client, baseRepo ghrepo.Interface, par
 }
 
 type FilterOptions struct {
-	Entity     string
-	State      string
-	Assignee   string
-	Labels     []string
-	Author     string
-	BaseBranch string
-	HeadBranch string
-	Mention    string
-	Milestone  string
-	Search     string
-	Draft      string
-	Fields     []string
+	Entity        string
+	State         string
+	Assignee      string
+	Labels        []string
+	Author        string
+	BaseBranch    string
+	HeadBranch    string
+	Mention       string
+	Milestone     string
+	CreatedAfter  string
+	CreatedBefore string
+	Search        string
+	Draft         string
+	Fields        []string
+}
+
+// ValidateDateFilters checks that --created-after/--created-before values are
+// ISO dates and that together they describe a non-empty range.
+func ValidateDateFilters(createdAfter, createdBefore string) error {
+	var after, before time.Time
+	var err error
+	if createdAfter != "" {
+		if after, err = time.Parse("2006-01-02", createdAfter); err != nil {
+			return fmt.Errorf("invalid value for --created-after: %q is not a YYYY-MM-DD date", createdAfter)
+		}
+	}
+	if createdBefore != "" {
+		if before, err = time.Parse("2006-01-02", createdBefore); err != nil {
+			return fmt.Errorf("invalid value for --created-before: %q is not a YYYY-MM-DD date", createdBefore)
+		}
+	}
+	if !after.IsZero() && !before.IsZero() && before.Before(after) {
+		return fmt.Errorf("--created-before date cannot precede the --created-after date")
+	}
+	return nil
 }
 
 func (opts *FilterOptions) IsDefault() bool {
@@ -187,6 +237,9 @@ func (opts *FilterOptions) IsDefault() bool {
 	if opts.Milestone != "" {
 		return false
 	}
+	if opts.CreatedAfter != "" || opts.CreatedBefore != "" {
+		return false
+	}
 	if opts.Search != "" {
 		return false
 	}
@@ -245,6 +298,12 @@ func SearchQueryBuild(options FilterOptions) string {
 	if options.Milestone != "" {
 		q.InMilestone(options.Milestone)
 	}
+	if options.CreatedAfter != "" {
+		q.CreatedAfter(options.CreatedAfter)
+	}
+	if options.CreatedBefore != "" {
+		q.CreatedBefore(options.CreatedBefore)
+	}
 	if options.Search != "" {
 		q.AddQuery(options.Search)
 	}
@@ -302,6 +361,21 @@ func (r *MeReplacer) Replace(handle string) (string, error) {
 	return handle, nil
 }
 
+// ReplaceFilters resolves `@me` in all of the user filters of opts, in place.
+func (r *MeReplacer) ReplaceFilters(filters *FilterOptions) error {
+	var err error
+	filters.Assignee, err = r.Replace(filters.Assignee)
+	if err != nil {
+		return err
+	}
+	filters.Author, err = r.Replace(filters.Author)
+	if err != nil {
+		return err
+	}
+	filters.Mention, err = r.Replace(filters.Mention)
+	return err
+}
+
 func (r *MeReplacer) ReplaceSlice(handles []string) ([]string, error) {
 	res := make([]string, len(handles))
 	for i, h := range handles {
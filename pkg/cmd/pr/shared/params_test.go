@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"io/ioutil"
 	"net/http"
 	"reflect"
 	"testing"
@@ -8,6 +9,8 @@ import (
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/test"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -273,3 +276,28 @@ func Test_WithPrAndIssueQueryParams(t *testing.T) {
 		})
 	}
 }
+
+func Test_PreserveBodyForWeb(t *testing.T) {
+	io, _, _, errOut := iostreams.Test()
+
+	tf, err := ioutil.TempFile(t.TempDir(), "testfile*")
+	assert.NoError(t, err)
+	defer tf.Close()
+	io.TempFileOverride = tf
+
+	state := &IssueMetadataState{
+		Title: "a pull request",
+		Body:  "a body too large for a URL",
+	}
+
+	assert.NoError(t, PreserveBodyForWeb(io, state))
+	assert.Equal(t, "", state.Body)
+	assert.Equal(t, "a pull request", state.Title)
+
+	data, err := ioutil.ReadFile(tf.Name())
+	assert.NoError(t, err)
+	assert.Equal(t, "a body too large for a URL", string(data))
+
+	//nolint:staticcheck // prefer exact matchers over ExpectLines
+	test.ExpectLines(t, errOut.String(), `Body exceeds the maximum link length; paste it into the web form from .*testfile.*`)
+}
@@ -10,16 +10,27 @@ import (
 )
 
 func PreserveInput(io *iostreams.IOStreams, state *IssueMetadataState, createErr *error) func() {
+	issueType := "pr"
+	if state.Type == IssueMetadata {
+		issueType = "issue"
+	}
+	return PreserveInputForCommand(io, state, createErr, fmt.Sprintf("gh %s create", issueType))
+}
+
+// PreserveInputForCommand saves state to a temporary file when the surrounding
+// operation failed, so that the run can be resumed by passing the file to the
+// named command's `--recover` flag
+func PreserveInputForCommand(io *iostreams.IOStreams, state *IssueMetadataState, runErr *error, restoreCmd string) func() {
 	return func() {
 		if !state.IsDirty() {
 			return
 		}
 
-		if *createErr == nil {
+		if *runErr == nil {
 			return
 		}
 
-		if cmdutil.IsUserCancellation(*createErr) {
+		if cmdutil.IsUserCancellation(*runErr) {
 			// these errors are user-initiated cancellations
 			return
 		}
@@ -55,12 +66,7 @@ func PreserveInput(io *iostreams.IOStreams, state *IssueMetadataState, createErr
 
 		cs := io.ColorScheme()
 
-		issueType := "pr"
-		if state.Type == IssueMetadata {
-			issueType = "issue"
-		}
-
-		fmt.Fprintf(out, "%s operation failed. To restore: gh %s create --recover %s\n", cs.FailureIcon(), issueType, tmpfile.Name())
+		fmt.Fprintf(out, "%s operation failed. To restore: %s --recover %s\n", cs.FailureIcon(), restoreCmd, tmpfile.Name())
 
 		// some whitespace before the actual error
 		fmt.Fprintln(out)
@@ -23,12 +23,15 @@ type IssueMetadataState struct {
 	Body  string
 	Title string
 
-	Metadata   []string
-	Reviewers  []string
-	Assignees  []string
-	Labels     []string
-	Projects   []string
-	Milestones []string
+	Metadata  []string
+	Reviewers []string
+	// SuggestedReviewers orders reviewer choices in interactive prompts; it
+	// is advisory only and never submitted with the pull request.
+	SuggestedReviewers []string `json:"-"`
+	Assignees          []string
+	Labels             []string
+	Projects           []string
+	Milestones         []string
 
 	MetadataResult *api.RepoMetadataResult
 
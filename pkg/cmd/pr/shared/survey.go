@@ -25,6 +25,15 @@ const (
 	EditCommitSubjectAction
 
 	noMilestone = "(none)"
+
+	// metadataSurveyPageSize overrides survey's cramped default so that large
+	// label/assignee/project lists are navigable while filtering
+	metadataSurveyPageSize = 15
+
+	// metadataSearchLimit caps how many assignees, labels, and projects are
+	// fetched up front; when a listing hits the cap, the user can narrow it down
+	// with a server-side search instead of waiting on every page
+	metadataSearchLimit = 100
 )
 
 func ConfirmSubmission(allowPreview bool, allowMetadata bool) (Action, error) {
@@ -155,7 +164,20 @@ func (mf *MetadataFetcher) RepoMetadataFetch(input api.RepoMetadataInput) (*api.
 	mf.IO.StartProgressIndicator()
 	metadataResult, err := api.RepoMetadata(mf.APIClient, mf.Repo, input)
 	mf.IO.StopProgressIndicator()
-	mf.State.MetadataResult = metadataResult
+	if metadataResult != nil {
+		for _, warning := range metadataResult.Warnings {
+			fmt.Fprintf(mf.IO.ErrOut, "%s warning: %s\n", mf.IO.ColorScheme().WarningIcon(), warning)
+		}
+	}
+	// merge rather than overwrite so that results of earlier fetches stay
+	// available for ID resolution after a narrower search fetch
+	if metadataResult != nil {
+		if mf.State.MetadataResult == nil {
+			mf.State.MetadataResult = metadataResult
+		} else {
+			mf.State.MetadataResult.Merge(metadataResult)
+		}
+	}
 	return metadataResult, err
 }
 
@@ -195,15 +217,70 @@ func MetadataSurvey(io *iostreams.IOStreams, baseRepo ghrepo.Interface, fetcher
 	}
 
 	metadataInput := api.RepoMetadataInput{
-		Reviewers:  isChosen("Reviewers"),
-		Assignees:  isChosen("Assignees"),
-		Labels:     isChosen("Labels"),
-		Projects:   isChosen("Projects"),
-		Milestones: isChosen("Milestone"),
+		Reviewers:   isChosen("Reviewers"),
+		Assignees:   isChosen("Assignees"),
+		Labels:      isChosen("Labels"),
+		Projects:    isChosen("Projects"),
+		Milestones:  isChosen("Milestone"),
+		SearchLimit: metadataSearchLimit,
 	}
 	metadataResult, err := fetcher.RepoMetadataFetch(metadataInput)
 	if err != nil {
-		return fmt.Errorf("error fetching metadata options: %w", err)
+		if metadataResult == nil || metadataResult.IsEmpty() {
+			return fmt.Errorf("error fetching metadata options: %w", err)
+		}
+		// a partial result is still usable; categories that came back empty
+		// surface their own warnings below
+		fmt.Fprintf(io.ErrOut, "warning: error fetching metadata options: %v\n", err)
+	}
+	if metadataResult == nil {
+		metadataResult = &api.RepoMetadataResult{}
+	}
+
+	// a listing that comes back at the cap was likely truncated; instead of
+	// paginating through everything, let the user narrow it down server-side
+	searchCategory := func(message string, refine api.RepoMetadataInput) (*api.RepoMetadataResult, error) {
+		var query string
+		err := prompt.SurveyAskOne(&survey.Input{
+			Message: fmt.Sprintf("%s (leave blank to choose from the first %d)", message, metadataSearchLimit),
+		}, &query)
+		if err != nil {
+			return nil, fmt.Errorf("could not prompt: %w", err)
+		}
+		if query == "" {
+			return nil, nil
+		}
+		refine.SearchLimit = metadataSearchLimit
+		refine.Query = query
+		return fetcher.RepoMetadataFetch(refine)
+	}
+
+	if (isChosen("Reviewers") || isChosen("Assignees")) && len(metadataResult.AssignableUsers) >= metadataSearchLimit {
+		refined, err := searchCategory("Search users", api.RepoMetadataInput{Assignees: true})
+		if err != nil {
+			return err
+		}
+		if refined != nil {
+			metadataResult.AssignableUsers = refined.AssignableUsers
+		}
+	}
+	if isChosen("Labels") && len(metadataResult.Labels) >= metadataSearchLimit {
+		refined, err := searchCategory("Search labels", api.RepoMetadataInput{Labels: true})
+		if err != nil {
+			return err
+		}
+		if refined != nil {
+			metadataResult.Labels = refined.Labels
+		}
+	}
+	if isChosen("Projects") && len(metadataResult.Projects) >= metadataSearchLimit {
+		refined, err := searchCategory("Search projects", api.RepoMetadataInput{Projects: true})
+		if err != nil {
+			return err
+		}
+		if refined != nil {
+			metadataResult.Projects = refined.Projects
+		}
 	}
 
 	var users []string
@@ -234,7 +311,7 @@ func MetadataSurvey(io *iostreams.IOStreams, baseRepo ghrepo.Interface, fetcher
 				Name: "reviewers",
 				Prompt: &survey.MultiSelect{
 					Message: "Reviewers",
-					Options: append(users, teams...),
+					Options: prioritizeReviewers(append(users, teams...), state.SuggestedReviewers),
 					Default: state.Reviewers,
 				},
 			})
@@ -311,7 +388,7 @@ func MetadataSurvey(io *iostreams.IOStreams, baseRepo ghrepo.Interface, fetcher
 		Milestone string
 	}{}
 
-	err = prompt.SurveyAsk(mqs, &values, survey.WithKeepFilter(true))
+	err = prompt.SurveyAsk(mqs, &values, survey.WithKeepFilter(true), survey.WithPageSize(metadataSurveyPageSize))
 	if err != nil {
 		return fmt.Errorf("could not prompt: %w", err)
 	}
@@ -1,6 +1,8 @@
 package shared
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/cli/cli/v2/api"
@@ -12,10 +14,11 @@ import (
 
 type metadataFetcher struct {
 	metadataResult *api.RepoMetadataResult
+	err            error
 }
 
 func (mf *metadataFetcher) RepoMetadataFetch(input api.RepoMetadataInput) (*api.RepoMetadataResult, error) {
-	return mf.metadataResult, nil
+	return mf.metadataResult, mf.err
 }
 
 func TestMetadataSurvey_selectAll(t *testing.T) {
@@ -43,40 +46,15 @@ func TestMetadataSurvey_selectAll(t *testing.T) {
 		},
 	}
 
-	//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-	as, restoreAsk := prompt.InitAskStubber()
-	defer restoreAsk()
+	as := prompt.NewAskStubber(t)
 
-	//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-	as.Stub([]*prompt.QuestionStub{
-		{
-			Name:  "metadata",
-			Value: []string{"Labels", "Projects", "Assignees", "Reviewers", "Milestone"},
-		},
-	})
-	//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-	as.Stub([]*prompt.QuestionStub{
-		{
-			Name:  "reviewers",
-			Value: []string{"monalisa"},
-		},
-		{
-			Name:  "assignees",
-			Value: []string{"hubot"},
-		},
-		{
-			Name:  "labels",
-			Value: []string{"good first issue"},
-		},
-		{
-			Name:  "projects",
-			Value: []string{"The road to 1.0"},
-		},
-		{
-			Name:  "milestone",
-			Value: "(none)",
-		},
-	})
+	as.StubPrompt("What would you like to add?").
+		AnswerWith([]string{"Labels", "Projects", "Assignees", "Reviewers", "Milestone"})
+	as.StubPrompt("Reviewers").AnswerWith([]string{"monalisa"})
+	as.StubPrompt("Assignees").AnswerWith([]string{"hubot"})
+	as.StubPrompt("Labels").AnswerWith([]string{"good first issue"})
+	as.StubPrompt("Projects").AnswerWith([]string{"The road to 1.0"})
+	as.StubPrompt("Milestone").AnswerWith("(none)")
 
 	state := &IssueMetadataState{
 		Assignees: []string{"hubot"},
@@ -112,28 +90,11 @@ func TestMetadataSurvey_keepExisting(t *testing.T) {
 		},
 	}
 
-	//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-	as, restoreAsk := prompt.InitAskStubber()
-	defer restoreAsk()
+	as := prompt.NewAskStubber(t)
 
-	//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-	as.Stub([]*prompt.QuestionStub{
-		{
-			Name:  "metadata",
-			Value: []string{"Labels", "Projects"},
-		},
-	})
-	//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-	as.Stub([]*prompt.QuestionStub{
-		{
-			Name:  "labels",
-			Value: []string{"good first issue"},
-		},
-		{
-			Name:  "projects",
-			Value: []string{"The road to 1.0"},
-		},
-	})
+	as.StubPrompt("What would you like to add?").AnswerWith([]string{"Labels", "Projects"})
+	as.StubPrompt("Labels").AnswerWith([]string{"good first issue"})
+	as.StubPrompt("Projects").AnswerWith([]string{"The road to 1.0"})
 
 	state := &IssueMetadataState{
 		Assignees: []string{"hubot"},
@@ -148,3 +109,68 @@ func TestMetadataSurvey_keepExisting(t *testing.T) {
 	assert.Equal(t, []string{"good first issue"}, state.Labels)
 	assert.Equal(t, []string{"The road to 1.0"}, state.Projects)
 }
+
+func TestMetadataSurvey_fetchFailure(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+
+	repo := ghrepo.New("OWNER", "REPO")
+
+	fetcher := &metadataFetcher{
+		metadataResult: &api.RepoMetadataResult{},
+		err:            errors.New("the server is on fire"),
+	}
+
+	as := prompt.NewAskStubber(t)
+	as.StubPrompt("What would you like to add?").AnswerWith([]string{"Labels"})
+
+	state := &IssueMetadataState{}
+	err := MetadataSurvey(io, repo, fetcher, state)
+	assert.EqualError(t, err, "error fetching metadata options: the server is on fire")
+}
+
+type searchMetadataFetcher struct {
+	inputs []api.RepoMetadataInput
+}
+
+func (mf *searchMetadataFetcher) RepoMetadataFetch(input api.RepoMetadataInput) (*api.RepoMetadataResult, error) {
+	mf.inputs = append(mf.inputs, input)
+	if input.Query != "" {
+		return &api.RepoMetadataResult{
+			Labels: []api.RepoLabel{
+				{Name: "needs triage"},
+			},
+		}, nil
+	}
+	result := &api.RepoMetadataResult{}
+	for i := 0; i < input.SearchLimit; i++ {
+		result.Labels = append(result.Labels, api.RepoLabel{Name: fmt.Sprintf("label %03d", i)})
+	}
+	return result, nil
+}
+
+func TestMetadataSurvey_searchTruncatedList(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+
+	repo := ghrepo.New("OWNER", "REPO")
+
+	fetcher := &searchMetadataFetcher{}
+
+	as := prompt.NewAskStubber(t)
+
+	as.StubPrompt("What would you like to add?").AnswerWith([]string{"Labels"})
+	as.StubPrompt("Search labels (leave blank to choose from the first 100)").AnswerWith("triage")
+	as.StubPrompt("Labels").AnswerWith([]string{"needs triage"})
+
+	state := &IssueMetadataState{}
+	err := MetadataSurvey(io, repo, fetcher, state)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "", stderr.String())
+
+	assert.Equal(t, []string{"needs triage"}, state.Labels)
+	if assert.Equal(t, 2, len(fetcher.inputs)) {
+		assert.Equal(t, "", fetcher.inputs[0].Query)
+		assert.Equal(t, "triage", fetcher.inputs[1].Query)
+	}
+}
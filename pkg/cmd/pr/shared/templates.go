@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -185,6 +187,30 @@ func (m *templateManager) Choose() (Template, error) {
 	return m.templates[selectedOption], nil
 }
 
+// Select returns the template whose name matches name without prompting, for
+// non-interactive template selection. Matching is case-insensitive.
+func (m *templateManager) Select(name string) (Template, error) {
+	if err := m.memoizedFetch(); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, t := range m.templates {
+		// a template loaded from the filesystem is named after its file; accept
+		// the name both with and without the file extension
+		if strings.EqualFold(t.Name(), name) ||
+			strings.EqualFold(strings.TrimSuffix(t.Name(), path.Ext(t.Name())), name) {
+			return t, nil
+		}
+		names = append(names, t.Name())
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no templates found in %s", ghrepo.FullName(m.repo))
+	}
+	return nil, fmt.Errorf("template %q not found; available templates: %s", name, strings.Join(names, ", "))
+}
+
 func (m *templateManager) memoizedFetch() error {
 	if m.didFetch {
 		return m.fetchError
@@ -63,14 +63,44 @@ func TestTemplateManager_hasAPI(t *testing.T) {
 
 	assert.Equal(t, "LEGACY", string(m.LegacyBody()))
 
-	//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-	as, askRestore := prompt.InitAskStubber()
-	defer askRestore()
-
-	//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-	as.StubOne(1) // choose "Feature Request"
+	as := prompt.NewAskStubber(t)
+	as.StubPrompt("Choose a template").AnswerWith(1) // choose "Feature Request"
 	tpl, err := m.Choose()
 	assert.NoError(t, err)
 	assert.Equal(t, "Feature request", tpl.NameForSubmit())
 	assert.Equal(t, "I need a feature", string(tpl.Body()))
 }
+
+func TestTemplateManager_Select(t *testing.T) {
+	rootDir := t.TempDir()
+	templateDir := filepath.Join(rootDir, ".github", "PULL_REQUEST_TEMPLATE")
+	_ = os.MkdirAll(templateDir, 0755)
+	_ = ioutil.WriteFile(filepath.Join(templateDir, "bugfix.md"), []byte("FIX THE BUG"), 0644)
+	_ = ioutil.WriteFile(filepath.Join(templateDir, "feature.md"), []byte("ADD A FEATURE"), 0644)
+
+	m := templateManager{
+		repo:    ghrepo.NewWithHost("OWNER", "REPO", "example.com"),
+		rootDir: rootDir,
+		allowFS: true,
+		isPR:    true,
+	}
+
+	tpl, err := m.Select("Bugfix")
+	assert.NoError(t, err)
+	assert.Equal(t, "FIX THE BUG", string(tpl.Body()))
+
+	_, err = m.Select("hotfix")
+	assert.EqualError(t, err, `template "hotfix" not found; available templates: bugfix.md, feature.md`)
+}
+
+func TestTemplateManager_Select_noTemplates(t *testing.T) {
+	m := templateManager{
+		repo:    ghrepo.NewWithHost("OWNER", "REPO", "example.com"),
+		rootDir: t.TempDir(),
+		allowFS: true,
+		isPR:    true,
+	}
+
+	_, err := m.Select("bugfix")
+	assert.EqualError(t, err, "no templates found in OWNER/REPO")
+}
@@ -0,0 +1,27 @@
+package shared
+
+// prTabSuffixes maps the tab names accepted by `pr view --web --tab` to the
+// path suffix of the corresponding pull request page tab. The conversation
+// tab is the pull request page itself and adds no suffix.
+var prTabSuffixes = map[string]string{
+	"conversation": "",
+	"files":        "/files",
+	"checks":       "/checks",
+	"commits":      "/commits",
+}
+
+// ValidBrowserTab reports whether tab names a pull request page tab.
+func ValidBrowserTab(tab string) bool {
+	_, ok := prTabSuffixes[tab]
+	return ok
+}
+
+// BrowserURL builds the browser URL for an issue or pull request, optionally
+// deep-linking a pull request page tab or an individual comment.
+func BrowserURL(baseURL, tab, commentID string) string {
+	url := baseURL + prTabSuffixes[tab]
+	if commentID != "" {
+		url += "#issuecomment-" + commentID
+	}
+	return url
+}
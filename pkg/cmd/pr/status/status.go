@@ -13,6 +13,7 @@ import (
 	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -29,6 +30,7 @@ type StatusOptions struct {
 	Branch     func() (string, error)
 
 	HasRepoOverride bool
+	Fast            bool
 	Exporter        cmdutil.Exporter
 }
 
@@ -43,7 +45,7 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 
 	cmd := &cobra.Command{
 		Use:   "status",
-		Short: "Show status of relevant pull requests",
+		Short: i18n.T("Show status of relevant pull requests"),
 		Args:  cmdutil.NoArgsQuoteReminder,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
@@ -57,6 +59,8 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 		},
 	}
 
+	cmd.Flags().BoolVarP(&opts.Fast, "fast", "", false, "Do not fetch the status of CI checks")
+
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.PullRequestFields)
 
 	return cmd
@@ -92,9 +96,10 @@ func statusRun(opts *StatusOptions) error {
 	}
 
 	options := api.StatusOptions{
-		Username:  "@me",
-		CurrentPR: currentPRNumber,
-		HeadRef:   currentPRHeadRef,
+		Username:   "@me",
+		CurrentPR:  currentPRNumber,
+		HeadRef:    currentPRHeadRef,
+		SkipChecks: opts.Fast,
 	}
 	if opts.Exporter != nil {
 		options.Fields = opts.Exporter.Fields()
@@ -104,6 +109,10 @@ func statusRun(opts *StatusOptions) error {
 		return err
 	}
 
+	for _, warning := range prPayload.Warnings {
+		fmt.Fprintf(opts.IO.ErrOut, "%s warning: %s\n", opts.IO.ColorScheme().WarningIcon(), warning)
+	}
+
 	err = opts.IO.StartPager()
 	if err != nil {
 		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
@@ -126,10 +135,10 @@ func statusRun(opts *StatusOptions) error {
 	cs := opts.IO.ColorScheme()
 
 	fmt.Fprintln(out, "")
-	fmt.Fprintf(out, "Relevant pull requests in %s\n", ghrepo.FullName(baseRepo))
+	fmt.Fprintf(out, i18n.T("Relevant pull requests in %s\n"), ghrepo.FullName(baseRepo))
 	fmt.Fprintln(out, "")
 
-	shared.PrintHeader(opts.IO, "Current branch")
+	shared.PrintHeader(opts.IO, i18n.T("Current branch"))
 	currentPR := prPayload.CurrentPR
 	if currentPR != nil && currentPR.State != "OPEN" && prPayload.DefaultBranch == currentBranch {
 		currentPR = nil
@@ -143,7 +152,7 @@ func statusRun(opts *StatusOptions) error {
 	}
 	fmt.Fprintln(out)
 
-	shared.PrintHeader(opts.IO, "Created by you")
+	shared.PrintHeader(opts.IO, i18n.T("Created by you"))
 	if prPayload.ViewerCreated.TotalCount > 0 {
 		printPrs(opts.IO, prPayload.ViewerCreated.TotalCount, prPayload.ViewerCreated.PullRequests...)
 	} else {
@@ -151,7 +160,7 @@ func statusRun(opts *StatusOptions) error {
 	}
 	fmt.Fprintln(out)
 
-	shared.PrintHeader(opts.IO, "Requesting a code review from you")
+	shared.PrintHeader(opts.IO, i18n.T("Requesting a code review from you"))
 	if prPayload.ReviewRequested.TotalCount > 0 {
 		printPrs(opts.IO, prPayload.ReviewRequested.TotalCount, prPayload.ReviewRequested.PullRequests...)
 	} else {
@@ -2,6 +2,8 @@ package status
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"regexp"
@@ -76,10 +78,42 @@ func initFakeHTTP() *httpmock.Registry {
 	return &httpmock.Registry{}
 }
 
+// registerStatusFixture splits a combined pr status fixture into responses for the three
+// queries that the command runs concurrently
+func registerStatusFixture(t *testing.T, reg *httpmock.Registry, fixturePath string) {
+	t.Helper()
+	raw, err := ioutil.ReadFile(fixturePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fixture struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &fixture); err != nil {
+		t.Fatal(err)
+	}
+	section := func(name, alias string) string {
+		data, ok := fixture.Data[name]
+		if !ok {
+			return `{"data": {}}`
+		}
+		return fmt.Sprintf(`{"data":{%q:%s}}`, alias, data)
+	}
+	reg.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.StringResponse(section("repository", "repository")))
+	reg.Register(httpmock.GraphQL(`query PullRequestStatusViewerCreated\b`), httpmock.StringResponse(section("viewerCreated", "results")))
+	reg.Register(httpmock.GraphQL(`query PullRequestStatusReviewRequested\b`), httpmock.StringResponse(section("reviewRequested", "results")))
+}
+
+func registerBlankStatusResponses(reg *httpmock.Registry) {
+	reg.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.StringResponse(`{"data": {}}`))
+	reg.Register(httpmock.GraphQL(`query PullRequestStatusViewerCreated\b`), httpmock.StringResponse(`{"data": {}}`))
+	reg.Register(httpmock.GraphQL(`query PullRequestStatusReviewRequested\b`), httpmock.StringResponse(`{"data": {}}`))
+}
+
 func TestPRStatus(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
-	http.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.FileResponse("./fixtures/prStatus.json"))
+	registerStatusFixture(t, http, "./fixtures/prStatus.json")
 
 	output, err := runCommand(http, "blueberries", true, "")
 	if err != nil {
@@ -103,7 +137,7 @@ func TestPRStatus(t *testing.T) {
 func TestPRStatus_reviewsAndChecks(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
-	http.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.FileResponse("./fixtures/prStatusChecks.json"))
+	registerStatusFixture(t, http, "./fixtures/prStatusChecks.json")
 
 	output, err := runCommand(http, "blueberries", true, "")
 	if err != nil {
@@ -126,7 +160,7 @@ func TestPRStatus_reviewsAndChecks(t *testing.T) {
 func TestPRStatus_currentBranch_showTheMostRecentPR(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
-	http.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.FileResponse("./fixtures/prStatusCurrentBranch.json"))
+	registerStatusFixture(t, http, "./fixtures/prStatusCurrentBranch.json")
 
 	output, err := runCommand(http, "blueberries", true, "")
 	if err != nil {
@@ -154,7 +188,7 @@ func TestPRStatus_currentBranch_showTheMostRecentPR(t *testing.T) {
 func TestPRStatus_currentBranch_defaultBranch(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
-	http.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.FileResponse("./fixtures/prStatusCurrentBranch.json"))
+	registerStatusFixture(t, http, "./fixtures/prStatusCurrentBranch.json")
 
 	output, err := runCommand(http, "blueberries", true, "")
 	if err != nil {
@@ -171,7 +205,7 @@ func TestPRStatus_currentBranch_defaultBranch(t *testing.T) {
 func TestPRStatus_currentBranch_defaultBranch_repoFlag(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
-	http.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.FileResponse("./fixtures/prStatusCurrentBranchClosedOnDefaultBranch.json"))
+	registerStatusFixture(t, http, "./fixtures/prStatusCurrentBranchClosedOnDefaultBranch.json")
 
 	output, err := runCommand(http, "blueberries", true, "-R OWNER/REPO")
 	if err != nil {
@@ -188,7 +222,7 @@ func TestPRStatus_currentBranch_defaultBranch_repoFlag(t *testing.T) {
 func TestPRStatus_currentBranch_Closed(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
-	http.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.FileResponse("./fixtures/prStatusCurrentBranchClosed.json"))
+	registerStatusFixture(t, http, "./fixtures/prStatusCurrentBranchClosed.json")
 
 	output, err := runCommand(http, "blueberries", true, "")
 	if err != nil {
@@ -205,7 +239,7 @@ func TestPRStatus_currentBranch_Closed(t *testing.T) {
 func TestPRStatus_currentBranch_Closed_defaultBranch(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
-	http.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.FileResponse("./fixtures/prStatusCurrentBranchClosedOnDefaultBranch.json"))
+	registerStatusFixture(t, http, "./fixtures/prStatusCurrentBranchClosedOnDefaultBranch.json")
 
 	output, err := runCommand(http, "blueberries", true, "")
 	if err != nil {
@@ -222,7 +256,7 @@ func TestPRStatus_currentBranch_Closed_defaultBranch(t *testing.T) {
 func TestPRStatus_currentBranch_Merged(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
-	http.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.FileResponse("./fixtures/prStatusCurrentBranchMerged.json"))
+	registerStatusFixture(t, http, "./fixtures/prStatusCurrentBranchMerged.json")
 
 	output, err := runCommand(http, "blueberries", true, "")
 	if err != nil {
@@ -239,7 +273,7 @@ func TestPRStatus_currentBranch_Merged(t *testing.T) {
 func TestPRStatus_currentBranch_Merged_defaultBranch(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
-	http.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.FileResponse("./fixtures/prStatusCurrentBranchMergedOnDefaultBranch.json"))
+	registerStatusFixture(t, http, "./fixtures/prStatusCurrentBranchMergedOnDefaultBranch.json")
 
 	output, err := runCommand(http, "blueberries", true, "")
 	if err != nil {
@@ -256,7 +290,7 @@ func TestPRStatus_currentBranch_Merged_defaultBranch(t *testing.T) {
 func TestPRStatus_blankSlate(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
-	http.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.StringResponse(`{"data": {}}`))
+	registerBlankStatusResponses(http)
 
 	output, err := runCommand(http, "blueberries", true, "")
 	if err != nil {
@@ -284,7 +318,7 @@ Requesting a code review from you
 func TestPRStatus_detachedHead(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
-	http.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.StringResponse(`{"data": {}}`))
+	registerBlankStatusResponses(http)
 
 	output, err := runCommand(http, "", true, "")
 	if err != nil {
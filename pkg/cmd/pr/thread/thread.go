@@ -0,0 +1,103 @@
+package thread
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ThreadOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	ThreadID  string
+	Unresolve bool
+}
+
+func NewCmdThread(f *cmdutil.Factory, runF func(*ThreadOptions) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "thread <command>",
+		Short: i18n.T("Manage pull request review threads"),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(newCmdResolve(f, false, runF))
+	cmd.AddCommand(newCmdResolve(f, true, runF))
+
+	return cmd
+}
+
+func newCmdResolve(f *cmdutil.Factory, unresolve bool, runF func(*ThreadOptions) error) *cobra.Command {
+	opts := &ThreadOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Unresolve:  unresolve,
+	}
+
+	verb, title := "resolve", "Resolve"
+	if unresolve {
+		verb, title = "unresolve", "Unresolve"
+	}
+
+	cmd := &cobra.Command{
+		Use:   verb + " <thread-id>",
+		Short: title + " a review thread",
+		Long: heredoc.Docf(`
+			%s a pull request review thread.
+
+			The thread ID can be obtained from the review threads of a pull request,
+			e.g. via %[2]sgh api%[2]s.
+		`, title, "`"),
+		Args: cmdutil.ExactArgs(1, "cannot "+verb+": thread ID required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.ThreadID = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return threadRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func threadRun(opts *ThreadOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	if err := api.ResolveReviewThread(apiClient, repo.RepoHost(), opts.ThreadID, !opts.Unresolve); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		verb := "Resolved"
+		if opts.Unresolve {
+			verb = "Unresolved"
+		}
+		fmt.Fprintf(opts.IO.ErrOut, "%s %s review thread\n", cs.SuccessIcon(), verb)
+	}
+
+	return nil
+}
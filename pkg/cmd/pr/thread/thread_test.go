@@ -0,0 +1,142 @@
+package thread
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdThread(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		wantThreadID  string
+		wantUnresolve bool
+		wantErr       string
+	}{
+		{
+			name:         "resolve",
+			input:        "resolve PRRT_id1",
+			wantThreadID: "PRRT_id1",
+		},
+		{
+			name:          "unresolve",
+			input:         "unresolve PRRT_id1",
+			wantThreadID:  "PRRT_id1",
+			wantUnresolve: true,
+		},
+		{
+			name:    "resolve without argument",
+			input:   "resolve",
+			wantErr: "cannot resolve: thread ID required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			var gotOpts *ThreadOptions
+			cmd := NewCmdThread(f, func(opts *ThreadOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantThreadID, gotOpts.ThreadID)
+			assert.Equal(t, tt.wantUnresolve, gotOpts.Unresolve)
+		})
+	}
+}
+
+func Test_threadRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		tty        bool
+		unresolve  bool
+		httpStubs  func(*testing.T, *httpmock.Registry)
+		wantStderr string
+	}{
+		{
+			name: "resolve",
+			tty:  true,
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`mutation ResolveReviewThread\b`),
+					httpmock.GraphQLMutation(`{"data": {"resolveReviewThread": {"thread": {"id": "PRRT_id1"}}}}`,
+						func(inputs map[string]interface{}) {
+							assert.Equal(t, "PRRT_id1", inputs["threadId"])
+						}))
+			},
+			wantStderr: "✓ Resolved review thread\n",
+		},
+		{
+			name:      "unresolve",
+			tty:       true,
+			unresolve: true,
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`mutation UnresolveReviewThread\b`),
+					httpmock.GraphQLMutation(`{"data": {"unresolveReviewThread": {"thread": {"id": "PRRT_id1"}}}}`,
+						func(inputs map[string]interface{}) {
+							assert.Equal(t, "PRRT_id1", inputs["threadId"])
+						}))
+			},
+			wantStderr: "✓ Unresolved review thread\n",
+		},
+		{
+			name: "nontty",
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`mutation ResolveReviewThread\b`),
+					httpmock.StringResponse(`{"data": {"resolveReviewThread": {"thread": {"id": "PRRT_id1"}}}}`))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(t, reg)
+			}
+
+			io, _, stdout, stderr := iostreams.Test()
+			io.SetStdoutTTY(tt.tty)
+
+			opts := &ThreadOptions{
+				IO:        io,
+				ThreadID:  "PRRT_id1",
+				Unresolve: tt.unresolve,
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.New("OWNER", "REPO"), nil
+				},
+			}
+
+			err := threadRun(opts)
+			assert.NoError(t, err)
+			assert.Equal(t, "", stdout.String())
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package updatebranch
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/shurcooL/githubv4"
+)
+
+type PullRequestBranchUpdateMethod string
+
+const (
+	PullRequestBranchUpdateMethodMerge  PullRequestBranchUpdateMethod = "MERGE"
+	PullRequestBranchUpdateMethodRebase PullRequestBranchUpdateMethod = "REBASE"
+)
+
+// TODO: drop after githubv4 gets updated
+type UpdatePullRequestBranchInput struct {
+	PullRequestID   githubv4.ID                    `json:"pullRequestId"`
+	ExpectedHeadOid *githubv4.GitObjectID          `json:"expectedHeadOid,omitempty"`
+	UpdateMethod    *PullRequestBranchUpdateMethod `json:"updateMethod,omitempty"`
+}
+
+func updatePullRequestBranch(client *http.Client, repo ghrepo.Interface, prID string, expectedHeadOid string, rebase bool) error {
+	input := UpdatePullRequestBranchInput{
+		PullRequestID: githubv4.ID(prID),
+	}
+	if expectedHeadOid != "" {
+		oid := githubv4.GitObjectID(expectedHeadOid)
+		input.ExpectedHeadOid = &oid
+	}
+	if rebase {
+		m := PullRequestBranchUpdateMethodRebase
+		input.UpdateMethod = &m
+	}
+
+	var mutation struct {
+		UpdatePullRequestBranch struct {
+			ClientMutationId string
+		} `graphql:"updatePullRequestBranch(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), client)
+	return gql.MutateNamed(context.Background(), "PullRequestUpdateBranch", &mutation, variables)
+}
+
+// isRebaseUnsupportedError recognizes the error returned by GitHub hosts whose
+// updatePullRequestBranch mutation predates the updateMethod argument.
+func isRebaseUnsupportedError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "'updateMethod'")
+}
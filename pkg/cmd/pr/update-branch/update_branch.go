@@ -0,0 +1,180 @@
+package updatebranch
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/context"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+type UpdateBranchOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Remotes    func() (context.Remotes, error)
+	Branch     func() (string, error)
+
+	Finder shared.PRFinder
+
+	SelectorArg string
+	Rebase      bool
+}
+
+func NewCmdUpdateBranch(f *cmdutil.Factory, runF func(*UpdateBranchOptions) error) *cobra.Command {
+	opts := &UpdateBranchOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Remotes:    f.Remotes,
+		Branch:     f.Branch,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update-branch [<number> | <url> | <branch>]",
+		Short: i18n.T("Update a pull request branch"),
+		Long: heredoc.Doc(`
+			Update a pull request branch with the latest changes of the base branch.
+
+			Without an argument, the pull request that belongs to the current branch is updated.
+
+			By default the branch is updated with a merge commit. Pass '--rebase' to update it
+			by rebasing on top of the base branch instead; when the GitHub host does not support
+			rebasing through the API, the rebase is performed locally and the branch is
+			force-pushed after confirmation.
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Finder = shared.NewFinder(f)
+
+			if repoOverride, _ := cmd.Flags().GetString("repo"); repoOverride != "" && len(args) == 0 {
+				return cmdutil.FlagErrorf("argument required when using the --repo flag")
+			}
+
+			if len(args) > 0 {
+				opts.SelectorArg = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return updateBranchRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Rebase, "rebase", false, "Update the branch by rebasing on top of the base branch")
+
+	return cmd
+}
+
+func updateBranchRun(opts *UpdateBranchOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	findOptions := shared.FindOptions{
+		Selector: opts.SelectorArg,
+		Fields:   []string{"id", "number", "state", "headRefName", "baseRefName", "lastCommit"},
+	}
+	pr, baseRepo, err := opts.Finder.Find(findOptions)
+	if err != nil {
+		return err
+	}
+
+	if !pr.IsOpen() {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Pull request #%d is %s. Only open pull request branches can be updated\n", cs.FailureIcon(), pr.Number, strings.ToLower(string(pr.State)))
+		return cmdutil.SilentError
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	var expectedHeadOid string
+	if commits := pr.Commits.Nodes; len(commits) > 0 {
+		expectedHeadOid = commits[0].Commit.OID
+	}
+
+	err = updatePullRequestBranch(httpClient, baseRepo, pr.ID, expectedHeadOid, opts.Rebase)
+	if err != nil {
+		if opts.Rebase && isRebaseUnsupportedError(err) {
+			fmt.Fprintf(opts.IO.ErrOut, "%s The GitHub API on %s does not support rebasing a pull request branch; rebasing locally instead\n", cs.WarningIcon(), baseRepo.RepoHost())
+			return updateBranchLocally(opts, pr, baseRepo)
+		}
+		return fmt.Errorf("API call failed: %w", err)
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Pull request #%d branch has been updated\n", cs.SuccessIcon(), pr.Number)
+
+	return nil
+}
+
+// updateBranchLocally rebases the pull request branch on top of the base
+// branch in the local repository and force-pushes the result.
+func updateBranchLocally(opts *UpdateBranchOptions, pr *api.PullRequest, baseRepo ghrepo.Interface) error {
+	cs := opts.IO.ColorScheme()
+
+	currentBranch, err := opts.Branch()
+	if err != nil {
+		return err
+	}
+	if currentBranch != pr.HeadRefName {
+		return fmt.Errorf("pull request branch %q must be checked out to rebase it locally", pr.HeadRefName)
+	}
+
+	remotes, err := opts.Remotes()
+	if err != nil {
+		return err
+	}
+	baseRemote, err := remotes.FindByRepo(baseRepo.RepoOwner(), baseRepo.RepoName())
+	if err != nil {
+		return err
+	}
+
+	if err := gitRun("fetch", baseRemote.Name, pr.BaseRefName); err != nil {
+		return err
+	}
+	if err := gitRun("rebase", fmt.Sprintf("%s/%s", baseRemote.Name, pr.BaseRefName)); err != nil {
+		return err
+	}
+
+	if opts.IO.CanPrompt() {
+		var confirmed bool
+		p := &survey.Confirm{
+			Message: fmt.Sprintf("Rebase complete. Force-push branch %q?", pr.HeadRefName),
+			Default: true,
+		}
+		if err := prompt.SurveyAskOne(p, &confirmed); err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+		if !confirmed {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Branch was rebased locally but not pushed\n", cs.WarningIcon())
+			return cmdutil.CancelError
+		}
+	}
+
+	if err := gitRun("push", "--force-with-lease"); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Pull request #%d branch has been rebased onto %s\n", cs.SuccessIcon(), pr.Number, pr.BaseRefName)
+
+	return nil
+}
+
+func gitRun(args ...string) error {
+	cmd, err := git.GitCommand(args...)
+	if err != nil {
+		return err
+	}
+	return run.PrepareCmd(cmd).Run()
+}
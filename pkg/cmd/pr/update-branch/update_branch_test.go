@@ -0,0 +1,231 @@
+package updatebranch
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/context"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdUpdateBranch(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		isTTY   bool
+		want    UpdateBranchOptions
+		wantErr string
+	}{
+		{
+			name:  "number argument",
+			args:  "123",
+			isTTY: true,
+			want: UpdateBranchOptions{
+				SelectorArg: "123",
+			},
+		},
+		{
+			name:  "rebase flag",
+			args:  "123 --rebase",
+			isTTY: true,
+			want: UpdateBranchOptions{
+				SelectorArg: "123",
+				Rebase:      true,
+			},
+		},
+		{
+			name:    "no argument with --repo override",
+			args:    "-R owner/repo",
+			isTTY:   true,
+			wantErr: "argument required when using the --repo flag",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStderrTTY(tt.isTTY)
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var opts *UpdateBranchOptions
+			cmd := NewCmdUpdateBranch(f, func(o *UpdateBranchOptions) error {
+				opts = o
+				return nil
+			})
+			cmd.PersistentFlags().StringP("repo", "R", "", "")
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want.SelectorArg, opts.SelectorArg)
+			assert.Equal(t, tt.want.Rebase, opts.Rebase)
+		})
+	}
+}
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(isTTY)
+	io.SetStdinTTY(isTTY)
+	io.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		Remotes: func() (context.Remotes, error) {
+			return context.Remotes{
+				{
+					Remote: &git.Remote{Name: "origin"},
+					Repo:   ghrepo.New("OWNER", "REPO"),
+				},
+			}, nil
+		},
+		Branch: func() (string, error) {
+			return "feature", nil
+		},
+	}
+
+	cmd := NewCmdUpdateBranch(factory, nil)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func stubPR() *api.PullRequest {
+	pr := &api.PullRequest{
+		ID:          "THE-ID",
+		Number:      123,
+		State:       "OPEN",
+		HeadRefName: "feature",
+		BaseRefName: "master",
+	}
+	pr.Commits.Nodes = []api.PullRequestCommit{
+		{Commit: api.PullRequestCommitCommit{OID: "deadbeef"}},
+	}
+	return pr
+}
+
+func TestPRUpdateBranch(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	shared.RunCommandFinder("123", stubPR(), ghrepo.New("OWNER", "REPO"))
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestUpdateBranch\b`),
+		httpmock.GraphQLMutation(`{"data":{}}`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "THE-ID", inputs["pullRequestId"])
+				assert.Equal(t, "deadbeef", inputs["expectedHeadOid"])
+				assert.NotContains(t, inputs, "updateMethod")
+			}),
+	)
+
+	output, err := runCommand(http, true, "123")
+	assert.NoError(t, err)
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "✓ Pull request #123 branch has been updated\n", output.Stderr())
+}
+
+func TestPRUpdateBranch_rebase(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	shared.RunCommandFinder("123", stubPR(), ghrepo.New("OWNER", "REPO"))
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestUpdateBranch\b`),
+		httpmock.GraphQLMutation(`{"data":{}}`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "THE-ID", inputs["pullRequestId"])
+				assert.Equal(t, "REBASE", inputs["updateMethod"])
+			}),
+	)
+
+	output, err := runCommand(http, true, "123 --rebase")
+	assert.NoError(t, err)
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "✓ Pull request #123 branch has been updated\n", output.Stderr())
+}
+
+func TestPRUpdateBranch_rebaseLocalFallback(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	shared.RunCommandFinder("123", stubPR(), ghrepo.New("OWNER", "REPO"))
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestUpdateBranch\b`),
+		httpmock.StringResponse(`{"errors":[{"message":"InputObject 'UpdatePullRequestBranchInput' doesn't accept argument 'updateMethod'"}]}`),
+	)
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+	cs.Register(`git fetch origin master`, 0, "")
+	cs.Register(`git rebase origin/master`, 0, "")
+	cs.Register(`git push --force-with-lease`, 0, "")
+
+	output, err := runCommand(http, false, "123 --rebase")
+	assert.NoError(t, err)
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "! The GitHub API on github.com does not support rebasing a pull request branch; rebasing locally instead\n✓ Pull request #123 branch has been rebased onto master\n", output.Stderr())
+}
+
+func TestPRUpdateBranch_closed(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	pr := stubPR()
+	pr.State = "CLOSED"
+	shared.RunCommandFinder("123", pr, ghrepo.New("OWNER", "REPO"))
+
+	output, err := runCommand(http, true, "123")
+	assert.EqualError(t, err, "SilentError")
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "X Pull request #123 is closed. Only open pull request branches can be updated\n", output.Stderr())
+}
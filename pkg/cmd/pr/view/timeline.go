@@ -0,0 +1,250 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+)
+
+// timelineEventTypes maps the user-facing event type names accepted by `--event-type`
+// to the itemTypes of the timelineItems GraphQL connection.
+var timelineEventTypes = map[string][]string{
+	"commit":     {"PULL_REQUEST_COMMIT"},
+	"review":     {"PULL_REQUEST_REVIEW"},
+	"comment":    {"ISSUE_COMMENT"},
+	"label":      {"LABELED_EVENT", "UNLABELED_EVENT"},
+	"assignee":   {"ASSIGNED_EVENT", "UNASSIGNED_EVENT"},
+	"force-push": {"HEAD_REF_FORCE_PUSHED_EVENT"},
+}
+
+func timelineItemTypes(eventTypes []string) ([]string, error) {
+	if len(eventTypes) == 0 {
+		for eventType := range timelineEventTypes {
+			eventTypes = append(eventTypes, eventType)
+		}
+		sort.Strings(eventTypes)
+	}
+
+	var itemTypes []string
+	for _, eventType := range eventTypes {
+		types, ok := timelineEventTypes[strings.ToLower(eventType)]
+		if !ok {
+			return nil, fmt.Errorf("invalid event type %q: must be one of commit, review, comment, label, assignee, force-push", eventType)
+		}
+		itemTypes = append(itemTypes, types...)
+	}
+	return itemTypes, nil
+}
+
+type timelineItem struct {
+	Typename string `json:"__typename"`
+
+	// PullRequestCommit
+	Commit struct {
+		AbbreviatedOid  string
+		MessageHeadline string
+		CommittedDate   time.Time
+		Author          struct {
+			Name string
+			User struct {
+				Login string
+			}
+		}
+	}
+
+	// PullRequestReview, IssueComment
+	Author struct {
+		Login string
+	}
+	State       string
+	Body        string
+	SubmittedAt time.Time
+
+	// events
+	Actor struct {
+		Login string
+	}
+	CreatedAt time.Time
+	Label     struct {
+		Name string
+	}
+	Assignee struct {
+		Login string
+	}
+	BeforeCommit struct {
+		AbbreviatedOid string
+	}
+	AfterCommit struct {
+		AbbreviatedOid string
+	}
+}
+
+func fetchTimeline(httpClient *http.Client, repo ghrepo.Interface, prNumber int, itemTypes []string) ([]timelineItem, error) {
+	type response struct {
+		Repository struct {
+			PullRequest struct {
+				TimelineItems struct {
+					Nodes    []timelineItem
+					PageInfo struct {
+						HasNextPage bool
+						EndCursor   string
+					}
+				}
+			}
+		}
+	}
+
+	query := `
+	query PullRequestTimeline($owner: String!, $repo: String!, $number: Int!, $itemTypes: [PullRequestTimelineItemsItemType!], $endCursor: String) {
+		repository(owner: $owner, name: $repo) {
+			pullRequest(number: $number) {
+				timelineItems(first: 100, after: $endCursor, itemTypes: $itemTypes) {
+					nodes {
+						__typename
+						...on PullRequestCommit {
+							commit {
+								abbreviatedOid
+								messageHeadline
+								committedDate
+								author { name, user { login } }
+							}
+						}
+						...on PullRequestReview { author { login }, state, body, submittedAt }
+						...on IssueComment { author { login }, body, createdAt }
+						...on LabeledEvent { actor { login }, label { name }, createdAt }
+						...on UnlabeledEvent { actor { login }, label { name }, createdAt }
+						...on AssignedEvent { actor { login }, assignee { ...on User { login } }, createdAt }
+						...on UnassignedEvent { actor { login }, assignee { ...on User { login } }, createdAt }
+						...on HeadRefForcePushedEvent {
+							actor { login }
+							beforeCommit { abbreviatedOid }
+							afterCommit { abbreviatedOid }
+							createdAt
+						}
+					}
+					pageInfo { hasNextPage, endCursor }
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner":     repo.RepoOwner(),
+		"repo":      repo.RepoName(),
+		"number":    prNumber,
+		"itemTypes": itemTypes,
+	}
+
+	client := api.NewClientFromHTTP(httpClient)
+
+	var items []timelineItem
+	for {
+		var resp response
+		err := client.GraphQL(repo.RepoHost(), query, variables, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		timeline := resp.Repository.PullRequest.TimelineItems
+		items = append(items, timeline.Nodes...)
+
+		if !timeline.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = timeline.PageInfo.EndCursor
+	}
+
+	return items, nil
+}
+
+func (i timelineItem) occurredAt() time.Time {
+	switch i.Typename {
+	case "PullRequestCommit":
+		return i.Commit.CommittedDate
+	case "PullRequestReview":
+		return i.SubmittedAt
+	default:
+		return i.CreatedAt
+	}
+}
+
+func printTimeline(opts *ViewOptions, pr *api.PullRequest, items []timelineItem) error {
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+	isTTY := opts.IO.IsStdoutTTY()
+
+	if isTTY {
+		fmt.Fprintf(out, "%s #%d\n", cs.Bold(pr.Title), pr.Number)
+	}
+
+	for _, item := range items {
+		var when string
+		if isTTY {
+			when = cs.Gray(fmt.Sprintf("(%s)", utils.FuzzyAgo(time.Since(item.occurredAt()))))
+		} else {
+			when = item.occurredAt().Format(time.RFC3339)
+		}
+
+		line := timelineItemSummary(item, cs)
+		if line == "" {
+			continue
+		}
+		if isTTY {
+			fmt.Fprintf(out, "%s %s\n", line, when)
+		} else {
+			fmt.Fprintf(out, "%s\t%s\n", when, line)
+		}
+	}
+
+	return nil
+}
+
+func timelineItemSummary(item timelineItem, cs *iostreams.ColorScheme) string {
+	switch item.Typename {
+	case "PullRequestCommit":
+		author := item.Commit.Author.User.Login
+		if author == "" {
+			author = item.Commit.Author.Name
+		}
+		return fmt.Sprintf("%s committed %s %q", author, cs.Cyan(item.Commit.AbbreviatedOid), item.Commit.MessageHeadline)
+	case "PullRequestReview":
+		var verb string
+		switch item.State {
+		case approvedReviewState:
+			verb = cs.Green("approved")
+		case changesRequestedReviewState:
+			verb = cs.Red("requested changes")
+		default:
+			verb = "reviewed"
+		}
+		return fmt.Sprintf("%s %s", item.Author.Login, verb)
+	case "IssueComment":
+		return fmt.Sprintf("%s commented: %s", item.Author.Login, firstLine(item.Body))
+	case "LabeledEvent":
+		return fmt.Sprintf("%s added the %q label", item.Actor.Login, item.Label.Name)
+	case "UnlabeledEvent":
+		return fmt.Sprintf("%s removed the %q label", item.Actor.Login, item.Label.Name)
+	case "AssignedEvent":
+		return fmt.Sprintf("%s assigned %s", item.Actor.Login, item.Assignee.Login)
+	case "UnassignedEvent":
+		return fmt.Sprintf("%s unassigned %s", item.Actor.Login, item.Assignee.Login)
+	case "HeadRefForcePushedEvent":
+		return fmt.Sprintf("%s force-pushed from %s to %s", item.Actor.Login,
+			cs.Cyan(item.BeforeCommit.AbbreviatedOid), cs.Cyan(item.AfterCommit.AbbreviatedOid))
+	}
+	return ""
+}
+
+func firstLine(s string) string {
+	if idx := strings.IndexRune(s, '\n'); idx >= 0 {
+		s = s[:idx]
+	}
+	return s
+}
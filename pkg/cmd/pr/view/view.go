@@ -2,12 +2,15 @@ package view
 
 import (
 	"fmt"
+	"net/http"
 	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -21,26 +24,31 @@ type browser interface {
 }
 
 type ViewOptions struct {
-	IO      *iostreams.IOStreams
-	Browser browser
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	Browser    browser
 
 	Finder   shared.PRFinder
 	Exporter cmdutil.Exporter
 
 	SelectorArg string
 	BrowserMode bool
+	BrowserTab  string
 	Comments    bool
+	Timeline    bool
+	EventTypes  []string
 }
 
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
 	opts := &ViewOptions{
-		IO:      f.IOStreams,
-		Browser: f.Browser,
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Browser:    f.Browser,
 	}
 
 	cmd := &cobra.Command{
 		Use:   "view [<number> | <url> | <branch>]",
-		Short: "View a pull request",
+		Short: i18n.T("View a pull request"),
 		Long: heredoc.Doc(`
 			Display the title, body, and other information about a pull request.
 
@@ -61,6 +69,29 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 				opts.SelectorArg = args[0]
 			}
 
+			if opts.BrowserTab != "" {
+				if !opts.BrowserMode {
+					return cmdutil.FlagErrorf("the `--tab` flag is only supported with `--web`")
+				}
+				if !shared.ValidBrowserTab(opts.BrowserTab) {
+					return cmdutil.FlagErrorf("invalid value for --tab: %q", opts.BrowserTab)
+				}
+			}
+
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--timeline` or `--comments`",
+				opts.Timeline,
+				opts.Comments,
+			); err != nil {
+				return err
+			}
+			if len(opts.EventTypes) > 0 && !opts.Timeline {
+				return cmdutil.FlagErrorf("the `--event-type` flag is only supported with `--timeline`")
+			}
+			if _, err := timelineItemTypes(opts.EventTypes); err != nil {
+				return cmdutil.FlagErrorWrap(err)
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -69,7 +100,10 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().BoolVarP(&opts.BrowserMode, "web", "w", false, "Open a pull request in the browser")
+	cmd.Flags().StringVar(&opts.BrowserTab, "tab", "", "Open a specific tab of the pull request page: {conversation|files|checks|commits}")
 	cmd.Flags().BoolVarP(&opts.Comments, "comments", "c", false, "View pull request comments")
+	cmd.Flags().BoolVar(&opts.Timeline, "timeline", false, "View the chronological feed of pull request activity")
+	cmd.Flags().StringSliceVar(&opts.EventTypes, "event-type", nil, "Filter timeline events by type: {commit|review|comment|label|assignee|force-push}")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.PullRequestFields)
 
 	return cmd
@@ -80,7 +114,9 @@ var defaultFields = []string{
 	"isDraft", "maintainerCanModify", "mergeable", "additions", "deletions", "commitsCount",
 	"baseRefName", "headRefName", "headRepositoryOwner", "headRepository", "isCrossRepository",
 	"reviewRequests", "reviews", "assignees", "labels", "projectCards", "milestone",
-	"comments", "reactionGroups",
+	"comments", "reactionGroups", "closingIssuesReferences",
+	"mergeStateStatus", "reviewDecision", "branchProtectionRule",
+	"statusCheckRollup",
 }
 
 func viewRun(opts *ViewOptions) error {
@@ -92,8 +128,10 @@ func viewRun(opts *ViewOptions) error {
 		findOptions.Fields = []string{"url"}
 	} else if opts.Exporter != nil {
 		findOptions.Fields = opts.Exporter.Fields()
+	} else if opts.Timeline {
+		findOptions.Fields = []string{"number", "title", "url"}
 	}
-	pr, _, err := opts.Finder.Find(findOptions)
+	pr, baseRepo, err := opts.Finder.Find(findOptions)
 	if err != nil {
 		return err
 	}
@@ -101,7 +139,7 @@ func viewRun(opts *ViewOptions) error {
 	connectedToTerminal := opts.IO.IsStdoutTTY() && opts.IO.IsStderrTTY()
 
 	if opts.BrowserMode {
-		openURL := pr.URL
+		openURL := shared.BrowserURL(pr.URL, opts.BrowserTab, "")
 		if connectedToTerminal {
 			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(openURL))
 		}
@@ -120,12 +158,28 @@ func viewRun(opts *ViewOptions) error {
 		return opts.Exporter.Write(opts.IO, pr)
 	}
 
+	if opts.Timeline {
+		httpClient, err := opts.HttpClient()
+		if err != nil {
+			return err
+		}
+		itemTypes, err := timelineItemTypes(opts.EventTypes)
+		if err != nil {
+			return err
+		}
+		items, err := fetchTimeline(httpClient, baseRepo, pr.Number, itemTypes)
+		if err != nil {
+			return err
+		}
+		return printTimeline(opts, pr, items)
+	}
+
 	if connectedToTerminal {
-		return printHumanPrPreview(opts, pr)
+		return printHumanPrPreview(opts, baseRepo, pr)
 	}
 
 	if opts.Comments {
-		fmt.Fprint(opts.IO.Out, shared.RawCommentList(pr.Comments, pr.DisplayableReviews()))
+		fmt.Fprint(opts.IO.Out, shared.RawCommentList(pr.Comments, pr.DisplayableReviews(), "", 0))
 		return nil
 	}
 
@@ -164,7 +218,7 @@ func printRawPrPreview(io *iostreams.IOStreams, pr *api.PullRequest) error {
 	return nil
 }
 
-func printHumanPrPreview(opts *ViewOptions, pr *api.PullRequest) error {
+func printHumanPrPreview(opts *ViewOptions, baseRepo ghrepo.Interface, pr *api.PullRequest) error {
 	out := opts.IO.Out
 	cs := opts.IO.ColorScheme()
 
@@ -208,6 +262,28 @@ func printHumanPrPreview(opts *ViewOptions, pr *api.PullRequest) error {
 		fmt.Fprint(out, cs.Bold("Milestone: "))
 		fmt.Fprintln(out, pr.Milestone.Title)
 	}
+	if linked := prClosingIssuesList(*pr); linked != "" {
+		fmt.Fprint(out, cs.Bold("Closes: "))
+		fmt.Fprintln(out, linked)
+	}
+	if checks := prChecksSummary(*pr, cs); checks != "" {
+		fmt.Fprint(out, cs.Bold("Checks: "))
+		fmt.Fprintln(out, checks)
+	}
+
+	// Merge requirements
+	if requirements := prMergeRequirements(*pr, cs); len(requirements) > 0 {
+		fmt.Fprintln(out, cs.Bold("Merge requirements:"))
+		for _, requirement := range requirements {
+			fmt.Fprintln(out, requirement)
+		}
+	}
+
+	// Failing checks
+	if failing := prFailingChecks(*pr); len(failing) > 0 {
+		fmt.Fprintln(out, cs.Bold("Failing checks:"))
+		printFailingChecks(opts, baseRepo, failing)
+	}
 
 	// Body
 	var md string
@@ -225,7 +301,7 @@ func printHumanPrPreview(opts *ViewOptions, pr *api.PullRequest) error {
 	// Reviews and Comments
 	if pr.Comments.TotalCount > 0 || pr.Reviews.TotalCount > 0 {
 		preview := !opts.Comments
-		comments, err := shared.CommentList(opts.IO, pr.Comments, pr.DisplayableReviews(), preview)
+		comments, err := shared.CommentList(opts.IO, pr.Comments, pr.DisplayableReviews(), preview, "", 0)
 		if err != nil {
 			return err
 		}
@@ -238,6 +314,179 @@ func printHumanPrPreview(opts *ViewOptions, pr *api.PullRequest) error {
 	return nil
 }
 
+// limits for the "Failing checks" section to keep the preview focused
+const (
+	maxFailingChecks    = 5
+	maxCheckAnnotations = 3
+)
+
+// prChecksSummary tallies the checks reported on the head commit of a pull request
+func prChecksSummary(pr api.PullRequest, cs *iostreams.ColorScheme) string {
+	checks := pr.ChecksStatus()
+	if checks.Total == 0 {
+		return ""
+	}
+
+	var parts []string
+	if checks.Failing > 0 {
+		parts = append(parts, cs.Red(fmt.Sprintf("%d failing", checks.Failing)))
+	}
+	if checks.Pending > 0 {
+		parts = append(parts, cs.Yellow(fmt.Sprintf("%d pending", checks.Pending)))
+	}
+	if checks.Passing > 0 {
+		parts = append(parts, cs.Green(fmt.Sprintf("%d successful", checks.Passing)))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// prFailingChecks filters the checks of the head commit down to the ones that failed
+func prFailingChecks(pr api.PullRequest) []api.CheckContext {
+	if len(pr.StatusCheckRollup.Nodes) == 0 {
+		return nil
+	}
+
+	var failing []api.CheckContext
+	for _, c := range pr.StatusCheckRollup.Nodes[0].Commit.StatusCheckRollup.Contexts.Nodes {
+		state := c.State // StatusContext
+		if state == "" {
+			// CheckRun
+			if c.Status == "COMPLETED" {
+				state = c.Conclusion
+			} else {
+				state = c.Status
+			}
+		}
+		switch state {
+		case "ERROR", "FAILURE", "CANCELLED", "TIMED_OUT", "ACTION_REQUIRED":
+			failing = append(failing, c)
+		}
+	}
+	return failing
+}
+
+func printFailingChecks(opts *ViewOptions, baseRepo ghrepo.Interface, failing []api.CheckContext) {
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	for i, c := range failing {
+		if i == maxFailingChecks {
+			fmt.Fprintln(out, cs.Gray(fmt.Sprintf("… and %d more", len(failing)-maxFailingChecks)))
+			break
+		}
+		name := c.Name
+		if name == "" {
+			name = c.Context
+		}
+		fmt.Fprintf(out, "%s %s\n", cs.Red("X"), name)
+		for _, annotation := range failedCheckAnnotations(opts, baseRepo, c) {
+			fmt.Fprintln(out, annotation)
+		}
+	}
+}
+
+// failedCheckAnnotations looks up the first few failure annotations of a failed check run.
+// The lookup is best-effort: annotations may not be accessible, e.g. for checks reported
+// from a fork, and their absence should not fail rendering the pull request.
+func failedCheckAnnotations(opts *ViewOptions, baseRepo ghrepo.Interface, c api.CheckContext) []string {
+	if c.DatabaseID == 0 || opts.HttpClient == nil {
+		return nil
+	}
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return nil
+	}
+	annotations, err := checkRunAnnotations(httpClient, baseRepo, c.DatabaseID)
+	if err != nil {
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	var lines []string
+	for _, a := range annotations {
+		if a.AnnotationLevel != "" && a.AnnotationLevel != "failure" {
+			continue
+		}
+		if len(lines) == maxCheckAnnotations {
+			break
+		}
+		message := a.Message
+		if idx := strings.IndexRune(message, '\n'); idx >= 0 {
+			message = message[:idx]
+		}
+		location := a.Path
+		if a.StartLine > 0 {
+			location = fmt.Sprintf("%s:%d", a.Path, a.StartLine)
+		}
+		lines = append(lines, fmt.Sprintf("  %s: %s", cs.Gray(location), message))
+	}
+	return lines
+}
+
+type checkAnnotation struct {
+	Path            string `json:"path"`
+	StartLine       int    `json:"start_line"`
+	AnnotationLevel string `json:"annotation_level"`
+	Message         string `json:"message"`
+}
+
+func checkRunAnnotations(httpClient *http.Client, repo ghrepo.Interface, checkRunID int64) ([]checkAnnotation, error) {
+	path := fmt.Sprintf("repos/%s/%s/check-runs/%d/annotations", repo.RepoOwner(), repo.RepoName(), checkRunID)
+	client := api.NewClientFromHTTP(httpClient)
+	var annotations []checkAnnotation
+	err := client.REST(repo.RepoHost(), "GET", path, nil, &annotations)
+	return annotations, err
+}
+
+// prMergeRequirements summarizes the merge state of an open pull request and
+// the protection rules of its base branch that gate the merge button.
+func prMergeRequirements(pr api.PullRequest, cs *iostreams.ColorScheme) []string {
+	if pr.State != "OPEN" {
+		return nil
+	}
+
+	var requirements []string
+
+	switch pr.MergeStateStatus {
+	case "DIRTY":
+		requirements = append(requirements, cs.Red("× Merge conflicts must be resolved"))
+	case "BEHIND":
+		requirements = append(requirements, cs.Yellow("- The head branch is not up to date with the base branch"))
+	}
+
+	rule := pr.BaseRef.BranchProtectionRule
+
+	if rule.RequiredApprovingReviewCount > 0 {
+		line := fmt.Sprintf("Requires %s", utils.Pluralize(rule.RequiredApprovingReviewCount, "approving review"))
+		if pr.ReviewDecision == "APPROVED" {
+			requirements = append(requirements, cs.Green("✓ "+line))
+		} else {
+			requirements = append(requirements, cs.Yellow("- "+line))
+		}
+	}
+	if rule.RequiresCodeOwnerReviews {
+		line := "Requires review from code owners"
+		if pr.ReviewDecision == "APPROVED" {
+			requirements = append(requirements, cs.Green("✓ "+line))
+		} else {
+			requirements = append(requirements, cs.Yellow("- "+line))
+		}
+	}
+	if len(rule.RequiredStatusCheckContexts) > 0 {
+		requirements = append(requirements, fmt.Sprintf("Required status checks: %s", strings.Join(rule.RequiredStatusCheckContexts, ", ")))
+	}
+	if rule.RequiresStrictStatusChecks {
+		switch pr.MergeStateStatus {
+		case "BEHIND", "UNKNOWN", "DIRTY":
+			// already reported above, or the merge state is indeterminate
+		default:
+			requirements = append(requirements, cs.Green("✓ The head branch is up to date with the base branch"))
+		}
+	}
+
+	return requirements
+}
+
 const (
 	requestedReviewState        = "REQUESTED" // This is our own state for review request
 	approvedReviewState         = "APPROVED"
@@ -248,8 +497,9 @@ const (
 )
 
 type reviewerState struct {
-	Name  string
-	State string
+	Name        string
+	State       string
+	AsCodeOwner bool
 }
 
 // formattedReviewerState formats a reviewerState with state color
@@ -273,7 +523,11 @@ func formattedReviewerState(cs *iostreams.ColorScheme, reviewer *reviewerState)
 		colorFunc = func(str string) string { return str } // Do nothing
 	}
 
-	return fmt.Sprintf("%s (%s)", reviewer.Name, colorFunc(strings.ReplaceAll(strings.Title(strings.ToLower(state)), "_", " ")))
+	stateText := colorFunc(strings.ReplaceAll(strings.Title(strings.ToLower(state)), "_", " "))
+	if reviewer.State == requestedReviewState && reviewer.AsCodeOwner {
+		return fmt.Sprintf("%s (%s via code owners)", reviewer.Name, stateText)
+	}
+	return fmt.Sprintf("%s (%s)", reviewer.Name, stateText)
 }
 
 // prReviewerList generates a reviewer list with their last state
@@ -315,8 +569,9 @@ func parseReviewers(pr api.PullRequest) []*reviewerState {
 	for _, reviewRequest := range pr.ReviewRequests.Nodes {
 		name := reviewRequest.RequestedReviewer.LoginOrSlug()
 		reviewerStates[name] = &reviewerState{
-			Name:  name,
-			State: requestedReviewState,
+			Name:        name,
+			State:       requestedReviewState,
+			AsCodeOwner: reviewRequest.AsCodeOwner,
 		}
 	}
 
@@ -382,6 +637,23 @@ func prLabelList(pr api.PullRequest, cs *iostreams.ColorScheme) string {
 	return list
 }
 
+func prClosingIssuesList(pr api.PullRequest) string {
+	if len(pr.ClosingIssuesReferences.Nodes) == 0 {
+		return ""
+	}
+
+	refs := make([]string, 0, len(pr.ClosingIssuesReferences.Nodes))
+	for _, issue := range pr.ClosingIssuesReferences.Nodes {
+		refs = append(refs, fmt.Sprintf("%s#%d", issue.Repository.NameWithOwner, issue.Number))
+	}
+
+	list := strings.Join(refs, ", ")
+	if pr.ClosingIssuesReferences.TotalCount > len(pr.ClosingIssuesReferences.Nodes) {
+		list += ", …"
+	}
+	return list
+}
+
 func prProjectList(pr api.PullRequest) string {
 	if len(pr.ProjectCards.Nodes) == 0 {
 		return ""
@@ -408,5 +680,5 @@ func prStateWithDraft(pr *api.PullRequest) string {
 		return "DRAFT"
 	}
 
-	return pr.State
+	return string(pr.State)
 }
@@ -57,6 +57,18 @@ func Test_NewCmdView(t *testing.T) {
 				BrowserMode: true,
 			},
 		},
+		{
+			name:    "tab without web",
+			args:    "123 --tab files",
+			isTTY:   true,
+			wantErr: "the `--tab` flag is only supported with `--web`",
+		},
+		{
+			name:    "invalid tab",
+			args:    "123 -w --tab reviews",
+			isTTY:   true,
+			wantErr: `invalid value for --tab: "reviews"`,
+		},
 		{
 			name:    "no argument with --repo override",
 			args:    "-R owner/repo",
@@ -72,6 +84,33 @@ func Test_NewCmdView(t *testing.T) {
 				Comments:    true,
 			},
 		},
+		{
+			name:  "timeline",
+			args:  "123 --timeline",
+			isTTY: true,
+			want: ViewOptions{
+				SelectorArg: "123",
+				Timeline:    true,
+			},
+		},
+		{
+			name:    "timeline and comments",
+			args:    "123 --timeline --comments",
+			isTTY:   true,
+			wantErr: "specify only one of `--timeline` or `--comments`",
+		},
+		{
+			name:    "event type without timeline",
+			args:    "123 --event-type comment",
+			isTTY:   true,
+			wantErr: "the `--event-type` flag is only supported with `--timeline`",
+		},
+		{
+			name:    "invalid event type",
+			args:    "123 --timeline --event-type party",
+			isTTY:   true,
+			wantErr: `invalid event type "party": must be one of commit, review, comment, label, assignee, force-push`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -108,6 +147,7 @@ func Test_NewCmdView(t *testing.T) {
 			}
 
 			assert.Equal(t, tt.want.SelectorArg, opts.SelectorArg)
+			assert.Equal(t, tt.want.Timeline, opts.Timeline)
 		})
 	}
 }
@@ -122,6 +162,9 @@ func runCommand(rt http.RoundTripper, branch string, isTTY bool, cli string) (*t
 	factory := &cmdutil.Factory{
 		IOStreams: io,
 		Browser:   browser,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
 	}
 
 	cmd := NewCmdView(factory, nil)
@@ -169,7 +212,7 @@ func prFromFixtures(fixtures map[string]string) (*api.PullRequest, error) {
 	for name := range fixtures {
 		switch name {
 		case "PullRequestByNumber":
-		case "ReviewsForPullRequest", "CommentsForPullRequest":
+		case "ReviewsForPullRequest", "CommentsForSubject":
 			ff, err := os.Open(fixtures[name])
 			if err != nil {
 				return nil, err
@@ -250,7 +293,7 @@ func TestPRView_Preview_nontty(t *testing.T) {
 				`milestone:\t\n`,
 				`additions:\t100\n`,
 				`deletions:\t10\n`,
-				`reviewers:\tDEF \(Commented\), def \(Changes requested\), ghost \(Approved\), hubot \(Commented\), xyz \(Approved\), 123 \(Requested\), abc \(Requested\), my-org\/team-1 \(Requested\)\n`,
+				`reviewers:\tDEF \(Commented\), def \(Changes requested\), ghost \(Approved\), hubot \(Commented\), xyz \(Approved\), 123 \(Requested\), abc \(Requested\), my-org\/team-1 \(Requested via code owners\)\n`,
 				`\*\*blueberries taste good\*\*`,
 			},
 		},
@@ -374,6 +417,22 @@ func TestPRView_Preview(t *testing.T) {
 				`View this pull request on GitHub: https://github.com/OWNER/REPO/pull/12`,
 			},
 		},
+		"Open PR with merge requirements": {
+			branch: "master",
+			args:   "12",
+			fixtures: map[string]string{
+				"PullRequestByNumber": "./fixtures/prViewPreviewBlockedState.json",
+			},
+			expectedOutputs: []string{
+				`Blueberries are from a fork #12`,
+				`Merge requirements:`,
+				`- Requires 2 approving reviews\n`,
+				`- Requires review from code owners\n`,
+				`Required status checks: build, tests\n`,
+				`✓ The head branch is up to date with the base branch\n`,
+				`blueberries taste good`,
+			},
+		},
 		"Open PR with reviewers by number": {
 			branch: "master",
 			args:   "12",
@@ -383,7 +442,7 @@ func TestPRView_Preview(t *testing.T) {
 			},
 			expectedOutputs: []string{
 				`Blueberries are from a fork #12`,
-				`Reviewers: DEF \(Commented\), def \(Changes requested\), ghost \(Approved\), hubot \(Commented\), xyz \(Approved\), 123 \(Requested\), abc \(Requested\), my-org\/team-1 \(Requested\)`,
+				`Reviewers: DEF \(Commented\), def \(Changes requested\), ghost \(Approved\), hubot \(Commented\), xyz \(Approved\), 123 \(Requested\), abc \(Requested\), my-org\/team-1 \(Requested via code owners\)`,
 				`blueberries taste good`,
 				`View this pull request on GitHub: https://github.com/OWNER/REPO/pull/12`,
 			},
@@ -451,6 +510,148 @@ func TestPRView_Preview(t *testing.T) {
 	}
 }
 
+func TestPRView_Preview_failingChecks(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/check-runs/12345/annotations"),
+		httpmock.StringResponse(`[
+			{
+				"path": "cmd/main.go",
+				"start_line": 42,
+				"annotation_level": "failure",
+				"message": "undefined: fruit\nmore context"
+			},
+			{
+				"path": "cmd/main.go",
+				"start_line": 10,
+				"annotation_level": "warning",
+				"message": "unused variable"
+			}
+		]`))
+
+	pr, err := prFromFixtures(map[string]string{
+		"PullRequestByNumber": "./fixtures/prViewPreviewFailingChecks.json",
+	})
+	require.NoError(t, err)
+	shared.RunCommandFinder("12", pr, ghrepo.New("OWNER", "REPO"))
+
+	output, err := runCommand(reg, "master", true, "12")
+	require.NoError(t, err)
+
+	assert.Equal(t, "", output.Stderr())
+
+	//nolint:staticcheck // prefer exact matchers over ExpectLines
+	test.ExpectLines(t, output.String(),
+		`Blueberries are from a fork #12`,
+		`Checks: 1 failing, 1 pending, 1 successful`,
+		`Failing checks:`,
+		`X build`,
+		`cmd/main.go:42: undefined: fruit`,
+		`blueberries taste good`)
+}
+
+func TestPRView_timeline(t *testing.T) {
+	timelineResponse := `{"data": {"repository": {"pullRequest": {"timelineItems": {
+		"nodes": [
+			{
+				"__typename": "PullRequestCommit",
+				"commit": {
+					"abbreviatedOid": "1a2b3c4",
+					"messageHeadline": "Add blueberries",
+					"committedDate": "2022-01-01T10:00:00Z",
+					"author": {"name": "Octo Cat", "user": {"login": "octocat"}}
+				}
+			},
+			{
+				"__typename": "LabeledEvent",
+				"actor": {"login": "hubot"},
+				"label": {"name": "bug"},
+				"createdAt": "2022-01-01T11:00:00Z"
+			},
+			{
+				"__typename": "IssueComment",
+				"author": {"login": "hubot"},
+				"body": "looks tasty\nbut fix the tests",
+				"createdAt": "2022-01-01T12:00:00Z"
+			},
+			{
+				"__typename": "HeadRefForcePushedEvent",
+				"actor": {"login": "octocat"},
+				"beforeCommit": {"abbreviatedOid": "1a2b3c4"},
+				"afterCommit": {"abbreviatedOid": "5d6e7f8"},
+				"createdAt": "2022-01-01T13:00:00Z"
+			},
+			{
+				"__typename": "PullRequestReview",
+				"author": {"login": "hubot"},
+				"state": "APPROVED",
+				"body": "",
+				"submittedAt": "2022-01-01T14:00:00Z"
+			}
+		],
+		"pageInfo": {"hasNextPage": false, "endCursor": ""}
+	}}}}}`
+
+	tests := map[string]struct {
+		args          string
+		wantItemTypes []interface{}
+		wantLines     []string
+	}{
+		"all events": {
+			args: "12 --timeline",
+			wantItemTypes: []interface{}{
+				"ASSIGNED_EVENT", "UNASSIGNED_EVENT",
+				"ISSUE_COMMENT",
+				"PULL_REQUEST_COMMIT",
+				"HEAD_REF_FORCE_PUSHED_EVENT",
+				"LABELED_EVENT", "UNLABELED_EVENT",
+				"PULL_REQUEST_REVIEW",
+			},
+			wantLines: []string{
+				`2022-01-01T10:00:00Z\toctocat committed 1a2b3c4 "Add blueberries"`,
+				`2022-01-01T11:00:00Z\thubot added the "bug" label`,
+				`2022-01-01T12:00:00Z\thubot commented: looks tasty`,
+				`2022-01-01T13:00:00Z\toctocat force-pushed from 1a2b3c4 to 5d6e7f8`,
+				`2022-01-01T14:00:00Z\thubot approved`,
+			},
+		},
+		"filtered events": {
+			args:          "12 --timeline --event-type comment,force-push",
+			wantItemTypes: []interface{}{"ISSUE_COMMENT", "HEAD_REF_FORCE_PUSHED_EVENT"},
+			wantLines: []string{
+				`2022-01-01T12:00:00Z\thubot commented: looks tasty`,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			reg.Register(
+				httpmock.GraphQL(`query PullRequestTimeline\b`),
+				httpmock.GraphQLQuery(timelineResponse,
+					func(_ string, variables map[string]interface{}) {
+						assert.Equal(t, tt.wantItemTypes, variables["itemTypes"])
+					}))
+
+			shared.RunCommandFinder("12", &api.PullRequest{
+				Number: 12,
+				Title:  "Blueberries are a good fruit",
+				URL:    "https://github.com/OWNER/REPO/pull/12",
+			}, ghrepo.New("OWNER", "REPO"))
+
+			output, err := runCommand(reg, "master", false, tt.args)
+			require.NoError(t, err)
+
+			assert.Equal(t, "", output.Stderr())
+			//nolint:staticcheck // prefer exact matchers over ExpectLines
+			test.ExpectLines(t, output.String(), tt.wantLines...)
+		})
+	}
+}
+
 func TestPRView_web_currentBranch(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -470,6 +671,25 @@ func TestPRView_web_currentBranch(t *testing.T) {
 	assert.Equal(t, "https://github.com/OWNER/REPO/pull/10", output.BrowsedURL)
 }
 
+func TestPRView_web_tab(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	shared.RunCommandFinder("", &api.PullRequest{URL: "https://github.com/OWNER/REPO/pull/10"}, ghrepo.New("OWNER", "REPO"))
+
+	_, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	output, err := runCommand(http, "blueberries", true, "-w --tab files")
+	if err != nil {
+		t.Errorf("error running command `pr view`: %v", err)
+	}
+
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "Opening github.com/OWNER/REPO/pull/10/files in your browser.\n", output.Stderr())
+	assert.Equal(t, "https://github.com/OWNER/REPO/pull/10/files", output.BrowsedURL)
+}
+
 func TestPRView_web_noResultsForBranch(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -516,9 +736,9 @@ func TestPRView_tty_Comments(t *testing.T) {
 			branch: "master",
 			cli:    "123 --comments",
 			fixtures: map[string]string{
-				"PullRequestByNumber":    "./fixtures/prViewPreviewSingleComment.json",
-				"ReviewsForPullRequest":  "./fixtures/prViewPreviewReviews.json",
-				"CommentsForPullRequest": "./fixtures/prViewPreviewFullComments.json",
+				"PullRequestByNumber":   "./fixtures/prViewPreviewSingleComment.json",
+				"ReviewsForPullRequest": "./fixtures/prViewPreviewReviews.json",
+				"CommentsForSubject":    "./fixtures/prViewPreviewFullComments.json",
 			},
 			expectedOutputs: []string{
 				`some title #12`,
@@ -611,9 +831,9 @@ func TestPRView_nontty_Comments(t *testing.T) {
 			branch: "master",
 			cli:    "123 --comments",
 			fixtures: map[string]string{
-				"PullRequestByNumber":    "./fixtures/prViewPreviewSingleComment.json",
-				"ReviewsForPullRequest":  "./fixtures/prViewPreviewReviews.json",
-				"CommentsForPullRequest": "./fixtures/prViewPreviewFullComments.json",
+				"PullRequestByNumber":   "./fixtures/prViewPreviewSingleComment.json",
+				"ReviewsForPullRequest": "./fixtures/prViewPreviewReviews.json",
+				"CommentsForSubject":    "./fixtures/prViewPreviewFullComments.json",
 			},
 			expectedOutputs: []string{
 				`author:\tmonalisa`,
@@ -0,0 +1,234 @@
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+const watchInterval = 3 * time.Second
+
+// leadingResources are always listed first, in this order; any other resources
+// reported by the API follow alphabetically.
+var leadingResources = []string{"core", "search", "graphql"}
+
+type RateLimitOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Watch bool
+
+	Now func() time.Time
+}
+
+type rateLimitPayload struct {
+	Resources map[string]rateLimit
+}
+
+type rateLimit struct {
+	Limit     int
+	Used      int
+	Remaining int
+	Reset     int64
+}
+
+func NewCmdRateLimit(f *cmdutil.Factory, runF func(*RateLimitOptions) error) *cobra.Command {
+	opts := &RateLimitOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		Now:        time.Now,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "ratelimit",
+		Short: "Show current API rate limit quotas",
+		Long: heredoc.Doc(`
+			Show how much of the API rate limit quotas the current account has used.
+
+			Quotas are reported per resource: core covers most REST endpoints, while
+			search and graphql have their own limits.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return rateLimitRun(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Watch, "watch", "w", false, "Refresh the quotas until interrupted")
+
+	return cmd
+}
+
+func rateLimitRun(ctx context.Context, opts *RateLimitOptions) error {
+	c, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	for {
+		payload, err := fetchRateLimits(c, host)
+		if err != nil {
+			return fmt.Errorf("failed to get rate limits: %w", err)
+		}
+
+		if opts.Watch {
+			// clear the screen and move the cursor to the top left
+			fmt.Fprint(opts.IO.Out, "\x1b[2J\x1b[0;0H")
+			fmt.Fprintln(opts.IO.Out, "Refreshing every 3 seconds. Press Ctrl+C to quit.")
+			fmt.Fprintln(opts.IO.Out)
+		}
+
+		if err := printRateLimits(opts, payload); err != nil {
+			return err
+		}
+
+		if !opts.Watch {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(watchInterval):
+		}
+	}
+}
+
+func fetchRateLimits(httpClient *http.Client, host string) (rateLimitPayload, error) {
+	var payload rateLimitPayload
+
+	req, err := http.NewRequest("GET", ghinstance.RESTPrefix(host)+"rate_limit", nil)
+	if err != nil {
+		return payload, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return payload, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return payload, api.HandleHTTPError(resp)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return payload, err
+	}
+
+	// some GitHub Enterprise instances omit the resource breakdown; fall back
+	// to the quota reported in the response headers
+	if len(payload.Resources) == 0 {
+		if limit, err := strconv.Atoi(resp.Header.Get("X-Ratelimit-Limit")); err == nil {
+			remaining, _ := strconv.Atoi(resp.Header.Get("X-Ratelimit-Remaining"))
+			reset, _ := strconv.ParseInt(resp.Header.Get("X-Ratelimit-Reset"), 10, 64)
+			resource := resp.Header.Get("X-Ratelimit-Resource")
+			if resource == "" {
+				resource = "core"
+			}
+			payload.Resources = map[string]rateLimit{
+				resource: {
+					Limit:     limit,
+					Used:      limit - remaining,
+					Remaining: remaining,
+					Reset:     reset,
+				},
+			}
+		}
+	}
+
+	return payload, nil
+}
+
+func printRateLimits(opts *RateLimitOptions, payload rateLimitPayload) error {
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+
+	for _, name := range sortedResources(payload.Resources) {
+		limit := payload.Resources[name]
+
+		percentUsed := 0.0
+		if limit.Limit > 0 {
+			percentUsed = 100 * float64(limit.Used) / float64(limit.Limit)
+		}
+
+		percentColor := cs.Green
+		if percentUsed >= 90 {
+			percentColor = cs.Red
+		} else if percentUsed >= 50 {
+			percentColor = cs.Yellow
+		}
+
+		reset := time.Unix(limit.Reset, 0).Local()
+
+		tp.AddField(name, nil, cs.Bold)
+		tp.AddField(fmt.Sprintf("%d/%d", limit.Used, limit.Limit), nil, nil)
+		tp.AddField(fmt.Sprintf("%.1f%%", percentUsed), nil, percentColor)
+		if tp.IsTTY() {
+			tp.AddField(fmt.Sprintf("resets %s (in %s)", reset.Format("15:04:05"), fuzzyDuration(reset.Sub(opts.Now()))), nil, cs.Gray)
+		} else {
+			tp.AddField(reset.Format(time.RFC3339), nil, nil)
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func sortedResources(resources map[string]rateLimit) []string {
+	names := []string{}
+	seen := map[string]bool{}
+	for _, name := range leadingResources {
+		if _, ok := resources[name]; ok {
+			names = append(names, name)
+			seen[name] = true
+		}
+	}
+	rest := []string{}
+	for name := range resources {
+		if !seen[name] {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+	return append(names, rest...)
+}
+
+func fuzzyDuration(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	if d < time.Minute {
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	}
+	return fmt.Sprintf("%dm", int(d.Minutes()))
+}
@@ -0,0 +1,135 @@
+package ratelimit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdRateLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		cli       string
+		wantWatch bool
+	}{
+		{
+			name: "blank",
+		},
+		{
+			name:      "watch",
+			cli:       "--watch",
+			wantWatch: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *RateLimitOptions
+			cmd := NewCmdRateLimit(f, func(opts *RateLimitOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantWatch, gotOpts.Watch)
+		})
+	}
+}
+
+func TestRateLimitRun(t *testing.T) {
+	reset := time.Date(2022, 5, 3, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		httpStubs func(*httpmock.Registry)
+		wantOut   string
+	}{
+		{
+			name: "resource breakdown",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "rate_limit"),
+					httpmock.StringResponse(fmt.Sprintf(`{"resources": {
+						"core": {"limit": 5000, "used": 1500, "remaining": 3500, "reset": %[1]d},
+						"search": {"limit": 30, "used": 0, "remaining": 30, "reset": %[1]d},
+						"graphql": {"limit": 5000, "used": 4900, "remaining": 100, "reset": %[1]d},
+						"code_scanning_upload": {"limit": 1000, "used": 0, "remaining": 1000, "reset": %[1]d}
+					}}`, reset.Unix())))
+			},
+			wantOut: fmt.Sprintf("core\t1500/5000\t30.0%%\t%[1]s\nsearch\t0/30\t0.0%%\t%[1]s\ngraphql\t4900/5000\t98.0%%\t%[1]s\ncode_scanning_upload\t0/1000\t0.0%%\t%[1]s\n",
+				time.Unix(reset.Unix(), 0).Format(time.RFC3339)),
+		},
+		{
+			name: "headers fallback",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "rate_limit"),
+					func(req *http.Request) (*http.Response, error) {
+						resp, err := httpmock.StringResponse(`{}`)(req)
+						if err != nil {
+							return nil, err
+						}
+						resp.Header = http.Header{}
+						resp.Header.Set("X-Ratelimit-Limit", "5000")
+						resp.Header.Set("X-Ratelimit-Remaining", "4000")
+						resp.Header.Set("X-Ratelimit-Reset", fmt.Sprintf("%d", reset.Unix()))
+						return resp, nil
+					})
+			},
+			wantOut: fmt.Sprintf("core\t1000/5000\t20.0%%\t%s\n",
+				time.Unix(reset.Unix(), 0).Format(time.RFC3339)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+
+			opts := &RateLimitOptions{
+				IO: io,
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				Config: func() (config.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+				Now: func() time.Time { return reset.Add(-30 * time.Minute) },
+			}
+
+			err := rateLimitRun(context.Background(), opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -50,6 +53,8 @@ type CreateOptions struct {
 	Concurrency        int
 	DiscussionCategory string
 	GenerateNotes      bool
+	GenerateChangelog  bool
+	NotesStartTag      string
 }
 
 func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
@@ -82,7 +87,11 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 			When using automatically generated release notes, a release title will also be automatically
 			generated unless a title was explicitly passed. Additional release notes can be prepended to
-			automatically generated notes by using the notes parameter.
+			automatically generated notes by using the notes parameter. The tag to start generating notes
+			from can be specified with %[1]s--notes-start-tag%[1]s.
+
+			With %[1]s--generate-changelog%[1]s, release notes are instead generated locally by summarizing
+			the pull requests merged since the starting tag, grouped by label.
 		`, "`"),
 		Example: heredoc.Doc(`
 			Interactively create a release
@@ -97,6 +106,12 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			Use automatically generated release notes
 			$ gh release create v1.2.3 --generate-notes
 
+			Use release notes generated since a specific tag
+			$ gh release create v1.2.3 --generate-notes --notes-start-tag v1.1.0
+
+			Summarize merged pull requests by label as the release notes
+			$ gh release create v1.2.3 --generate-changelog
+
 			Use release notes from a file
 			$ gh release create v1.2.3 -F changelog.md
 
@@ -114,6 +129,18 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return errors.New("discussions for draft releases not supported")
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--generate-notes` or `--generate-changelog`",
+				opts.GenerateNotes,
+				opts.GenerateChangelog,
+			); err != nil {
+				return err
+			}
+
+			if opts.NotesStartTag != "" && !opts.GenerateNotes && !opts.GenerateChangelog {
+				return cmdutil.FlagErrorf("`--notes-start-tag` requires `--generate-notes` or `--generate-changelog`")
+			}
+
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
 			opts.RepoOverride, _ = cmd.Flags().GetString("repo")
@@ -134,7 +161,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 			opts.Concurrency = 5
 
-			opts.BodyProvided = cmd.Flags().Changed("notes") || opts.GenerateNotes
+			opts.BodyProvided = cmd.Flags().Changed("notes") || opts.GenerateNotes || opts.GenerateChangelog
 			if notesFile != "" {
 				b, err := cmdutil.ReadFile(notesFile, opts.IO.In)
 				if err != nil {
@@ -159,6 +186,8 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().StringVarP(&notesFile, "notes-file", "F", "", "Read release notes from `file` (use \"-\" to read from standard input)")
 	cmd.Flags().StringVarP(&opts.DiscussionCategory, "discussion-category", "", "", "Start a discussion of the specified category")
 	cmd.Flags().BoolVarP(&opts.GenerateNotes, "generate-notes", "", false, "Automatically generate title and notes for the release")
+	cmd.Flags().BoolVarP(&opts.GenerateChangelog, "generate-changelog", "", false, "Generate notes locally by summarizing merged pull requests by label")
+	cmd.Flags().StringVar(&opts.NotesStartTag, "notes-start-tag", "", "Tag to use as the starting point for generating release notes")
 
 	return cmd
 }
@@ -229,6 +258,9 @@ func createRun(opts *CreateOptions) error {
 		if opts.Target != "" {
 			params["target_commitish"] = opts.Target
 		}
+		if opts.NotesStartTag != "" {
+			params["previous_tag_name"] = opts.NotesStartTag
+		}
 		generatedNotes, err = generateReleaseNotes(httpClient, baseRepo, params)
 		if err != nil && !errors.Is(err, notImplementedError) {
 			return err
@@ -373,6 +405,18 @@ func createRun(opts *CreateOptions) error {
 		)
 	}
 
+	if opts.GenerateChangelog {
+		changelog, err := changelogFromPullRequests(httpClient, baseRepo, opts)
+		if err != nil {
+			return err
+		}
+		if opts.Body == "" {
+			opts.Body = changelog
+		} else {
+			opts.Body = opts.Body + "\n\n" + changelog
+		}
+	}
+
 	params := map[string]interface{}{
 		"tag_name":   opts.TagName,
 		"draft":      opts.Draft,
@@ -391,7 +435,31 @@ func createRun(opts *CreateOptions) error {
 		params["discussion_category_name"] = opts.DiscussionCategory
 	}
 	if opts.GenerateNotes {
-		params["generate_release_notes"] = true
+		if opts.NotesStartTag != "" {
+			// the REST payload for creating a release has no equivalent of previous_tag_name,
+			// so generate the notes in a separate request and submit them as the body
+			generateParams := map[string]interface{}{
+				"tag_name":          opts.TagName,
+				"previous_tag_name": opts.NotesStartTag,
+			}
+			if opts.Target != "" {
+				generateParams["target_commitish"] = opts.Target
+			}
+			generatedNotes, err := generateReleaseNotes(httpClient, baseRepo, generateParams)
+			if err != nil {
+				return err
+			}
+			if opts.Name == "" {
+				params["name"] = generatedNotes.Name
+			}
+			if opts.Body == "" {
+				params["body"] = generatedNotes.Body
+			} else {
+				params["body"] = opts.Body + "\n" + generatedNotes.Body
+			}
+		} else {
+			params["generate_release_notes"] = true
+		}
 	}
 
 	hasAssets := len(opts.Assets) > 0
@@ -499,3 +567,104 @@ func generateChangelog(commits []logEntry) string {
 	}
 	return strings.Join(parts, "\n\n")
 }
+
+// changelogFromPullRequests renders release notes by summarizing the pull requests that were
+// merged between the starting tag and the release, grouped by label.
+func changelogFromPullRequests(httpClient *http.Client, baseRepo ghrepo.Interface, opts *CreateOptions) (string, error) {
+	if opts.RepoOverride != "" {
+		return "", errors.New("`--generate-changelog` requires access to a local clone of the repository")
+	}
+
+	headRef := opts.TagName
+	if tagDescription, _ := gitTagInfo(opts.TagName); tagDescription == "" {
+		if opts.Target != "" {
+			// TODO: use the remote-tracking version of the branch ref
+			headRef = opts.Target
+		} else {
+			headRef = "HEAD"
+		}
+	}
+
+	startTag := opts.NotesStartTag
+	if startTag == "" {
+		var err error
+		startTag, err = detectPreviousTag(headRef)
+		if err != nil {
+			return "", fmt.Errorf("could not detect a previous tag; specify one with `--notes-start-tag`: %w", err)
+		}
+	}
+
+	commits, err := changelogForRange(fmt.Sprintf("%s..%s", startTag, headRef))
+	if err != nil {
+		return "", err
+	}
+
+	numbers := pullRequestNumbersFromCommits(commits)
+	if len(numbers) == 0 {
+		// no pull request references found; fall back to summarizing raw commits
+		return generateChangelog(commits), nil
+	}
+
+	prs, err := fetchChangelogPullRequests(httpClient, baseRepo, numbers)
+	if err != nil {
+		return "", err
+	}
+
+	return generatePullRequestChangelog(prs), nil
+}
+
+// matches the subjects of both merge commits and squashed pull request merges
+var prReferenceRE = regexp.MustCompile(`^Merge pull request #(\d+) from|\(#(\d+)\)$`)
+
+func pullRequestNumbersFromCommits(commits []logEntry) []int {
+	var numbers []int
+	seen := map[int]bool{}
+	for _, c := range commits {
+		m := prReferenceRE.FindStringSubmatch(c.Subject)
+		if m == nil {
+			continue
+		}
+		s := m[1]
+		if s == "" {
+			s = m[2]
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || seen[n] {
+			continue
+		}
+		seen[n] = true
+		numbers = append(numbers, n)
+	}
+	return numbers
+}
+
+func generatePullRequestChangelog(prs []changelogPullRequest) string {
+	const unlabeled = "Other changes"
+
+	sections := map[string][]changelogPullRequest{}
+	var labels []string
+	for _, pr := range prs {
+		label := unlabeled
+		if len(pr.Labels.Nodes) > 0 {
+			label = pr.Labels.Nodes[0].Name
+		}
+		if _, ok := sections[label]; !ok && label != unlabeled {
+			labels = append(labels, label)
+		}
+		sections[label] = append(sections[label], pr)
+	}
+	sort.Strings(labels)
+	if _, ok := sections[unlabeled]; ok {
+		labels = append(labels, unlabeled)
+	}
+
+	var parts []string
+	for _, label := range labels {
+		lines := []string{fmt.Sprintf("## %s", label)}
+		for _, pr := range sections[label] {
+			lines = append(lines, fmt.Sprintf("* %s (#%d) @%s", pr.Title, pr.Number, pr.Author.Login))
+		}
+		parts = append(parts, strings.Join(lines, "\n"))
+	}
+	return strings.Join(parts, "\n\n")
+}
@@ -222,6 +222,55 @@ func Test_NewCmdCreate(t *testing.T) {
 				GenerateNotes: true,
 			},
 		},
+		{
+			name:  "generate release notes with start tag",
+			args:  "v1.2.3 --generate-notes --notes-start-tag v1.1.0",
+			isTTY: true,
+			want: CreateOptions{
+				TagName:       "v1.2.3",
+				Target:        "",
+				Name:          "",
+				Body:          "",
+				BodyProvided:  true,
+				Draft:         false,
+				Prerelease:    false,
+				RepoOverride:  "",
+				Concurrency:   5,
+				Assets:        []*shared.AssetForUpload(nil),
+				GenerateNotes: true,
+				NotesStartTag: "v1.1.0",
+			},
+		},
+		{
+			name:  "generate changelog",
+			args:  "v1.2.3 --generate-changelog",
+			isTTY: true,
+			want: CreateOptions{
+				TagName:           "v1.2.3",
+				Target:            "",
+				Name:              "",
+				Body:              "",
+				BodyProvided:      true,
+				Draft:             false,
+				Prerelease:        false,
+				RepoOverride:      "",
+				Concurrency:       5,
+				Assets:            []*shared.AssetForUpload(nil),
+				GenerateChangelog: true,
+			},
+		},
+		{
+			name:    "generate notes and changelog",
+			args:    "v1.2.3 --generate-notes --generate-changelog",
+			isTTY:   true,
+			wantErr: "specify only one of `--generate-notes` or `--generate-changelog`",
+		},
+		{
+			name:    "notes start tag without generate notes",
+			args:    "v1.2.3 --notes-start-tag v1.1.0",
+			isTTY:   true,
+			wantErr: "`--notes-start-tag` requires `--generate-notes` or `--generate-changelog`",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -272,6 +321,9 @@ func Test_NewCmdCreate(t *testing.T) {
 			assert.Equal(t, tt.want.Concurrency, opts.Concurrency)
 			assert.Equal(t, tt.want.RepoOverride, opts.RepoOverride)
 			assert.Equal(t, tt.want.DiscussionCategory, opts.DiscussionCategory)
+			assert.Equal(t, tt.want.GenerateNotes, opts.GenerateNotes)
+			assert.Equal(t, tt.want.GenerateChangelog, opts.GenerateChangelog)
+			assert.Equal(t, tt.want.NotesStartTag, opts.NotesStartTag)
 
 			require.Equal(t, len(tt.want.Assets), len(opts.Assets))
 			for i := range tt.want.Assets {
@@ -502,6 +554,181 @@ func Test_createRun(t *testing.T) {
 	}
 }
 
+func Test_createRun_generatedNotes(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       CreateOptions
+		httpStubs  func(*testing.T, *httpmock.Registry)
+		runStubs   func(*run.CommandStubber)
+		wantParams map[string]interface{}
+		wantErr    string
+	}{
+		{
+			name: "generate notes with start tag",
+			opts: CreateOptions{
+				TagName:       "v1.2.3",
+				BodyProvided:  true,
+				GenerateNotes: true,
+				NotesStartTag: "v1.1.0",
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("POST", "repos/OWNER/REPO/releases/generate-notes"),
+					httpmock.RESTPayload(200, `{
+						"name": "generated name",
+						"body": "generated body"
+					}`, func(params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"tag_name":          "v1.2.3",
+							"previous_tag_name": "v1.1.0",
+						}, params)
+					}))
+			},
+			wantParams: map[string]interface{}{
+				"tag_name":   "v1.2.3",
+				"name":       "generated name",
+				"body":       "generated body",
+				"draft":      false,
+				"prerelease": false,
+			},
+		},
+		{
+			name: "generate notes with start tag and body",
+			opts: CreateOptions{
+				TagName:       "v1.2.3",
+				Body:          "intro",
+				BodyProvided:  true,
+				GenerateNotes: true,
+				NotesStartTag: "v1.1.0",
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("POST", "repos/OWNER/REPO/releases/generate-notes"),
+					httpmock.StatusStringResponse(200, `{
+						"name": "generated name",
+						"body": "generated body"
+					}`))
+			},
+			wantParams: map[string]interface{}{
+				"tag_name":   "v1.2.3",
+				"name":       "generated name",
+				"body":       "intro\ngenerated body",
+				"draft":      false,
+				"prerelease": false,
+			},
+		},
+		{
+			name: "changelog from merged pull requests",
+			opts: CreateOptions{
+				TagName:           "v1.2.3",
+				BodyProvided:      true,
+				GenerateChangelog: true,
+				NotesStartTag:     "v1.1.0",
+			},
+			runStubs: func(rs *run.CommandStubber) {
+				rs.Register(`git tag --list`, 0, "hello from annotated tag")
+				rs.Register(`git .+log .+v1\.1\.0\.\.v1\.2\.3$`, 0, "Fix crash (#101)\x00Update docs (#102)\x00")
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(httpmock.GraphQL(`query ChangelogPullRequests\b`),
+					httpmock.StringResponse(`{ "data": { "repository": {
+						"pr101": {
+							"number": 101,
+							"title": "Fix crash",
+							"merged": true,
+							"author": { "login": "octocat" },
+							"labels": { "nodes": [ { "name": "bug" } ] }
+						},
+						"pr102": {
+							"number": 102,
+							"title": "Update docs",
+							"merged": true,
+							"author": { "login": "hubot" },
+							"labels": { "nodes": [] }
+						}
+					} } }`))
+			},
+			wantParams: map[string]interface{}{
+				"tag_name":   "v1.2.3",
+				"body":       "## bug\n* Fix crash (#101) @octocat\n\n## Other changes\n* Update docs (#102) @hubot",
+				"draft":      false,
+				"prerelease": false,
+			},
+		},
+		{
+			name: "changelog without pull request references",
+			opts: CreateOptions{
+				TagName:           "v1.2.3",
+				BodyProvided:      true,
+				GenerateChangelog: true,
+				NotesStartTag:     "v1.1.0",
+			},
+			runStubs: func(rs *run.CommandStubber) {
+				rs.Register(`git tag --list`, 0, "hello from annotated tag")
+				rs.Register(`git .+log .+v1\.1\.0\.\.v1\.2\.3$`, 0, "fixed all the bugs\x00")
+			},
+			wantParams: map[string]interface{}{
+				"tag_name":   "v1.2.3",
+				"body":       "* fixed all the bugs",
+				"draft":      false,
+				"prerelease": false,
+			},
+		},
+		{
+			name: "changelog with repo override",
+			opts: CreateOptions{
+				TagName:           "v1.2.3",
+				BodyProvided:      true,
+				GenerateChangelog: true,
+				RepoOverride:      "OWNER/REPO",
+			},
+			wantErr: "`--generate-changelog` requires access to a local clone of the repository",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			tt.opts.IO = io
+
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(t, reg)
+			}
+			if tt.wantErr == "" {
+				reg.Register(httpmock.REST("POST", "repos/OWNER/REPO/releases"), httpmock.StatusStringResponse(201, `{
+					"url": "https://api.github.com/releases/123",
+					"html_url": "https://github.com/OWNER/REPO/releases/tag/v1.2.3"
+				}`))
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			rs, teardown := run.Stub()
+			defer teardown(t)
+			if tt.runStubs != nil {
+				tt.runStubs(rs)
+			}
+
+			err := createRun(&tt.opts)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			bb, err := ioutil.ReadAll(reg.Requests[len(reg.Requests)-1].Body)
+			require.NoError(t, err)
+			var params map[string]interface{}
+			err = json.Unmarshal(bb, &params)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantParams, params)
+		})
+	}
+}
+
 func Test_createRun_interactive(t *testing.T) {
 	tests := []struct {
 		name       string
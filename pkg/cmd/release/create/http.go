@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghinstance"
@@ -98,6 +99,56 @@ func generateReleaseNotes(httpClient *http.Client, repo ghrepo.Interface, params
 	return &rn, err
 }
 
+type changelogPullRequest struct {
+	Number int
+	Title  string
+	Merged bool
+	Author struct {
+		Login string
+	}
+	Labels struct {
+		Nodes []struct {
+			Name string
+		}
+	}
+}
+
+func fetchChangelogPullRequests(httpClient *http.Client, repo ghrepo.Interface, numbers []int) ([]changelogPullRequest, error) {
+	fields := make([]string, 0, len(numbers))
+	for _, number := range numbers {
+		fields = append(fields, fmt.Sprintf(
+			"pr%d: pullRequest(number: %d) { number title merged author { login } labels(first: 10) { nodes { name } } }",
+			number, number))
+	}
+	query := fmt.Sprintf(
+		`query ChangelogPullRequests($owner: String!, $repo: String!) { repository(owner: $owner, name: $repo) { %s } }`,
+		strings.Join(fields, " "))
+
+	var resp struct {
+		Repository map[string]*changelogPullRequest
+	}
+	client := api.NewClientFromHTTP(httpClient)
+	err := client.GraphQL(repo.RepoHost(), query, map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+	}, &resp)
+	if err != nil {
+		// tolerate missing nodes, e.g. when a commit subject references an issue number
+		var gerr *api.GraphQLErrorResponse
+		if !errors.As(err, &gerr) || !gerr.Match("NOT_FOUND", "repository.") {
+			return nil, err
+		}
+	}
+
+	prs := make([]changelogPullRequest, 0, len(numbers))
+	for _, number := range numbers {
+		if pr := resp.Repository[fmt.Sprintf("pr%d", number)]; pr != nil && pr.Merged {
+			prs = append(prs, *pr)
+		}
+	}
+	return prs, nil
+}
+
 func createRelease(httpClient *http.Client, repo ghrepo.Interface, params map[string]interface{}) (*shared.Release, error) {
 	bodyBytes, err := json.Marshal(params)
 	if err != nil {
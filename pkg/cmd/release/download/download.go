@@ -1,6 +1,9 @@
 package download
 
 import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -9,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
@@ -27,6 +31,8 @@ type DownloadOptions struct {
 	TagName      string
 	FilePatterns []string
 	Destination  string
+	OutputFile   string
+	Checksum     string
 
 	// maximum number of simultaneous downloads
 	Concurrency int
@@ -34,6 +40,8 @@ type DownloadOptions struct {
 	ArchiveType string
 }
 
+var checksumRE = regexp.MustCompile(`^sha256:[0-9a-fA-F]{64}$`)
+
 func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobra.Command {
 	opts := &DownloadOptions{
 		IO:         f.IOStreams,
@@ -48,6 +56,13 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 
 			Without an explicit tag name argument, assets are downloaded from the
 			latest release in the project. In this case, '--pattern' is required.
+
+			When the release carries a checksums manifest such as 'checksums.txt',
+			the digest of every downloaded asset is verified against it. A digest
+			for a single asset can also be supplied with '--checksum'.
+
+			Interrupted downloads are resumed with an HTTP Range request when the
+			partially downloaded file is still on disk.
 		`),
 		Example: heredoc.Doc(`
 			# download all assets from a specific release
@@ -61,6 +76,12 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 
 			# download the archive of the source code for a release
 			$ gh release download v1.2.3 --archive=zip
+
+			# verify a single asset against an explicit digest
+			$ gh release download v1.2.3 -p checksums.txt -O - --checksum sha256:27dd...
+
+			# stream an asset to another command
+			$ gh release download v1.2.3 -p '*.tar.gz' -O - | tar xzf -
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -80,6 +101,18 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 				return err
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--dir` or `--output`",
+				opts.Destination != ".",
+				opts.OutputFile != "",
+			); err != nil {
+				return err
+			}
+
+			if opts.Checksum != "" && !checksumRE.MatchString(opts.Checksum) {
+				return cmdutil.FlagErrorf("invalid checksum: expected format `sha256:<hex>`")
+			}
+
 			opts.Concurrency = 5
 
 			if runF != nil {
@@ -92,6 +125,8 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 	cmd.Flags().StringVarP(&opts.Destination, "dir", "D", ".", "The directory to download files into")
 	cmd.Flags().StringArrayVarP(&opts.FilePatterns, "pattern", "p", nil, "Download only assets that match a glob pattern")
 	cmd.Flags().StringVarP(&opts.ArchiveType, "archive", "A", "", "Download the source code archive in the specified `format` (zip or tar.gz)")
+	cmd.Flags().StringVarP(&opts.OutputFile, "output", "O", "", "The `file` to write a single asset to (use \"-\" to write to standard output)")
+	cmd.Flags().StringVar(&opts.Checksum, "checksum", "", "Verify a single downloaded asset against a `sha256:<hex>` digest")
 
 	return cmd
 }
@@ -168,14 +203,33 @@ func downloadRun(opts *DownloadOptions) error {
 		return errors.New("no assets to download")
 	}
 
-	if opts.Destination != "." {
+	if opts.OutputFile != "" && len(toDownload) > 1 {
+		return errors.New("`--output` can only be used when downloading a single asset")
+	}
+	if opts.Checksum != "" && len(toDownload) > 1 {
+		return errors.New("`--checksum` can only be used when downloading a single asset")
+	}
+
+	checksums := map[string]string{}
+	if opts.Checksum != "" {
+		checksums[toDownload[0].Name] = strings.TrimPrefix(opts.Checksum, "sha256:")
+	} else if !isArchive {
+		if cks := findChecksumsAsset(release.Assets); cks != nil {
+			checksums, err = fetchChecksums(httpClient, cks.APIURL)
+			if err != nil {
+				return fmt.Errorf("error fetching %s: %w", cks.Name, err)
+			}
+		}
+	}
+
+	if opts.Destination != "." && opts.OutputFile == "" {
 		err := os.MkdirAll(opts.Destination, 0755)
 		if err != nil {
 			return err
 		}
 	}
 
-	return downloadAssets(httpClient, toDownload, opts.Destination, opts.Concurrency, isArchive)
+	return downloadAssets(httpClient, toDownload, opts, isArchive, checksums)
 }
 
 func matchAny(patterns []string, name string) bool {
@@ -187,7 +241,55 @@ func matchAny(patterns []string, name string) bool {
 	return false
 }
 
-func downloadAssets(httpClient *http.Client, toDownload []shared.ReleaseAsset, destDir string, numWorkers int, isArchive bool) error {
+// findChecksumsAsset returns the conventional checksums manifest among the
+// release assets, if any
+func findChecksumsAsset(assets []shared.ReleaseAsset) *shared.ReleaseAsset {
+	for i, a := range assets {
+		name := strings.ToLower(a.Name)
+		if name == "checksums.txt" || strings.HasSuffix(name, "_checksums.txt") {
+			return &assets[i]
+		}
+	}
+	return nil
+}
+
+// fetchChecksums parses a checksums manifest of `<hex>  <filename>` lines into
+// a map of file names to their expected digests
+func fetchChecksums(httpClient *http.Client, assetURL string) (map[string]string, error) {
+	httpClient, req, err := assetRequest(httpClient, assetURL, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	checksums := map[string]string{}
+	s := bufio.NewScanner(resp.Body)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		checksums[strings.TrimPrefix(fields[1], "*")] = fields[0]
+	}
+	return checksums, s.Err()
+}
+
+func downloadAssets(httpClient *http.Client, toDownload []shared.ReleaseAsset, opts *DownloadOptions, isArchive bool, checksums map[string]string) error {
+	if opts.OutputFile == "-" {
+		a := toDownload[0]
+		return streamAsset(httpClient, a.APIURL, isArchive, checksums[a.Name], opts.IO.Out)
+	}
+
+	numWorkers := opts.Concurrency
 	if numWorkers == 0 {
 		return errors.New("the number of concurrent workers needs to be greater than 0")
 	}
@@ -202,7 +304,7 @@ func downloadAssets(httpClient *http.Client, toDownload []shared.ReleaseAsset, d
 	for w := 1; w <= numWorkers; w++ {
 		go func() {
 			for a := range jobs {
-				results <- downloadAsset(httpClient, a.APIURL, destDir, a.Name, isArchive)
+				results <- downloadAsset(httpClient, a.APIURL, opts.Destination, a.Name, opts.OutputFile, isArchive, checksums[a.Name])
 			}
 		}()
 	}
@@ -222,10 +324,12 @@ func downloadAssets(httpClient *http.Client, toDownload []shared.ReleaseAsset, d
 	return downloadError
 }
 
-func downloadAsset(httpClient *http.Client, assetURL, destinationDir string, fileName string, isArchive bool) error {
+// assetRequest prepares a request for an asset download, possibly returning a
+// copy of the client with redirect logic suitable for archive downloads
+func assetRequest(httpClient *http.Client, assetURL string, isArchive bool) (*http.Client, *http.Request, error) {
 	req, err := http.NewRequest("GET", assetURL, nil)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	req.Header.Set("Accept", "application/octet-stream")
@@ -244,19 +348,47 @@ func downloadAsset(httpClient *http.Client, assetURL, destinationDir string, fil
 		}
 	}
 
+	return httpClient, req, nil
+}
+
+func downloadAsset(httpClient *http.Client, assetURL, destinationDir, fileName, outputFile string, isArchive bool, checksum string) error {
+	httpClient, req, err := assetRequest(httpClient, assetURL, isArchive)
+	if err != nil {
+		return err
+	}
+
+	var destinationPath string
+	if outputFile != "" {
+		destinationPath = outputFile
+	} else if len(fileName) > 0 {
+		destinationPath = filepath.Join(destinationDir, fileName)
+	}
+
+	var resumeFrom int64
+	if destinationPath != "" {
+		if fi, err := os.Stat(destinationPath); err == nil {
+			resumeFrom = fi.Size()
+		}
+	}
+	if resumeFrom > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", resumeFrom))
+	}
+
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+		// the file on disk already holds all the data
+		return verifyChecksum(destinationPath, checksum)
+	}
 	if resp.StatusCode > 299 {
 		return api.HandleHTTPError(resp)
 	}
 
-	var destinationPath = filepath.Join(destinationDir, fileName)
-
-	if len(fileName) == 0 {
+	if destinationPath == "" {
 		contentDisposition := resp.Header.Get("Content-Disposition")
 
 		_, params, err := mime.ParseMediaType(contentDisposition)
@@ -270,14 +402,79 @@ func downloadAsset(httpClient *http.Client, assetURL, destinationDir string, fil
 		}
 	}
 
-	f, err := os.OpenFile(destinationPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	openFlags := os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	if resp.StatusCode == http.StatusPartialContent {
+		openFlags = os.O_WRONLY | os.O_APPEND
+	} else if resumeFrom > 0 {
+		// the server ignored the Range request; start the download over
+		openFlags = os.O_WRONLY | os.O_TRUNC
+	}
+
+	f, err := os.OpenFile(destinationPath, openFlags, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		return err
+	}
+
+	return verifyChecksum(destinationPath, checksum)
+}
+
+func streamAsset(httpClient *http.Client, assetURL string, isArchive bool, checksum string, w io.Writer) error {
+	httpClient, req, err := assetRequest(httpClient, assetURL, isArchive)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	hash := sha256.New()
+	var dst io.Writer = w
+	if checksum != "" {
+		dst = io.MultiWriter(w, hash)
+	}
+	if _, err := io.Copy(dst, resp.Body); err != nil {
+		return err
+	}
+
+	if checksum != "" {
+		if actual := hex.EncodeToString(hash.Sum(nil)); !strings.EqualFold(actual, checksum) {
+			return fmt.Errorf("checksum mismatch: expected %s, got %s", checksum, actual)
+		}
+	}
+	return nil
+}
+
+func verifyChecksum(path, checksum string) error {
+	if checksum == "" {
+		return nil
+	}
+
+	f, err := os.Open(path)
 	if err != nil {
 		return err
 	}
 	defer f.Close()
 
-	_, err = io.Copy(f, resp.Body)
-	return err
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return err
+	}
+	if actual := hex.EncodeToString(hash.Sum(nil)); !strings.EqualFold(actual, checksum) {
+		return fmt.Errorf("checksum mismatch for %q: expected %s, got %s", filepath.Base(path), checksum, actual)
+	}
+	return nil
 }
 
 var codeloadLegacyRE = regexp.MustCompile(`^(/[^/]+/[^/]+/)legacy\.`)
@@ -286,7 +483,7 @@ var codeloadLegacyRE = regexp.MustCompile(`^(/[^/]+/[^/]+/)legacy\.`)
 // when you choose to download "Source code (zip/tar.gz)" from a tagged release on the web. The legacy URLs
 // look like this:
 //
-//   https://codeload.github.com/OWNER/REPO/legacy.zip/refs/tags/TAGNAME
+//	https://codeload.github.com/OWNER/REPO/legacy.zip/refs/tags/TAGNAME
 //
 // Removing the "legacy." part results in a valid Codeload URL for our desired archive format.
 func removeLegacyFromCodeloadPath(p string) string {
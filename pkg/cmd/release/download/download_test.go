@@ -2,10 +2,13 @@ package download
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/cli/cli/v2/internal/ghrepo"
@@ -92,12 +95,48 @@ func Test_NewCmdDownload(t *testing.T) {
 				Concurrency:  5,
 			},
 		},
+		{
+			name:  "output to stdout",
+			args:  "v1.2.3 -p *.tgz -O -",
+			isTTY: true,
+			want: DownloadOptions{
+				TagName:      "v1.2.3",
+				FilePatterns: []string{"*.tgz"},
+				Destination:  ".",
+				OutputFile:   "-",
+				Concurrency:  5,
+			},
+		},
+		{
+			name:  "explicit checksum",
+			args:  "v1.2.3 -p *.tgz --checksum sha256:" + strings.Repeat("0", 64),
+			isTTY: true,
+			want: DownloadOptions{
+				TagName:      "v1.2.3",
+				FilePatterns: []string{"*.tgz"},
+				Destination:  ".",
+				Checksum:     "sha256:" + strings.Repeat("0", 64),
+				Concurrency:  5,
+			},
+		},
 		{
 			name:    "no arguments",
 			args:    "",
 			isTTY:   true,
 			wantErr: "`--pattern` or `--archive` is required when downloading the latest release",
 		},
+		{
+			name:    "invalid checksum format",
+			args:    "v1.2.3 --checksum md5:abcd",
+			isTTY:   true,
+			wantErr: "invalid checksum: expected format `sha256:<hex>`",
+		},
+		{
+			name:    "simultaneous dir and output arguments",
+			args:    "v1.2.3 -D tmp/assets -O out.zip",
+			isTTY:   true,
+			wantErr: "specify only one of `--dir` or `--output`",
+		},
 		{
 			name:    "simultaneous pattern and archive arguments",
 			args:    "-p * -A zip",
@@ -148,6 +187,8 @@ func Test_NewCmdDownload(t *testing.T) {
 			assert.Equal(t, tt.want.TagName, opts.TagName)
 			assert.Equal(t, tt.want.FilePatterns, opts.FilePatterns)
 			assert.Equal(t, tt.want.Destination, opts.Destination)
+			assert.Equal(t, tt.want.OutputFile, opts.OutputFile)
+			assert.Equal(t, tt.want.Checksum, opts.Checksum)
 			assert.Equal(t, tt.want.Concurrency, opts.Concurrency)
 		})
 	}
@@ -208,6 +249,30 @@ func Test_downloadRun(t *testing.T) {
 			wantStderr: ``,
 			wantErr:    "no assets match the file pattern",
 		},
+		{
+			name:  "stream single asset to stdout",
+			isTTY: false,
+			opts: DownloadOptions{
+				TagName:      "v1.2.3",
+				FilePatterns: []string{"linux.tgz"},
+				Destination:  ".",
+				OutputFile:   "-",
+				Concurrency:  2,
+			},
+			wantStdout: `5678`,
+			wantStderr: ``,
+		},
+		{
+			name:  "checksum with multiple assets",
+			isTTY: true,
+			opts: DownloadOptions{
+				TagName:     "v1.2.3",
+				Destination: ".",
+				Checksum:    "sha256:" + strings.Repeat("0", 64),
+				Concurrency: 2,
+			},
+			wantErr: "`--checksum` can only be used when downloading a single asset",
+		},
 		{
 			name:  "download archive in zip format into destination directory",
 			isTTY: true,
@@ -319,6 +384,118 @@ func Test_downloadRun(t *testing.T) {
 	}
 }
 
+func Test_downloadRun_checksums(t *testing.T) {
+	assetDigest := fmt.Sprintf("%x", sha256.Sum256([]byte("5678")))
+	badDigest := strings.Repeat("0", 64)
+
+	tests := []struct {
+		name    string
+		digest  string
+		wantErr string
+	}{
+		{
+			name:   "digest matches",
+			digest: assetDigest,
+		},
+		{
+			name:    "digest mismatch",
+			digest:  badDigest,
+			wantErr: fmt.Sprintf("checksum mismatch for \"linux.tgz\": expected %s, got %s", badDigest, assetDigest),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := filepath.Join(t.TempDir(), "assets")
+
+			io, _, _, _ := iostreams.Test()
+
+			fakeHTTP := &httpmock.Registry{}
+			fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/releases/tags/v1.2.3"), httpmock.StringResponse(`{
+				"assets": [
+					{ "name": "linux.tgz", "size": 4,
+					  "url": "https://api.github.com/assets/5678" },
+					{ "name": "checksums.txt", "size": 76,
+					  "url": "https://api.github.com/assets/9999" }
+				]
+			}`))
+			fakeHTTP.Register(httpmock.REST("GET", "assets/9999"), httpmock.StringResponse(tt.digest+"  linux.tgz\n"))
+			fakeHTTP.Register(httpmock.REST("GET", "assets/5678"), httpmock.StringResponse(`5678`))
+
+			opts := DownloadOptions{
+				TagName:      "v1.2.3",
+				FilePatterns: []string{"linux.tgz"},
+				Destination:  tempDir,
+				Concurrency:  2,
+				IO:           io,
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: fakeHTTP}, nil
+				},
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.FromFullName("OWNER/REPO")
+				},
+			}
+
+			err := downloadRun(&opts)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			content, err := ioutil.ReadFile(filepath.Join(tempDir, "linux.tgz"))
+			require.NoError(t, err)
+			assert.Equal(t, "5678", string(content))
+		})
+	}
+}
+
+func Test_downloadRun_resume(t *testing.T) {
+	tempDir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tempDir, "linux.tgz"), []byte("56"), 0644))
+
+	io, _, _, _ := iostreams.Test()
+
+	fakeHTTP := &httpmock.Registry{}
+	fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/releases/tags/v1.2.3"), httpmock.StringResponse(`{
+		"assets": [
+			{ "name": "linux.tgz", "size": 4,
+			  "url": "https://api.github.com/assets/5678" }
+		]
+	}`))
+	fakeHTTP.Register(httpmock.REST("GET", "assets/5678"), func(req *http.Request) (*http.Response, error) {
+		if got := req.Header.Get("Range"); got != "bytes=2-" {
+			t.Errorf("got Range header %q, want %q", got, "bytes=2-")
+		}
+		return &http.Response{
+			StatusCode: 206,
+			Request:    req,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("78")),
+			Header:     http.Header{},
+		}, nil
+	})
+
+	opts := DownloadOptions{
+		TagName:      "v1.2.3",
+		FilePatterns: []string{"linux.tgz"},
+		Destination:  tempDir,
+		Checksum:     fmt.Sprintf("sha256:%x", sha256.Sum256([]byte("5678"))),
+		Concurrency:  2,
+		IO:           io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	require.NoError(t, downloadRun(&opts))
+
+	content, err := ioutil.ReadFile(filepath.Join(tempDir, "linux.tgz"))
+	require.NoError(t, err)
+	assert.Equal(t, "5678", string(content))
+}
+
 func listFiles(dir string) ([]string, error) {
 	var files []string
 	err := filepath.Walk(dir, func(p string, f os.FileInfo, err error) error {
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	graphql "github.com/cli/shurcooL-graphql"
@@ -24,8 +25,9 @@ func fetchReleases(httpClient *http.Client, repo ghrepo.Interface, limit int) ([
 	type responseData struct {
 		Repository struct {
 			Releases struct {
-				Nodes    []Release
-				PageInfo struct {
+				TotalCount int
+				Nodes      []Release
+				PageInfo   struct {
 					HasNextPage bool
 					EndCursor   string
 				}
@@ -47,26 +49,37 @@ func fetchReleases(httpClient *http.Client, repo ghrepo.Interface, limit int) ([
 
 	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), httpClient)
 
-	var releases []Release
-loop:
-	for {
+	fetchPage := func(offset, pageSize int) (interface{}, int, error) {
+		pageVariables := map[string]interface{}{}
+		for name, value := range variables {
+			pageVariables[name] = value
+		}
+		pageVariables["perPage"] = githubv4.Int(pageSize)
+		if offset > 0 {
+			pageVariables["endCursor"] = githubv4.String(api.OffsetCursor(offset))
+		}
+
 		var query responseData
-		err := gql.QueryNamed(context.Background(), "RepositoryReleaseList", &query, variables)
+		err := gql.QueryNamed(context.Background(), "RepositoryReleaseList", &query, pageVariables)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
+		return query.Repository.Releases.Nodes, query.Repository.Releases.TotalCount, nil
+	}
 
-		for _, r := range query.Repository.Releases.Nodes {
-			releases = append(releases, r)
+	pages, _, err := api.PaginateConcurrently(perPage, limit, fetchPage)
+	if err != nil {
+		return nil, err
+	}
+
+	var releases []Release
+	for _, page := range pages {
+		for _, r := range page.([]Release) {
 			if len(releases) == limit {
-				break loop
+				break
 			}
+			releases = append(releases, r)
 		}
-
-		if !query.Repository.Releases.PageInfo.HasNextPage {
-			break
-		}
-		variables["endCursor"] = githubv4.String(query.Repository.Releases.PageInfo.EndCursor)
 	}
 
 	return releases, nil
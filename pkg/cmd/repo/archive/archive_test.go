@@ -80,8 +80,7 @@ func Test_ArchiveRun(t *testing.T) {
 			name:       "unarchived repo tty",
 			wantStdout: "✓ Archived repository OWNER/REPO\n",
 			askStubs: func(q *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: q.StubOne is deprecated: use StubPrompt
-				q.StubOne(true)
+				q.StubPrompt("Archive OWNER/REPO?").AnswerWith(true)
 			},
 			isTTY: true,
 			opts:  ArchiveOptions{RepoArg: "OWNER/REPO"},
@@ -99,8 +98,7 @@ func Test_ArchiveRun(t *testing.T) {
 			wantStdout: "✓ Archived repository OWNER/REPO\n",
 			opts:       ArchiveOptions{},
 			askStubs: func(q *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: q.StubOne is deprecated: use StubPrompt
-				q.StubOne(true)
+				q.StubPrompt("Archive OWNER/REPO?").AnswerWith(true)
 			},
 			isTTY: true,
 			httpStubs: func(reg *httpmock.Registry) {
@@ -140,9 +138,7 @@ func Test_ArchiveRun(t *testing.T) {
 		io, _, stdout, stderr := iostreams.Test()
 		tt.opts.IO = io
 
-		//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-		q, teardown := prompt.InitAskStubber()
-		defer teardown()
+		q := prompt.NewAskStubber(t)
 		if tt.askStubs != nil {
 			tt.askStubs(q)
 		}
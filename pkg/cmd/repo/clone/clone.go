@@ -1,6 +1,7 @@
 package clone
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -21,8 +22,10 @@ type CloneOptions struct {
 	Config     func() (config.Config, error)
 	IO         *iostreams.IOStreams
 
-	GitArgs    []string
-	Repository string
+	GitArgs           []string
+	Repository        string
+	UpstreamName      string
+	RecurseSubmodules bool
 }
 
 func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Command {
@@ -44,6 +47,11 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 			If the "OWNER/" portion of the "OWNER/REPO" repository argument is omitted, it
 			defaults to the name of the authenticating user.
 
+			If the repository is a fork, its parent repository will be added as an additional
+			git remote called "upstream". The remote name can be configured using --upstream-remote-name.
+			The default branch will be set up to pull from the parent repository while pushes
+			go to the fork.
+
 			Pass additional 'git clone' flags by listing them after '--'.
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -54,10 +62,13 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 				return runF(opts)
 			}
 
-			return cloneRun(opts)
+			return cloneRun(cmd.Context(), opts)
 		},
 	}
 
+	cmd.Flags().StringVarP(&opts.UpstreamName, "upstream-remote-name", "u", "upstream", "Upstream remote name when cloning a fork")
+	cmd.Flags().BoolVar(&opts.RecurseSubmodules, "recurse-submodules", false, "Initialize all submodules after cloning, rewriting same-host submodule URLs to the configured protocol")
+
 	cmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
 		if err == pflag.ErrHelp {
 			return err
@@ -68,7 +79,7 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 	return cmd
 }
 
-func cloneRun(opts *CloneOptions) error {
+func cloneRun(ctx context.Context, opts *CloneOptions) error {
 	httpClient, err := opts.HttpClient()
 	if err != nil {
 		return err
@@ -149,12 +160,14 @@ func cloneRun(opts *CloneOptions) error {
 		canonicalCloneURL = strings.TrimSuffix(canonicalCloneURL, ".git") + ".wiki.git"
 	}
 
-	cloneDir, err := git.RunClone(canonicalCloneURL, opts.GitArgs)
+	cloneDir, err := git.RunCloneContext(ctx, canonicalCloneURL, opts.GitArgs)
 	if err != nil {
 		return err
 	}
 
-	// If the repo is a fork, add the parent as an upstream
+	// If the repo is a fork, add the parent as an upstream remote and
+	// configure the default branch to pull from the parent while pushing to
+	// the fork
 	if canonicalRepo.Parent != nil {
 		protocol, err := cfg.GetOrDefault(canonicalRepo.Parent.RepoHost(), "git_protocol")
 		if err != nil {
@@ -162,11 +175,51 @@ func cloneRun(opts *CloneOptions) error {
 		}
 		upstreamURL := ghrepo.FormatRemoteURL(canonicalRepo.Parent, protocol)
 
-		err = git.AddUpstreamRemote(upstreamURL, cloneDir, []string{canonicalRepo.Parent.DefaultBranchRef.Name})
+		upstreamName := opts.UpstreamName
+		if upstreamName == "" {
+			return fmt.Errorf("--upstream-remote-name cannot be blank")
+		}
+
+		defaultBranch := canonicalRepo.Parent.DefaultBranchRef.Name
+
+		err = git.AddNamedRemote(upstreamURL, upstreamName, cloneDir, []string{defaultBranch})
 		if err != nil {
 			return err
 		}
+
+		if err := git.SetConfig(cloneDir, "remote.pushDefault", "origin"); err != nil {
+			return err
+		}
+		if err := git.SetConfig(cloneDir, fmt.Sprintf("branch.%s.remote", defaultBranch), upstreamName); err != nil {
+			return err
+		}
+		if err := git.SetConfig(cloneDir, fmt.Sprintf("branch.%s.merge", defaultBranch), "refs/heads/"+defaultBranch); err != nil {
+			return err
+		}
+	}
+
+	if opts.RecurseSubmodules {
+		if err := rewriteSubmoduleURLs(cloneDir, repo.RepoHost(), protocol); err != nil {
+			return err
+		}
+		if err := git.UpdateSubmodules(cloneDir); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
+
+// rewriteSubmoduleURLs adds an insteadOf rule so that submodules hosted on the
+// same host as the cloned repository are fetched over the protocol the user
+// configured for gh, reusing its credentials.
+func rewriteSubmoduleURLs(cloneDir, host, protocol string) error {
+	if protocol == "ssh" {
+		return git.SetConfig(cloneDir,
+			fmt.Sprintf("url.git@%s:.insteadOf", host),
+			fmt.Sprintf("https://%s/", host))
+	}
+	return git.SetConfig(cloneDir,
+		fmt.Sprintf("url.https://%s/.insteadOf", host),
+		fmt.Sprintf("git@%s:", host))
+}
@@ -244,6 +244,9 @@ func Test_RepoClone_hasParent(t *testing.T) {
 
 	cs.Register(`git clone https://github.com/OWNER/REPO.git`, 0, "")
 	cs.Register(`git -C REPO remote add -t trunk -f upstream https://github.com/hubot/ORIG.git`, 0, "")
+	cs.Register(`git -C REPO config remote\.pushDefault origin`, 0, "")
+	cs.Register(`git -C REPO config branch\.trunk\.remote upstream`, 0, "")
+	cs.Register(`git -C REPO config branch\.trunk\.merge refs/heads/trunk`, 0, "")
 
 	_, err := runCloneCommand(httpClient, "OWNER/REPO")
 	if err != nil {
@@ -251,6 +254,74 @@ func Test_RepoClone_hasParent(t *testing.T) {
 	}
 }
 
+func Test_RepoClone_hasParent_upstreamRemoteName(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "REPO",
+					"owner": {
+						"login": "OWNER"
+					},
+					"parent": {
+						"name": "ORIG",
+						"owner": {
+							"login": "hubot"
+						},
+						"defaultBranchRef": {
+							"name": "trunk"
+						}
+					}
+				} } }
+				`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git clone https://github.com/OWNER/REPO.git`, 0, "")
+	cs.Register(`git -C REPO remote add -t trunk -f test https://github.com/hubot/ORIG.git`, 0, "")
+	cs.Register(`git -C REPO config remote\.pushDefault origin`, 0, "")
+	cs.Register(`git -C REPO config branch\.trunk\.remote test`, 0, "")
+	cs.Register(`git -C REPO config branch\.trunk\.merge refs/heads/trunk`, 0, "")
+
+	_, err := runCloneCommand(httpClient, "--upstream-remote-name test OWNER/REPO")
+	if err != nil {
+		t.Fatalf("error running command `repo clone`: %v", err)
+	}
+}
+
+func Test_RepoClone_recurseSubmodules(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "REPO",
+					"owner": {
+						"login": "OWNER"
+					}
+				} } }
+				`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git clone https://github.com/OWNER/REPO.git`, 0, "")
+	cs.Register(`git -C REPO config url\.https://github\.com/\.insteadOf git@github\.com:`, 0, "")
+	cs.Register(`git -C REPO submodule update --init --recursive`, 0, "")
+
+	_, err := runCloneCommand(httpClient, "OWNER/REPO --recurse-submodules")
+	if err != nil {
+		t.Fatalf("error running command `repo clone`: %v", err)
+	}
+}
+
 func Test_RepoClone_withoutUsername(t *testing.T) {
 	reg := &httpmock.Registry{}
 	defer reg.Verify(t)
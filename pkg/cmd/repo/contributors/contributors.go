@@ -0,0 +1,266 @@
+package contributors
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+const defaultLimit = 30
+
+type ContributorsOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	IO         *iostreams.IOStreams
+	Exporter   cmdutil.Exporter
+
+	RepoArg string
+	Limit   int
+	Since   string
+}
+
+type Contributor struct {
+	Login         string `json:"login"`
+	Contributions int    `json:"contributions"`
+}
+
+func (c *Contributor) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "login":
+			data[f] = c.Login
+		case "contributions":
+			data[f] = c.Contributions
+		}
+	}
+	return data
+}
+
+var contributorFields = []string{"login", "contributions"}
+
+func NewCmdContributors(f *cmdutil.Factory, runF func(*ContributorsOptions) error) *cobra.Command {
+	opts := &ContributorsOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "contributors [<repository>]",
+		Short: "List contributors to a repository",
+		Long: heredoc.Doc(`
+			List the contributors to a GitHub repository along with their commit counts.
+
+			With no argument, lists the contributors to the current repository.
+
+			With '--since', commits are counted from the given date onwards instead of
+			over the whole history of the repository.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo contributors
+			$ gh repo contributors cli/cli
+			$ gh repo contributors --since 2022-01-01
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if opts.Since != "" {
+				if _, err := parseSince(opts.Since); err != nil {
+					return cmdutil.FlagErrorf("invalid value for --since: %v", opts.Since)
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return contributorsRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", defaultLimit, "Maximum number of contributors to list")
+	cmd.Flags().StringVar(&opts.Since, "since", "", "Count only commits after the given date, e.g. 2022-01-01")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, contributorFields)
+
+	return cmd
+}
+
+func parseSince(since string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, since); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", since)
+}
+
+func contributorsRun(opts *ContributorsOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var repo ghrepo.Interface
+	if opts.RepoArg == "" {
+		repo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	} else {
+		repoSelector := opts.RepoArg
+		if !strings.Contains(repoSelector, "/") {
+			cfg, err := opts.Config()
+			if err != nil {
+				return err
+			}
+
+			hostname, err := cfg.DefaultHost()
+			if err != nil {
+				return err
+			}
+
+			currentUser, err := api.CurrentLoginName(apiClient, hostname)
+			if err != nil {
+				return err
+			}
+			repoSelector = currentUser + "/" + repoSelector
+		}
+		repo, err = ghrepo.FromFullName(repoSelector)
+		if err != nil {
+			return fmt.Errorf("argument error: %w", err)
+		}
+	}
+
+	var contributors []Contributor
+	if opts.Since == "" {
+		contributors, err = listContributors(apiClient, repo, opts.Limit)
+	} else {
+		var since time.Time
+		since, err = parseSince(opts.Since)
+		if err == nil {
+			contributors, err = countCommitsSince(apiClient, repo, since, opts.Limit)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to list contributors: %w", err)
+	}
+
+	if err := opts.IO.StartPager(); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, contributors)
+	}
+
+	if len(contributors) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "No contributors found in %s\n", ghrepo.FullName(repo))
+		}
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, c := range contributors {
+		tp.AddField(c.Login, nil, cs.Bold)
+		tp.AddField(utils.Pluralize(c.Contributions, "commit"), nil, nil)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func listContributors(client *api.Client, repo ghrepo.Interface, limit int) ([]Contributor, error) {
+	var contributors []Contributor
+	perPage := limit
+	if perPage > 100 {
+		perPage = 100
+	}
+	for page := 1; len(contributors) < limit; page++ {
+		path := fmt.Sprintf("repos/%s/contributors?per_page=%d&page=%d", ghrepo.FullName(repo), perPage, page)
+		var result []Contributor
+		if err := client.REST(repo.RepoHost(), "GET", path, nil, &result); err != nil {
+			return nil, err
+		}
+		for _, c := range result {
+			contributors = append(contributors, c)
+			if len(contributors) == limit {
+				break
+			}
+		}
+		if len(result) < perPage {
+			break
+		}
+	}
+	return contributors, nil
+}
+
+func countCommitsSince(client *api.Client, repo ghrepo.Interface, since time.Time, limit int) ([]Contributor, error) {
+	type commit struct {
+		Author *struct {
+			Login string
+		}
+		Commit struct {
+			Author struct {
+				Name string
+			}
+		}
+	}
+
+	counts := map[string]int{}
+	for page := 1; ; page++ {
+		path := fmt.Sprintf("repos/%s/commits?since=%s&per_page=100&page=%d",
+			ghrepo.FullName(repo), url.QueryEscape(since.Format(time.RFC3339)), page)
+		var result []commit
+		if err := client.REST(repo.RepoHost(), "GET", path, nil, &result); err != nil {
+			return nil, err
+		}
+		for _, c := range result {
+			login := c.Commit.Author.Name
+			if c.Author != nil && c.Author.Login != "" {
+				login = c.Author.Login
+			}
+			counts[login]++
+		}
+		if len(result) < 100 {
+			break
+		}
+	}
+
+	contributors := make([]Contributor, 0, len(counts))
+	for login, count := range counts {
+		contributors = append(contributors, Contributor{Login: login, Contributions: count})
+	}
+	sort.Slice(contributors, func(i, j int) bool {
+		if contributors[i].Contributions != contributors[j].Contributions {
+			return contributors[i].Contributions > contributors[j].Contributions
+		}
+		return contributors[i].Login < contributors[j].Login
+	})
+	if len(contributors) > limit {
+		contributors = contributors[:limit]
+	}
+	return contributors, nil
+}
@@ -0,0 +1,173 @@
+package contributors
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdContributors(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    ContributorsOptions
+		wantsErr string
+	}{
+		{
+			name: "blank",
+			wants: ContributorsOptions{
+				Limit: 30,
+			},
+		},
+		{
+			name: "repository argument",
+			cli:  "cli/cli",
+			wants: ContributorsOptions{
+				RepoArg: "cli/cli",
+				Limit:   30,
+			},
+		},
+		{
+			name: "limit and since",
+			cli:  "-L 10 --since 2022-01-01",
+			wants: ContributorsOptions{
+				Limit: 10,
+				Since: "2022-01-01",
+			},
+		},
+		{
+			name:     "invalid limit",
+			cli:      "-L 0",
+			wantsErr: "invalid limit: 0",
+		},
+		{
+			name:     "invalid since",
+			cli:      "--since yesterday",
+			wantsErr: "invalid value for --since: yesterday",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *ContributorsOptions
+			cmd := NewCmdContributors(f, func(opts *ContributorsOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.RepoArg, gotOpts.RepoArg)
+			assert.Equal(t, tt.wants.Limit, gotOpts.Limit)
+			assert.Equal(t, tt.wants.Since, gotOpts.Since)
+		})
+	}
+}
+
+func TestContributorsRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       ContributorsOptions
+		httpStubs  func(*httpmock.Registry)
+		wantStdout string
+	}{
+		{
+			name: "lists contributors",
+			opts: ContributorsOptions{
+				Limit: 30,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contributors"),
+					httpmock.StringResponse(`[
+						{"login": "mona", "contributions": 100},
+						{"login": "hubot", "contributions": 1}
+					]`))
+			},
+			wantStdout: "mona\t100 commits\nhubot\t1 commit\n",
+		},
+		{
+			name: "limit truncates the list",
+			opts: ContributorsOptions{
+				Limit: 1,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contributors"),
+					httpmock.StringResponse(`[
+						{"login": "mona", "contributions": 100},
+						{"login": "hubot", "contributions": 1}
+					]`))
+			},
+			wantStdout: "mona\t100 commits\n",
+		},
+		{
+			name: "counts commits since a date",
+			opts: ContributorsOptions{
+				Limit: 30,
+				Since: "2022-01-01",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/commits"),
+					httpmock.StringResponse(`[
+						{"author": {"login": "hubot"}, "commit": {"author": {"name": "Hubot"}}},
+						{"author": {"login": "mona"}, "commit": {"author": {"name": "Mona"}}},
+						{"author": null, "commit": {"author": {"name": "Mona"}}},
+						{"author": {"login": "mona"}, "commit": {"author": {"name": "Mona"}}}
+					]`))
+			},
+			wantStdout: "mona\t2 commits\nMona\t1 commit\nhubot\t1 commit\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+
+			opts := tt.opts
+			opts.IO = io
+			opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			err := contributorsRun(&opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
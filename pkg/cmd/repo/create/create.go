@@ -27,24 +27,25 @@ type CreateOptions struct {
 	Config     func() (config.Config, error)
 	IO         *iostreams.IOStreams
 
-	Name              string
-	Description       string
-	Homepage          string
-	Team              string
-	Template          string
-	Public            bool
-	Private           bool
-	Internal          bool
-	Visibility        string
-	Push              bool
-	Clone             bool
-	Source            string
-	Remote            string
-	GitIgnoreTemplate string
-	LicenseTemplate   string
-	DisableIssues     bool
-	DisableWiki       bool
-	Interactive       bool
+	Name               string
+	Description        string
+	Homepage           string
+	Team               string
+	Template           string
+	IncludeAllBranches bool
+	Public             bool
+	Private            bool
+	Internal           bool
+	Visibility         string
+	Push               bool
+	Clone              bool
+	Source             string
+	Remote             string
+	GitIgnoreTemplate  string
+	LicenseTemplate    string
+	DisableIssues      bool
+	DisableWiki        bool
+	Interactive        bool
 }
 
 func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
@@ -143,6 +144,10 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("the `--template` option is not supported with `--homepage`, `--team`, `--disable-issues`, or `--disable-wiki`")
 			}
 
+			if opts.Template == "" && opts.IncludeAllBranches {
+				return cmdutil.FlagErrorf("the `--include-all-branches` option is only supported when using `--template`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -154,6 +159,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().StringVarP(&opts.Homepage, "homepage", "h", "", "Repository home page `URL`")
 	cmd.Flags().StringVarP(&opts.Team, "team", "t", "", "The `name` of the organization team to be granted access")
 	cmd.Flags().StringVarP(&opts.Template, "template", "p", "", "Make the new repository based on a template `repository`")
+	cmd.Flags().BoolVar(&opts.IncludeAllBranches, "include-all-branches", false, "Include all branches from template repository")
 	cmd.Flags().BoolVar(&opts.Public, "public", false, "Make the new repository public")
 	cmd.Flags().BoolVar(&opts.Private, "private", false, "Make the new repository private")
 	cmd.Flags().BoolVar(&opts.Internal, "internal", false, "Make the new repository internal")
@@ -265,18 +271,32 @@ func createFromScratch(opts *CreateOptions) error {
 	}
 
 	if opts.Interactive {
-		opts.Name, opts.Description, opts.Visibility, err = interactiveRepoInfo("")
+		owner, err := interactiveRepoOwner(httpClient, host)
 		if err != nil {
 			return err
 		}
-		opts.GitIgnoreTemplate, err = interactiveGitIgnore(httpClient, host)
+		opts.Name, opts.Description, opts.Visibility, err = interactiveRepoInfo("", visibilityOptions(httpClient, host, owner))
 		if err != nil {
 			return err
 		}
-		opts.LicenseTemplate, err = interactiveLicense(httpClient, host)
+		if owner.IsOrganization && !strings.Contains(opts.Name, "/") {
+			opts.Name = owner.Login + "/" + opts.Name
+		}
+		opts.Template, err = interactiveTemplate(httpClient, host, opts.Name)
 		if err != nil {
 			return err
 		}
+		if opts.Template == "" {
+			// .gitignore and license templates cannot be combined with a template repository
+			opts.GitIgnoreTemplate, err = interactiveGitIgnore(httpClient, host)
+			if err != nil {
+				return err
+			}
+			opts.LicenseTemplate, err = interactiveLicense(httpClient, host)
+			if err != nil {
+				return err
+			}
+		}
 
 		if err := confirmSubmission(opts.Name, opts.Visibility); err != nil {
 			return err
@@ -293,17 +313,29 @@ func createFromScratch(opts *CreateOptions) error {
 		repoToCreate = ghrepo.NewWithHost("", opts.Name, host)
 	}
 
+	// internal repositories and team access only exist within an organization; catch
+	// this early instead of letting the API reject the creation request
+	if repoToCreate.RepoOwner() == "" {
+		if strings.EqualFold(opts.Visibility, "INTERNAL") {
+			return errors.New("internal repositories can only be created within an organization")
+		}
+		if opts.Team != "" {
+			return errors.New("the `--team` option is only supported when creating a repository in an organization")
+		}
+	}
+
 	input := repoCreateInput{
-		Name:              repoToCreate.RepoName(),
-		Visibility:        opts.Visibility,
-		OwnerLogin:        repoToCreate.RepoOwner(),
-		TeamSlug:          opts.Team,
-		Description:       opts.Description,
-		HomepageURL:       opts.Homepage,
-		HasIssuesEnabled:  !opts.DisableIssues,
-		HasWikiEnabled:    !opts.DisableWiki,
-		GitIgnoreTemplate: opts.GitIgnoreTemplate,
-		LicenseTemplate:   opts.LicenseTemplate,
+		Name:               repoToCreate.RepoName(),
+		Visibility:         opts.Visibility,
+		OwnerLogin:         repoToCreate.RepoOwner(),
+		TeamSlug:           opts.Team,
+		Description:        opts.Description,
+		HomepageURL:        opts.Homepage,
+		HasIssuesEnabled:   !opts.DisableIssues,
+		HasWikiEnabled:     !opts.DisableWiki,
+		GitIgnoreTemplate:  opts.GitIgnoreTemplate,
+		LicenseTemplate:    opts.LicenseTemplate,
+		IncludeAllBranches: opts.IncludeAllBranches,
 	}
 
 	var templateRepoMainBranch string
@@ -372,7 +404,11 @@ func createFromScratch(opts *CreateOptions) error {
 			// cloning empty repository or template
 			checkoutBranch := ""
 			if opts.Template != "" {
-				// use the template's default branch
+				// the new repository is generated from the template asynchronously;
+				// wait for the template's default branch to exist before fetching it
+				if err := waitForBranch(httpClient, repo, templateRepoMainBranch); err != nil {
+					return err
+				}
 				checkoutBranch = templateRepoMainBranch
 			}
 			if err := localInit(opts.IO, remoteURL, repo.RepoName(), checkoutBranch); err != nil {
@@ -450,10 +486,17 @@ func createFromLocal(opts *CreateOptions) error {
 	}
 
 	if opts.Interactive {
-		opts.Name, opts.Description, opts.Visibility, err = interactiveRepoInfo(filepath.Base(absPath))
+		owner, err := interactiveRepoOwner(httpClient, host)
+		if err != nil {
+			return err
+		}
+		opts.Name, opts.Description, opts.Visibility, err = interactiveRepoInfo(filepath.Base(absPath), visibilityOptions(httpClient, host, owner))
 		if err != nil {
 			return err
 		}
+		if owner.IsOrganization && !strings.Contains(opts.Name, "/") {
+			opts.Name = owner.Login + "/" + opts.Name
+		}
 	}
 
 	var repoToCreate ghrepo.Interface
@@ -471,6 +514,17 @@ func createFromLocal(opts *CreateOptions) error {
 		repoToCreate = ghrepo.NewWithHost("", opts.Name, host)
 	}
 
+	// internal repositories and team access only exist within an organization; catch
+	// this early instead of letting the API reject the creation request
+	if repoToCreate.RepoOwner() == "" {
+		if strings.EqualFold(opts.Visibility, "INTERNAL") {
+			return errors.New("internal repositories can only be created within an organization")
+		}
+		if opts.Team != "" {
+			return errors.New("the `--team` option is only supported when creating a repository in an organization")
+		}
+	}
+
 	input := repoCreateInput{
 		Name:              repoToCreate.RepoName(),
 		Visibility:        opts.Visibility,
@@ -675,6 +729,56 @@ func localInit(io *iostreams.IOStreams, remoteURL, path, checkoutBranch string)
 	return run.PrepareCmd(gitCheckout).Run()
 }
 
+// interactiveTemplate offers the template repositories of the owner that the
+// new repository will belong to. It returns an empty string when the owner has
+// no template repositories or the user declines to use one.
+func interactiveTemplate(client *http.Client, hostname, repoWithOwner string) (string, error) {
+	owner := ""
+	if idx := strings.IndexRune(repoWithOwner, '/'); idx > 0 {
+		owner = repoWithOwner[0:idx]
+	} else {
+		apiClient := api.NewClientFromHTTP(client)
+		currentUser, err := api.CurrentLoginName(apiClient, hostname)
+		if err != nil {
+			return "", err
+		}
+		owner = currentUser
+	}
+
+	templates, err := listTemplateRepositories(client, hostname, owner)
+	if err != nil {
+		return "", err
+	}
+	if len(templates) == 0 {
+		return "", nil
+	}
+
+	var fromTemplate bool
+	confirmQuestion := &survey.Confirm{
+		Message: "Create the repository from a template repository?",
+		Default: false,
+	}
+	err = prompt.SurveyAskOne(confirmQuestion, &fromTemplate)
+	if err != nil {
+		return "", err
+	}
+	if !fromTemplate {
+		return "", nil
+	}
+
+	var wantedTemplate string
+	templateQuestion := &survey.Select{
+		Message: "Choose a template repository",
+		Options: templates,
+	}
+	err = prompt.SurveyAskOne(templateQuestion, &wantedTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	return owner + "/" + wantedTemplate, nil
+}
+
 func interactiveGitIgnore(client *http.Client, hostname string) (string, error) {
 	var addGitIgnore bool
 	var addGitIgnoreSurvey []*survey.Question
@@ -770,8 +874,51 @@ func interactiveLicense(client *http.Client, hostname string) (string, error) {
 	return "", nil
 }
 
+// interactiveRepoOwner asks which account the new repository should belong to.
+// A blank login means the repository belongs to the current user.
+func interactiveRepoOwner(client *http.Client, hostname string) (repoOwner, error) {
+	owners, err := listRepoOwners(client, hostname)
+	if err != nil {
+		return repoOwner{}, err
+	}
+	if len(owners) < 2 {
+		return repoOwner{}, nil
+	}
+
+	options := make([]string, len(owners))
+	for i, owner := range owners {
+		options[i] = owner.Login
+	}
+
+	var selected string
+	ownerQuestion := &survey.Select{
+		Message: "Repository owner",
+		Options: options,
+	}
+	if err := prompt.SurveyAskOne(ownerQuestion, &selected); err != nil {
+		return repoOwner{}, err
+	}
+
+	for _, owner := range owners[1:] {
+		if owner.Login == selected {
+			return owner, nil
+		}
+	}
+	return repoOwner{}, nil
+}
+
+// visibilityOptions returns the visibilities that the owner of the new repository supports.
+func visibilityOptions(client *http.Client, hostname string, owner repoOwner) []string {
+	options := []string{"Public", "Private"}
+	// internal repositories are only available in organizations that belong to an enterprise
+	if owner.IsOrganization && organizationSupportsInternalVisibility(api.NewClientFromHTTP(client), hostname, owner.Login) {
+		options = append(options, "Internal")
+	}
+	return options
+}
+
 // name, description, and visibility
-func interactiveRepoInfo(defaultName string) (string, string, string, error) {
+func interactiveRepoInfo(defaultName string, visibilities []string) (string, string, string, error) {
 	qs := []*survey.Question{
 		{
 			Name: "repoName",
@@ -788,7 +935,7 @@ func interactiveRepoInfo(defaultName string) (string, string, string, error) {
 			Name: "repoVisibility",
 			Prompt: &survey.Select{
 				Message: "Visibility",
-				Options: []string{"Public", "Private", "Internal"},
+				Options: visibilities,
 			},
 		}}
 
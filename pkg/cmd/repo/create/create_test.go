@@ -101,6 +101,12 @@ func TestNewCmdCreate(t *testing.T) {
 			wantsErr: true,
 			errMsg:   "the `--source` option is not supported with `--clone`, `--template`, `--license`, or `--gitignore`",
 		},
+		{
+			name:     "include all branches without template",
+			cli:      "REPO --private --include-all-branches",
+			wantsErr: true,
+			errMsg:   "the `--include-all-branches` option is only supported when using `--template`",
+		},
 	}
 
 	for _, tt := range tests {
@@ -171,33 +177,28 @@ func Test_createRun(t *testing.T) {
 			tty:        true,
 			wantStdout: "✓ Created repository OWNER/REPO on GitHub\n",
 			askStubs: func(as *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne("Create a new repository on GitHub from scratch")
-				//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-				as.Stub([]*prompt.QuestionStub{
-					{Name: "repoName", Value: "REPO"},
-					{Name: "repoDescription", Value: "my new repo"},
-					{Name: "repoVisibility", Value: "Private"},
-				})
-				//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-				as.Stub([]*prompt.QuestionStub{
-					{Name: "addGitIgnore", Value: true}})
-				//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-				as.Stub([]*prompt.QuestionStub{
-					{Name: "chooseGitIgnore", Value: "Go"}})
-				//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-				as.Stub([]*prompt.QuestionStub{
-					{Name: "addLicense", Value: true}})
-				//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-				as.Stub([]*prompt.QuestionStub{
-					{Name: "chooseLicense", Value: "GNU Lesser General Public License v3.0"}})
-				//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-				as.Stub([]*prompt.QuestionStub{
-					{Name: "confirmSubmit", Value: true}})
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(true) //clone locally?
+				as.StubPrompt("What would you like to do?").AnswerWith("Create a new repository on GitHub from scratch")
+				as.StubPrompt("Repository name").AnswerWith("REPO")
+				as.StubPrompt("Description").AnswerWith("my new repo")
+				as.StubPrompt("Visibility").AnswerWith("Private")
+				as.StubPrompt("Create the repository from a template repository?").AnswerWith(false)
+				as.StubPrompt("Would you like to add a .gitignore?").AnswerWith(true)
+				as.StubPrompt("Choose a .gitignore template").AnswerWith("Go")
+				as.StubPrompt("Would you like to add a license?").AnswerWith(true)
+				as.StubPrompt("Choose a license").AnswerWith("GNU Lesser General Public License v3.0")
+				as.StubPrompt(`This will create "REPO" as a private repository on GitHub. Continue?`).AnswerWith(true)
+				as.StubPrompt("Clone the new repository locally?").AnswerWith(true)
 			},
 			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryOwnerList\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"OWNER","organizations":{"nodes":[],"pageInfo":{"hasNextPage":false}}}}}`))
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"OWNER"}}}`))
+				reg.Register(
+					httpmock.GraphQL(`query TemplateRepositoryList\b`),
+					httpmock.StringResponse(`{"data":{"repositoryOwner":{"repositories":{"nodes":[{"name":"TEMPLATE","isTemplate":true}],"pageInfo":{"hasNextPage":false}}}}}`))
 				reg.Register(
 					httpmock.REST("GET", "gitignore/templates"),
 					httpmock.StringResponse(`["Actionscript","Android","AppceleratorTitanium","Autotools","Bancha","C","C++","Go"]`))
@@ -213,28 +214,65 @@ func Test_createRun(t *testing.T) {
 				cs.Register(`git clone https://github.com/OWNER/REPO.git`, 0, "")
 			},
 		},
+		{
+			name:       "interactive create from scratch with template repository",
+			opts:       &CreateOptions{Interactive: true},
+			tty:        true,
+			wantStdout: "✓ Created repository OWNER/REPO on GitHub\n",
+			askStubs: func(as *prompt.AskStubber) {
+				as.StubPrompt("What would you like to do?").AnswerWith("Create a new repository on GitHub from scratch")
+				as.StubPrompt("Repository name").AnswerWith("REPO")
+				as.StubPrompt("Description").AnswerWith("my new repo")
+				as.StubPrompt("Visibility").AnswerWith("Private")
+				as.StubPrompt("Create the repository from a template repository?").AnswerWith(true)
+				as.StubPrompt("Choose a template repository").AnswerWith("TEMPLATE")
+				as.StubPrompt(`This will create "REPO" as a private repository on GitHub. Continue?`).AnswerWith(true)
+				as.StubPrompt("Clone the new repository locally?").AnswerWith(false)
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryOwnerList\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"OWNER","organizations":{"nodes":[],"pageInfo":{"hasNextPage":false}}}}}`))
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"OWNER"}}}`))
+				reg.Register(
+					httpmock.GraphQL(`query TemplateRepositoryList\b`),
+					httpmock.StringResponse(`{"data":{"repositoryOwner":{"repositories":{"nodes":[{"name":"TEMPLATE","isTemplate":true}],"pageInfo":{"hasNextPage":false}}}}}`))
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"id":"TPLID","defaultBranchRef":{"name":"main"}}}}`))
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"id":"USERID"}}}`))
+				reg.Register(
+					httpmock.GraphQL(`mutation CloneTemplateRepository\b`),
+					httpmock.StringResponse(`{"data":{"cloneTemplateRepository":{"repository":{"id":"REPOID","name":"REPO","owner":{"login":"OWNER"},"url":"https://github.com/OWNER/REPO"}}}}`))
+			},
+		},
 		{
 			name: "interactive create from scratch but cancel before submit",
 			opts: &CreateOptions{Interactive: true},
 			tty:  true,
 			askStubs: func(as *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne("Create a new repository on GitHub from scratch")
-				//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-				as.Stub([]*prompt.QuestionStub{
-					{Name: "repoName", Value: "REPO"},
-					{Name: "repoDescription", Value: "my new repo"},
-					{Name: "repoVisibility", Value: "Private"},
-				})
-				//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-				as.Stub([]*prompt.QuestionStub{
-					{Name: "addGitIgnore", Value: false}})
-				//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-				as.Stub([]*prompt.QuestionStub{
-					{Name: "addLicense", Value: false}})
-				//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-				as.Stub([]*prompt.QuestionStub{
-					{Name: "confirmSubmit", Value: false}})
+				as.StubPrompt("What would you like to do?").AnswerWith("Create a new repository on GitHub from scratch")
+				as.StubPrompt("Repository name").AnswerWith("REPO")
+				as.StubPrompt("Description").AnswerWith("my new repo")
+				as.StubPrompt("Visibility").AnswerWith("Private")
+				as.StubPrompt("Would you like to add a .gitignore?").AnswerWith(false)
+				as.StubPrompt("Would you like to add a license?").AnswerWith(false)
+				as.StubPrompt(`This will create "REPO" as a private repository on GitHub. Continue?`).AnswerWith(false)
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryOwnerList\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"OWNER","organizations":{"nodes":[],"pageInfo":{"hasNextPage":false}}}}}`))
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"OWNER"}}}`))
+				reg.Register(
+					httpmock.GraphQL(`query TemplateRepositoryList\b`),
+					httpmock.StringResponse(`{"data":{"repositoryOwner":{"repositories":{"nodes":[],"pageInfo":{"hasNextPage":false}}}}}`))
 			},
 			wantStdout: "",
 			wantErr:    true,
@@ -245,20 +283,17 @@ func Test_createRun(t *testing.T) {
 			opts: &CreateOptions{Interactive: true},
 			tty:  true,
 			askStubs: func(as *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne("Push an existing local repository to GitHub")
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(".")
-				//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-				as.Stub([]*prompt.QuestionStub{
-					{Name: "repoName", Value: "REPO"},
-					{Name: "repoDescription", Value: "my new repo"},
-					{Name: "repoVisibility", Value: "Private"},
-				})
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(false)
+				as.StubPrompt("What would you like to do?").AnswerWith("Push an existing local repository to GitHub")
+				as.StubPrompt("Path to local repository").AnswerWith(".")
+				as.StubPrompt("Repository name").AnswerWith("REPO")
+				as.StubPrompt("Description").AnswerWith("my new repo")
+				as.StubPrompt("Visibility").AnswerWith("Private")
+				as.StubPrompt("Add a remote?").AnswerWith(false)
 			},
 			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryOwnerList\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"OWNER","organizations":{"nodes":[],"pageInfo":{"hasNextPage":false}}}}}`))
 				reg.Register(
 					httpmock.GraphQL(`mutation RepositoryCreate\b`),
 					httpmock.StringResponse(`
@@ -286,24 +321,19 @@ func Test_createRun(t *testing.T) {
 			opts: &CreateOptions{Interactive: true},
 			tty:  true,
 			askStubs: func(as *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne("Push an existing local repository to GitHub")
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(".")
-				//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-				as.Stub([]*prompt.QuestionStub{
-					{Name: "repoName", Value: "REPO"},
-					{Name: "repoDescription", Value: "my new repo"},
-					{Name: "repoVisibility", Value: "Private"},
-				})
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(true) //ask for adding a remote
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne("origin") //ask for remote name
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(false) //ask to push to remote
+				as.StubPrompt("What would you like to do?").AnswerWith("Push an existing local repository to GitHub")
+				as.StubPrompt("Path to local repository").AnswerWith(".")
+				as.StubPrompt("Repository name").AnswerWith("REPO")
+				as.StubPrompt("Description").AnswerWith("my new repo")
+				as.StubPrompt("Visibility").AnswerWith("Private")
+				as.StubPrompt("Add a remote?").AnswerWith(true)
+				as.StubPrompt("What should the new remote be called?").AnswerWith("origin")
+				as.StubPrompt(`Would you like to push commits from the current branch to the "origin"?`).AnswerWith(false)
 			},
 			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryOwnerList\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"OWNER","organizations":{"nodes":[],"pageInfo":{"hasNextPage":false}}}}}`))
 				reg.Register(
 					httpmock.GraphQL(`mutation RepositoryCreate\b`),
 					httpmock.StringResponse(`
@@ -332,24 +362,19 @@ func Test_createRun(t *testing.T) {
 			opts: &CreateOptions{Interactive: true},
 			tty:  true,
 			askStubs: func(as *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne("Push an existing local repository to GitHub")
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(".")
-				//nolint:staticcheck // SA1019: as.Stub is deprecated: use StubPrompt
-				as.Stub([]*prompt.QuestionStub{
-					{Name: "repoName", Value: "REPO"},
-					{Name: "repoDescription", Value: "my new repo"},
-					{Name: "repoVisibility", Value: "Private"},
-				})
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(true) //ask for adding a remote
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne("origin") //ask for remote name
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(true) //ask to push to remote
+				as.StubPrompt("What would you like to do?").AnswerWith("Push an existing local repository to GitHub")
+				as.StubPrompt("Path to local repository").AnswerWith(".")
+				as.StubPrompt("Repository name").AnswerWith("REPO")
+				as.StubPrompt("Description").AnswerWith("my new repo")
+				as.StubPrompt("Visibility").AnswerWith("Private")
+				as.StubPrompt("Add a remote?").AnswerWith(true)
+				as.StubPrompt("What should the new remote be called?").AnswerWith("origin")
+				as.StubPrompt(`Would you like to push commits from the current branch to the "origin"?`).AnswerWith(true)
 			},
 			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryOwnerList\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"OWNER","organizations":{"nodes":[],"pageInfo":{"hasNextPage":false}}}}}`))
 				reg.Register(
 					httpmock.GraphQL(`mutation RepositoryCreate\b`),
 					httpmock.StringResponse(`
@@ -374,6 +399,75 @@ func Test_createRun(t *testing.T) {
 			},
 			wantStdout: "✓ Created repository OWNER/REPO on GitHub\n✓ Added remote https://github.com/OWNER/REPO.git\n✓ Pushed commits to https://github.com/OWNER/REPO.git\n",
 		},
+		{
+			name:       "interactive create from scratch in an organization",
+			opts:       &CreateOptions{Interactive: true},
+			tty:        true,
+			wantStdout: "✓ Created repository ORG/REPO on GitHub\n",
+			askStubs: func(as *prompt.AskStubber) {
+				as.StubPrompt("What would you like to do?").AnswerWith("Create a new repository on GitHub from scratch")
+				as.StubPrompt("Repository owner").AnswerWith("ORG")
+				as.StubPrompt("Repository name").AnswerWith("REPO")
+				as.StubPrompt("Description").AnswerWith("my new repo")
+				as.StubPrompt("Visibility").AnswerWith("Internal")
+				as.StubPrompt("Would you like to add a .gitignore?").AnswerWith(false)
+				as.StubPrompt("Would you like to add a license?").AnswerWith(false)
+				as.StubPrompt(`This will create "ORG/REPO" as a internal repository on GitHub. Continue?`).AnswerWith(true)
+				as.StubPrompt("Clone the new repository locally?").AnswerWith(false)
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryOwnerList\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"OWNER","organizations":{"nodes":[{"login":"ORG","viewerCanCreateRepositories":true},{"login":"OTHERORG","viewerCanCreateRepositories":false}],"pageInfo":{"hasNextPage":false}}}}}`))
+				reg.Register(
+					httpmock.REST("GET", "orgs/ORG"),
+					httpmock.StringResponse(`{"plan":{"name":"enterprise"}}`))
+				reg.Register(
+					httpmock.GraphQL(`query TemplateRepositoryList\b`),
+					httpmock.StringResponse(`{"data":{"repositoryOwner":{"repositories":{"nodes":[],"pageInfo":{"hasNextPage":false}}}}}`))
+				reg.Register(
+					httpmock.REST("GET", "users/ORG"),
+					httpmock.StringResponse(`{"node_id":"ORGID","type":"Organization"}`))
+				reg.Register(
+					httpmock.GraphQL(`mutation RepositoryCreate\b`),
+					httpmock.StringResponse(`
+					{
+						"data": {
+							"createRepository": {
+								"repository": {
+									"id": "REPOID",
+									"name": "REPO",
+									"owner": {"login":"ORG"},
+									"url": "https://github.com/ORG/REPO"
+								}
+							}
+						}
+					}`))
+			},
+		},
+		{
+			name: "noninteractive create internal without organization",
+			opts: &CreateOptions{
+				Interactive: false,
+				Name:        "REPO",
+				Visibility:  "INTERNAL",
+			},
+			tty:     false,
+			wantErr: true,
+			errMsg:  "internal repositories can only be created within an organization",
+		},
+		{
+			name: "noninteractive create with team without organization",
+			opts: &CreateOptions{
+				Interactive: false,
+				Name:        "REPO",
+				Visibility:  "PRIVATE",
+				Team:        "monkeys",
+			},
+			tty:     false,
+			wantErr: true,
+			errMsg:  "the `--team` option is only supported when creating a repository in an organization",
+		},
 		{
 			name: "noninteractive create from scratch",
 			opts: &CreateOptions{
@@ -436,9 +530,7 @@ func Test_createRun(t *testing.T) {
 		},
 	}
 	for _, tt := range tests {
-		//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-		q, teardown := prompt.InitAskStubber()
-		defer teardown()
+		q := prompt.NewAskStubber(t)
 		if tt.askStubs != nil {
 			tt.askStubs(q)
 		}
@@ -3,11 +3,14 @@ package create
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
 )
 
 // repoCreateInput is input parameters for the repoCreate method
@@ -23,6 +26,7 @@ type repoCreateInput struct {
 	HasWikiEnabled       bool
 	GitIgnoreTemplate    string
 	LicenseTemplate      string
+	IncludeAllBranches   bool
 }
 
 // createRepositoryInputV3 is the payload for the repo create REST API
@@ -53,11 +57,12 @@ type createRepositoryInput struct {
 
 // cloneTemplateRepositoryInput is the payload for creating a repo from a template using GraphQL
 type cloneTemplateRepositoryInput struct {
-	Name         string `json:"name"`
-	Visibility   string `json:"visibility"`
-	Description  string `json:"description,omitempty"`
-	OwnerID      string `json:"ownerId"`
-	RepositoryID string `json:"repositoryId"`
+	Name               string `json:"name"`
+	Visibility         string `json:"visibility"`
+	Description        string `json:"description,omitempty"`
+	OwnerID            string `json:"ownerId"`
+	RepositoryID       string `json:"repositoryId"`
+	IncludeAllBranches bool   `json:"includeAllBranches"`
 }
 
 // repoCreate creates a new GitHub repository
@@ -104,11 +109,12 @@ func repoCreate(client *http.Client, hostname string, input repoCreateInput) (*a
 
 		variables := map[string]interface{}{
 			"input": cloneTemplateRepositoryInput{
-				Name:         input.Name,
-				Description:  input.Description,
-				Visibility:   strings.ToUpper(input.Visibility),
-				OwnerID:      ownerID,
-				RepositoryID: input.TemplateRepositoryID,
+				Name:               input.Name,
+				Description:        input.Description,
+				Visibility:         strings.ToUpper(input.Visibility),
+				OwnerID:            ownerID,
+				RepositoryID:       input.TemplateRepositoryID,
+				IncludeAllBranches: input.IncludeAllBranches,
 			},
 		}
 
@@ -230,6 +236,176 @@ func resolveOrganizationTeam(client *api.Client, hostname, orgName, teamSlug str
 	return &response, err
 }
 
+// repoOwner is an account in which the current user is allowed to create repositories
+type repoOwner struct {
+	Login          string
+	IsOrganization bool
+}
+
+// listRepoOwners returns the current user followed by the organizations in
+// which the user is allowed to create repositories.
+func listRepoOwners(httpClient *http.Client, hostname string) ([]repoOwner, error) {
+	var response struct {
+		Viewer struct {
+			Login         string
+			Organizations struct {
+				Nodes []struct {
+					Login                       string
+					ViewerCanCreateRepositories bool
+				}
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			}
+		}
+	}
+
+	query := `
+	query RepositoryOwnerList($endCursor: String) {
+		viewer {
+			login
+			organizations(first: 100, after: $endCursor) {
+				nodes {
+					login
+					viewerCanCreateRepositories
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}`
+
+	client := api.NewClientFromHTTP(httpClient)
+	variables := map[string]interface{}{}
+
+	var owners []repoOwner
+	for {
+		if err := client.GraphQL(hostname, query, variables, &response); err != nil {
+			return nil, err
+		}
+		if len(owners) == 0 {
+			owners = append(owners, repoOwner{Login: response.Viewer.Login})
+		}
+		for _, org := range response.Viewer.Organizations.Nodes {
+			if org.ViewerCanCreateRepositories {
+				owners = append(owners, repoOwner{Login: org.Login, IsOrganization: true})
+			}
+		}
+		if !response.Viewer.Organizations.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = response.Viewer.Organizations.PageInfo.EndCursor
+	}
+
+	return owners, nil
+}
+
+// organizationSupportsInternalVisibility reports whether an organization can host
+// internal repositories, which requires an enterprise plan. The plan is not visible
+// to every member; when it cannot be determined the answer is optimistic and the
+// API remains the authority.
+func organizationSupportsInternalVisibility(client *api.Client, hostname, orgLogin string) bool {
+	var response struct {
+		Plan struct {
+			Name string `json:"name"`
+		} `json:"plan"`
+	}
+	if err := client.REST(hostname, "GET", fmt.Sprintf("orgs/%s", orgLogin), nil, &response); err != nil {
+		return true
+	}
+	if response.Plan.Name == "" {
+		return true
+	}
+	return response.Plan.Name == "enterprise"
+}
+
+// listTemplateRepositories returns the names of the template repositories owned by owner.
+func listTemplateRepositories(httpClient *http.Client, hostname, owner string) ([]string, error) {
+	var response struct {
+		RepositoryOwner struct {
+			Repositories struct {
+				Nodes []struct {
+					Name       string
+					IsTemplate bool
+				}
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			}
+		}
+	}
+
+	query := `
+	query TemplateRepositoryList($owner: String!, $perPage: Int!, $endCursor: String) {
+		repositoryOwner(login: $owner) {
+			repositories(first: $perPage, after: $endCursor, ownerAffiliations: OWNER, orderBy: {field: PUSHED_AT, direction: DESC}) {
+				nodes {
+					name
+					isTemplate
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}`
+
+	client := api.NewClientFromHTTP(httpClient)
+	variables := map[string]interface{}{
+		"owner":   owner,
+		"perPage": 100,
+	}
+
+	var templates []string
+	for {
+		if err := client.GraphQL(hostname, query, variables, &response); err != nil {
+			return nil, err
+		}
+		for _, repo := range response.RepositoryOwner.Repositories.Nodes {
+			if repo.IsTemplate {
+				templates = append(templates, repo.Name)
+			}
+		}
+		if !response.RepositoryOwner.Repositories.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = response.RepositoryOwner.Repositories.PageInfo.EndCursor
+	}
+
+	return templates, nil
+}
+
+// waitForBranch polls the repository until branch exists. A repository
+// generated from a template is populated asynchronously, so right after
+// creation its default branch may not be available to clone yet.
+func waitForBranch(httpClient *http.Client, repo ghrepo.Interface, branch string) error {
+	client := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/branches/%s", ghrepo.FullName(repo), branch)
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		var response struct {
+			Name string `json:"name"`
+		}
+		err := client.REST(repo.RepoHost(), "GET", path, nil, &response)
+		if err == nil {
+			return nil
+		}
+		var httpErr api.HTTPError
+		if !errors.As(err, &httpErr) || httpErr.StatusCode != 404 {
+			return err
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for %s to be generated from the template repository", ghrepo.FullName(repo))
+		}
+		time.Sleep(time.Second)
+	}
+}
+
 // listGitIgnoreTemplates uses API v3 here because gitignore template isn't supported by GraphQL yet.
 func listGitIgnoreTemplates(httpClient *http.Client, hostname string) ([]string, error) {
 	var gitIgnoreTemplates []string
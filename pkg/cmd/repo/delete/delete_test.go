@@ -94,8 +94,7 @@ func Test_deleteRun(t *testing.T) {
 			askStubs: func(q *prompt.AskStubber) {
 				// TODO: survey stubber doesn't have WithValidator support
 				// so this always passes regardless of prompt input
-				//nolint:staticcheck // SA1019: q.StubOne is deprecated: use StubPrompt
-				q.StubOne("OWNER/REPO")
+				q.StubPrompt("Type OWNER/REPO to confirm deletion:").AnswerWith("OWNER/REPO")
 			},
 			httpStubs: func(reg *httpmock.Registry) {
 				reg.Register(
@@ -109,8 +108,7 @@ func Test_deleteRun(t *testing.T) {
 			opts:       &DeleteOptions{},
 			wantStdout: "✓ Deleted repository OWNER/REPO\n",
 			askStubs: func(q *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: q.StubOne is deprecated: use StubPrompt
-				q.StubOne("OWNER/REPO")
+				q.StubPrompt("Type OWNER/REPO to confirm deletion:").AnswerWith("OWNER/REPO")
 			},
 			httpStubs: func(reg *httpmock.Registry) {
 				reg.Register(
@@ -136,8 +134,7 @@ func Test_deleteRun(t *testing.T) {
 			wantStdout: "✓ Deleted repository OWNER/REPO\n",
 			tty:        true,
 			askStubs: func(q *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: q.StubOne is deprecated: use StubPrompt
-				q.StubOne("OWNER/REPO")
+				q.StubPrompt("Type OWNER/REPO to confirm deletion:").AnswerWith("OWNER/REPO")
 			},
 			httpStubs: func(reg *httpmock.Registry) {
 				reg.Register(
@@ -150,9 +147,7 @@ func Test_deleteRun(t *testing.T) {
 		},
 	}
 	for _, tt := range tests {
-		//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-		q, teardown := prompt.InitAskStubber()
-		defer teardown()
+		q := prompt.NewAskStubber(t)
 		if tt.askStubs != nil {
 			tt.askStubs(q)
 		}
@@ -0,0 +1,157 @@
+package download
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DownloadOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	IO         *iostreams.IOStreams
+
+	RepoArg     string
+	Ref         string
+	Destination string
+	Paths       []string
+}
+
+func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobra.Command {
+	opts := &DownloadOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "download [<repository>]",
+		Short: "Download and extract the contents of a repository",
+		Long: heredoc.Doc(`
+			Download a snapshot of a repository at a given ref and extract it
+			into a directory, without creating a local clone.
+
+			With no argument, downloads the current repository. Without '--ref',
+			the default branch is used.
+		`),
+		Example: heredoc.Doc(`
+			# download the default branch of the current repository
+			$ gh repo download
+
+			# download a tag of another repository into a directory
+			$ gh repo download cli/cli --ref v2.0.0 --dir cli-src
+
+			# only extract selected paths
+			$ gh repo download cli/cli --path docs --path LICENSE
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return downloadRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Ref, "ref", "", "The branch, tag, or commit SHA to download")
+	cmd.Flags().StringVarP(&opts.Destination, "dir", "D", ".", "The directory to extract files into")
+	cmd.Flags().StringArrayVarP(&opts.Paths, "path", "p", nil, "Extract only files under the specified path (may be given multiple times)")
+
+	return cmd
+}
+
+func downloadRun(opts *DownloadOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var toDownload ghrepo.Interface
+	if opts.RepoArg == "" {
+		toDownload, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	} else {
+		repoSelector := opts.RepoArg
+		if !strings.Contains(repoSelector, "/") {
+			cfg, err := opts.Config()
+			if err != nil {
+				return err
+			}
+
+			hostname, err := cfg.DefaultHost()
+			if err != nil {
+				return err
+			}
+
+			currentUser, err := api.CurrentLoginName(apiClient, hostname)
+			if err != nil {
+				return err
+			}
+			repoSelector = currentUser + "/" + repoSelector
+		}
+
+		toDownload, err = ghrepo.FromFullName(repoSelector)
+		if err != nil {
+			return fmt.Errorf("argument error: %w", err)
+		}
+	}
+
+	archiveURL := fmt.Sprintf("%srepos/%s/tarball/%s",
+		ghinstance.RESTPrefix(toDownload.RepoHost()), ghrepo.FullName(toDownload), opts.Ref)
+
+	opts.IO.StartProgressIndicator()
+	err = downloadArchive(httpClient, archiveURL, opts.Destination, opts.Paths)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		ref := opts.Ref
+		if ref == "" {
+			ref = "the default branch"
+		}
+		fmt.Fprintf(opts.IO.Out, "%s Downloaded %s at %s to %s\n",
+			cs.SuccessIcon(), ghrepo.FullName(toDownload), ref, opts.Destination)
+	}
+
+	return nil
+}
+
+func downloadArchive(httpClient *http.Client, archiveURL, destDir string, paths []string) error {
+	req, err := http.NewRequest("GET", archiveURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return extractTarball(resp.Body, destDir, paths)
+}
@@ -0,0 +1,175 @@
+package download
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdDownload(t *testing.T) {
+	tests := []struct {
+		name  string
+		cli   string
+		wants DownloadOptions
+	}{
+		{
+			name: "no arguments",
+			cli:  "",
+			wants: DownloadOptions{
+				Destination: ".",
+			},
+		},
+		{
+			name: "ref and dir",
+			cli:  "cli/cli --ref v1.0.0 --dir src",
+			wants: DownloadOptions{
+				RepoArg:     "cli/cli",
+				Ref:         "v1.0.0",
+				Destination: "src",
+			},
+		},
+		{
+			name: "path filters",
+			cli:  "-p docs -p LICENSE",
+			wants: DownloadOptions{
+				Destination: ".",
+				Paths:       []string{"docs", "LICENSE"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *DownloadOptions
+			cmd := NewCmdDownload(f, func(opts *DownloadOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.RepoArg, gotOpts.RepoArg)
+			assert.Equal(t, tt.wants.Ref, gotOpts.Ref)
+			assert.Equal(t, tt.wants.Destination, gotOpts.Destination)
+			assert.Equal(t, tt.wants.Paths, gotOpts.Paths)
+		})
+	}
+}
+
+func TestDownloadRun(t *testing.T) {
+	archive := makeTarball(t, map[string]string{
+		"OWNER-REPO-abc123/README.md":     "hello",
+		"OWNER-REPO-abc123/docs/api.md":   "api docs",
+		"OWNER-REPO-abc123/internal/x.go": "package x",
+	})
+
+	tests := []struct {
+		name      string
+		opts      DownloadOptions
+		wantPath  string
+		wantFiles []string
+		skipFiles []string
+	}{
+		{
+			name:      "downloads the default branch",
+			opts:      DownloadOptions{},
+			wantPath:  "repos/OWNER/REPO/tarball/",
+			wantFiles: []string{"README.md", "docs/api.md", "internal/x.go"},
+		},
+		{
+			name: "downloads a ref",
+			opts: DownloadOptions{
+				Ref: "v1.0.0",
+			},
+			wantPath:  "repos/OWNER/REPO/tarball/v1.0.0",
+			wantFiles: []string{"README.md"},
+		},
+		{
+			name: "extracts only matching paths",
+			opts: DownloadOptions{
+				Paths: []string{"docs"},
+			},
+			wantPath:  "repos/OWNER/REPO/tarball/",
+			wantFiles: []string{"docs/api.md"},
+			skipFiles: []string{"README.md", "internal/x.go"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			reg.Register(
+				httpmock.REST("GET", tt.wantPath),
+				httpmock.StringResponse(string(archive)))
+
+			io, _, _, _ := iostreams.Test()
+
+			opts := tt.opts
+			opts.IO = io
+			opts.Destination = t.TempDir()
+			opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			err := downloadRun(&opts)
+			assert.NoError(t, err)
+
+			for _, f := range tt.wantFiles {
+				_, err := os.Stat(filepath.Join(opts.Destination, filepath.FromSlash(f)))
+				assert.NoError(t, err)
+			}
+			for _, f := range tt.skipFiles {
+				_, err := os.Stat(filepath.Join(opts.Destination, filepath.FromSlash(f)))
+				assert.True(t, os.IsNotExist(err))
+			}
+		})
+	}
+}
+
+func makeTarball(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+	for name, contents := range files {
+		err := tw.WriteHeader(&tar.Header{
+			Name: name,
+			Mode: 0644,
+			Size: int64(len(contents)),
+		})
+		assert.NoError(t, err)
+		_, err = tw.Write([]byte(contents))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, tw.Close())
+	assert.NoError(t, gw.Close())
+	return buf.Bytes()
+}
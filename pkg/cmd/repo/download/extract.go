@@ -0,0 +1,116 @@
+package download
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	dirMode  os.FileMode = 0755
+	fileMode os.FileMode = 0644
+	execMode os.FileMode = 0755
+)
+
+// extractTarball extracts a gzip-compressed tarball into destDir, stripping
+// the archive's top-level directory. When paths is non-empty, only entries
+// under one of the given path prefixes are extracted.
+func extractTarball(r io.Reader, destDir string, paths []string) error {
+	destDirAbs, err := filepath.Abs(destDir)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(destDirAbs, dirMode); err != nil {
+		return err
+	}
+	pathPrefix := destDirAbs + string(filepath.Separator)
+
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		name := stripFirstComponent(hdr.Name)
+		if name == "" || !matchPath(paths, name) {
+			continue
+		}
+
+		fpath := filepath.Join(destDirAbs, filepath.FromSlash(name))
+		if !strings.HasPrefix(fpath, pathPrefix) {
+			continue
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(fpath, dirMode); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := extractTarFile(tr, hdr, fpath); err != nil {
+				return fmt.Errorf("error extracting %q: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func extractTarFile(tr *tar.Reader, hdr *tar.Header, dest string) error {
+	if dir := filepath.Dir(dest); dir != "." {
+		if err := os.MkdirAll(dir, dirMode); err != nil {
+			return err
+		}
+	}
+
+	df, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_EXCL, getPerm(hdr.FileInfo().Mode()))
+	if err != nil {
+		return err
+	}
+	defer df.Close()
+
+	_, err = io.Copy(df, tr)
+	return err
+}
+
+// stripFirstComponent removes the "OWNER-REPO-SHA/" prefix that GitHub
+// archives wrap their contents in.
+func stripFirstComponent(name string) string {
+	if idx := strings.IndexRune(name, '/'); idx >= 0 {
+		return name[idx+1:]
+	}
+	return ""
+}
+
+func matchPath(paths []string, name string) bool {
+	if len(paths) == 0 {
+		return true
+	}
+	for _, p := range paths {
+		p = strings.TrimSuffix(p, "/")
+		if name == p || strings.HasPrefix(name, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func getPerm(m os.FileMode) os.FileMode {
+	if m&0111 == 0 {
+		return fileMode
+	}
+	return execMode
+}
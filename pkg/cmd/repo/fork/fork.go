@@ -319,6 +319,11 @@ func forkRun(opts *ForkOptions) error {
 				return err
 			}
 
+			// push to the fork by default even when pulling from upstream
+			if err := git.SetConfig(cloneDir, "remote.pushDefault", "origin"); err != nil {
+				return err
+			}
+
 			if connectedToTerminal {
 				fmt.Fprintf(stderr, "%s Cloned fork\n", cs.SuccessIcon())
 			}
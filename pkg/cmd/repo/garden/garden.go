@@ -17,6 +17,7 @@ import (
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/text"
 	"github.com/cli/cli/v2/utils"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
@@ -55,6 +56,7 @@ const (
 	DirLeft
 	DirRight
 	Quit
+	Redraw
 )
 
 type Direction = int
@@ -93,6 +95,7 @@ type GardenOptions struct {
 	Config     func() (config.Config, error)
 
 	RepoArg string
+	Offline bool
 }
 
 func NewCmdGarden(f *cmdutil.Factory, runF func(*GardenOptions) error) *cobra.Command {
@@ -106,7 +109,7 @@ func NewCmdGarden(f *cmdutil.Factory, runF func(*GardenOptions) error) *cobra.Co
 	cmd := &cobra.Command{
 		Use:    "garden [<repository>]",
 		Short:  "Explore a git repository as a garden",
-		Long:   "Use arrow keys, WASD or vi keys to move. q to quit.",
+		Long:   "Use arrow keys, WASD or vi keys to move. q to quit.\n\nWith --offline, the garden is planted from the local git history without talking to the API.",
 		Hidden: true,
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
@@ -119,6 +122,8 @@ func NewCmdGarden(f *cmdutil.Factory, runF func(*GardenOptions) error) *cobra.Co
 		},
 	}
 
+	cmd.Flags().BoolVar(&opts.Offline, "offline", false, "Plant the garden from the local git history instead of the API")
+
 	return cmd
 }
 
@@ -151,6 +156,9 @@ func gardenRun(opts *GardenOptions) error {
 		var err error
 		viewURL := opts.RepoArg
 		if !strings.Contains(viewURL, "/") {
+			if opts.Offline {
+				return errors.New("a full OWNER/REPO name is required in offline mode")
+			}
 			cfg, err := opts.Config()
 			if err != nil {
 				return err
@@ -193,12 +201,20 @@ func gardenRun(opts *GardenOptions) error {
 
 	maxCommits := (geo.Width * geo.Height) / 2
 
-	opts.IO.StartProgressIndicator()
-	fmt.Fprintln(out, "gathering commits; this could take a minute...")
-	commits, err := getCommits(httpClient, toView, maxCommits)
-	opts.IO.StopProgressIndicator()
-	if err != nil {
-		return err
+	var commits []*Commit
+	if opts.Offline {
+		commits, err = getLocalCommits(maxCommits)
+		if err != nil {
+			return err
+		}
+	} else {
+		opts.IO.StartProgressIndicator()
+		fmt.Fprintln(out, "gathering commits; this could take a minute...")
+		commits, err = getCommits(httpClient, toView, maxCommits)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
 	}
 	player := &Player{0, 0, cs.Bold("@"), geo, 0}
 
@@ -221,6 +237,16 @@ func gardenRun(opts *GardenOptions) error {
 	}
 
 	dirc := make(chan Direction)
+
+	visitedFlowers := map[string]bool{}
+
+	// repaint the garden when the terminal is resized so the status line
+	// matches the new width
+	stopResizeListener := opts.IO.StartResizeListener(func() {
+		dirc <- Redraw
+	})
+	defer stopResizeListener()
+
 	go func() {
 		b := make([]byte, 3)
 		for {
@@ -248,6 +274,10 @@ mainLoop:
 		d := <-dirc
 		if d == Quit {
 			break mainLoop
+		} else if d == Redraw {
+			clear(opts.IO)
+			drawGarden(opts.IO, garden, player)
+			continue mainLoop
 		} else if !player.move(d) {
 			continue mainLoop
 		}
@@ -255,6 +285,10 @@ mainLoop:
 		underPlayer := garden[player.Y][player.X]
 		previousCell := garden[oldY][oldX]
 
+		if strings.Contains(underPlayer.StatusLine, "flower called") {
+			visitedFlowers[fmt.Sprintf("%d,%d", player.X, player.Y)] = true
+		}
+
 		// print whatever was just under player
 
 		fmt.Fprint(out, "\033[;H") // move to top left
@@ -305,7 +339,7 @@ mainLoop:
 	_ = term.Restore(int(os.Stdout.Fd()), oldTermState)
 	fmt.Fprintln(out, cs.Bold("You turn and walk away from the wildflower garden..."))
 
-	return nil
+	return recordVisit(opts.IO, gardenStateFilePath(), ghrepo.FullName(toView), len(visitedFlowers))
 }
 
 func isLeft(b []byte) bool {
@@ -457,10 +491,7 @@ func statusLine(garden [][]*Cell, player *Player, io *iostreams.IOStreams) strin
 	}
 
 	for i, line := range statusLines {
-		if len(line) < width {
-			paddingSize := width - len(line)
-			statusLines[i] = line + strings.Repeat(" ", paddingSize)
-		}
+		statusLines[i] = text.PadRight(width, line)
 	}
 
 	return strings.Join(statusLines, "\n")
@@ -0,0 +1,50 @@
+package garden
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/run"
+)
+
+// getLocalCommits reads commits from the local git history so that a garden
+// can be planted without talking to the API.
+func getLocalCommits(maxCommits int) ([]*Commit, error) {
+	logCmd, err := git.GitCommand("log", "--format=%H %an", fmt.Sprintf("--max-count=%d", maxCommits))
+	if err != nil {
+		return nil, err
+	}
+	output, err := run.PrepareCmd(logCmd).Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the local git history: %w", err)
+	}
+
+	commits := []*Commit{}
+	for _, line := range strings.Split(strings.TrimSuffix(string(output), "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+		sha := line
+		handle := ""
+		if idx := strings.IndexRune(line, ' '); idx >= 0 {
+			sha, handle = line[:idx], line[idx+1:]
+		}
+		if handle == "" {
+			handle = "a mysterious stranger"
+		}
+		colorFunc := shaToColorFunc(sha)
+		commits = append(commits, &Commit{
+			Handle: handle,
+			Sha:    sha,
+			Char:   colorFunc(string(handle[0])),
+		})
+	}
+
+	// reverse to get older commits first
+	for i, j := 0, len(commits)-1; i < j; i, j = i+1, j-1 {
+		commits[i], commits[j] = commits[j], commits[i]
+	}
+
+	return commits, nil
+}
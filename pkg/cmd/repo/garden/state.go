@@ -0,0 +1,74 @@
+package garden
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// gardenState persists results between visits so that a player can try to
+// beat their previous flower count for a repository.
+type gardenState struct {
+	HighScores map[string]int `yaml:"high_scores"`
+}
+
+func gardenStateFilePath() string {
+	return filepath.Join(config.StateDir(), "garden.yml")
+}
+
+func loadGardenState(stateFilePath string) (*gardenState, error) {
+	state := &gardenState{HighScores: map[string]int{}}
+	content, err := ioutil.ReadFile(stateFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+		return nil, err
+	}
+	if err := yaml.Unmarshal(content, state); err != nil {
+		return nil, err
+	}
+	if state.HighScores == nil {
+		state.HighScores = map[string]int{}
+	}
+	return state, nil
+}
+
+func saveGardenState(stateFilePath string, state *gardenState) error {
+	content, err := yaml.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(stateFilePath), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(stateFilePath, content, 0600)
+}
+
+// recordVisit reports how many flowers were visited and updates the stored
+// high score for the repository when it was beaten.
+func recordVisit(io *iostreams.IOStreams, stateFilePath, repoName string, flowersVisited int) error {
+	state, err := loadGardenState(stateFilePath)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(io.Out, "You visited %s.\n", utils.Pluralize(flowersVisited, "flower"))
+
+	if flowersVisited <= state.HighScores[repoName] {
+		return nil
+	}
+
+	if state.HighScores[repoName] > 0 {
+		cs := io.ColorScheme()
+		fmt.Fprintln(io.Out, cs.Bold("A new personal best for this garden!"))
+	}
+	state.HighScores[repoName] = flowersVisited
+	return saveGardenState(stateFilePath, state)
+}
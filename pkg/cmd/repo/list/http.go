@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/pkg/githubsearch"
@@ -87,6 +88,84 @@ func listRepos(client *http.Client, hostname string, limit int, owner string, fi
 		}
 	}`, strings.Join(inputs, ","), ownerConnection, api.RepositoryGraphQL(filter.Fields))
 
+	apiClient := api.NewClientFromHTTP(client)
+	listResult := RepositoryList{}
+	var mu sync.Mutex
+
+	fetchPage := func(offset, pageSize int) (interface{}, int, error) {
+		pageVariables := map[string]interface{}{}
+		for name, value := range variables {
+			pageVariables[name] = value
+		}
+		pageVariables["perPage"] = githubv4.Int(pageSize)
+		if offset > 0 {
+			pageVariables["endCursor"] = githubv4.String(api.OffsetCursor(offset))
+		}
+
+		var res result
+		err := apiClient.GraphQL(hostname, query, pageVariables, &res)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		mu.Lock()
+		listResult.Owner = res.RepositoryOwner.Login
+		mu.Unlock()
+		return res.RepositoryOwner.Repositories.Nodes, res.RepositoryOwner.Repositories.TotalCount, nil
+	}
+
+	pages, totalCount, err := api.PaginateConcurrently(perPage, limit, fetchPage)
+	if err != nil {
+		return nil, err
+	}
+
+	listResult.TotalCount = totalCount
+	for _, page := range pages {
+		for _, repo := range page.([]api.Repository) {
+			if len(listResult.Repositories) >= limit {
+				break
+			}
+			listResult.Repositories = append(listResult.Repositories, repo)
+		}
+	}
+
+	return &listResult, nil
+}
+
+func listStarred(client *http.Client, hostname string, limit int, filter FilterOptions) (*RepositoryList, error) {
+	type result struct {
+		Viewer struct {
+			Login               string
+			StarredRepositories struct {
+				Nodes      []api.Repository
+				TotalCount int
+				PageInfo   struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			}
+		}
+	}
+
+	query := fmt.Sprintf(`query RepositoryListStarred($perPage:Int!,$endCursor:String) {
+		viewer {
+			login
+			starredRepositories(first: $perPage, after: $endCursor, orderBy: { field: STARRED_AT, direction: DESC }) {
+				nodes{%s}
+				totalCount
+				pageInfo{hasNextPage,endCursor}
+			}
+		}
+	}`, api.RepositoryGraphQL(filter.Fields))
+
+	perPage := limit
+	if perPage > 100 {
+		perPage = 100
+	}
+	variables := map[string]interface{}{
+		"perPage": githubv4.Int(perPage),
+	}
+
 	apiClient := api.NewClientFromHTTP(client)
 	listResult := RepositoryList{}
 pagination:
@@ -97,21 +176,21 @@ pagination:
 			return nil, err
 		}
 
-		owner := res.RepositoryOwner
-		listResult.TotalCount = owner.Repositories.TotalCount
-		listResult.Owner = owner.Login
+		viewer := res.Viewer
+		listResult.TotalCount = viewer.StarredRepositories.TotalCount
+		listResult.Owner = viewer.Login
 
-		for _, repo := range owner.Repositories.Nodes {
+		for _, repo := range viewer.StarredRepositories.Nodes {
 			listResult.Repositories = append(listResult.Repositories, repo)
 			if len(listResult.Repositories) >= limit {
 				break pagination
 			}
 		}
 
-		if !owner.Repositories.PageInfo.HasNextPage {
+		if !viewer.StarredRepositories.PageInfo.HasNextPage {
 			break
 		}
-		variables["endCursor"] = githubv4.String(owner.Repositories.PageInfo.EndCursor)
+		variables["endCursor"] = githubv4.String(viewer.StarredRepositories.PageInfo.EndCursor)
 	}
 
 	return &listResult, nil
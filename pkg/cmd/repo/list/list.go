@@ -31,6 +31,7 @@ type ListOptions struct {
 	Topic       string
 	Archived    bool
 	NonArchived bool
+	Starred     bool
 
 	Now func() time.Time
 }
@@ -67,6 +68,10 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 				return cmdutil.FlagErrorf("specify only one of `--archived` or `--no-archived`")
 			}
 
+			if opts.Starred && len(args) > 0 {
+				return cmdutil.FlagErrorf("`--starred` cannot be combined with an owner argument")
+			}
+
 			if flagPrivate {
 				opts.Visibility = "private"
 			} else if flagPublic {
@@ -93,6 +98,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVar(&opts.Topic, "topic", "", "Filter by topic")
 	cmd.Flags().BoolVar(&opts.Archived, "archived", false, "Show only archived repositories")
 	cmd.Flags().BoolVar(&opts.NonArchived, "no-archived", false, "Omit archived repositories")
+	cmd.Flags().BoolVar(&opts.Starred, "starred", false, "List repositories the authenticated user has starred")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.RepositoryFields)
 
 	return cmd
@@ -130,7 +136,12 @@ func listRun(opts *ListOptions) error {
 		return err
 	}
 
-	listResult, err := listRepos(httpClient, host, opts.Limit, opts.Owner, filter)
+	var listResult *RepositoryList
+	if opts.Starred {
+		listResult, err = listStarred(httpClient, host, opts.Limit, filter)
+	} else {
+		listResult, err = listRepos(httpClient, host, opts.Limit, opts.Owner, filter)
+	}
 	if err != nil {
 		return err
 	}
@@ -176,7 +187,12 @@ func listRun(opts *ListOptions) error {
 	}
 	if opts.IO.IsStdoutTTY() {
 		hasFilters := filter.Visibility != "" || filter.Fork || filter.Source || filter.Language != "" || filter.Topic != ""
-		title := listHeader(listResult.Owner, len(listResult.Repositories), listResult.TotalCount, hasFilters)
+		var title string
+		if opts.Starred {
+			title = fmt.Sprintf("Showing %d of %d repositories starred by @%s", len(listResult.Repositories), listResult.TotalCount, listResult.Owner)
+		} else {
+			title = listHeader(listResult.Owner, len(listResult.Repositories), listResult.TotalCount, hasFilters)
+		}
 		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", title)
 	}
 
@@ -0,0 +1,216 @@
+package edit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/repo/protection/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type EditOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Branch   string
+	FromFile string
+
+	EnforceAdmins         *bool
+	RequiredLinearHistory *bool
+	StrictStatusChecks    *bool
+	RequiredApprovals     *int
+	RequiredChecks        []string
+	checksChanged         bool
+}
+
+// fileRules is the declarative rule set accepted by --from-file. Unlike flag
+// edits, it replaces the whole protection rule.
+type fileRules struct {
+	RequiredStatusChecks *struct {
+		Strict   bool     `yaml:"strict"`
+		Contexts []string `yaml:"contexts"`
+	} `yaml:"required_status_checks"`
+	RequiredApprovingReviewCount int  `yaml:"required_approving_review_count"`
+	EnforceAdmins                bool `yaml:"enforce_admins"`
+	RequiredLinearHistory        bool `yaml:"required_linear_history"`
+}
+
+func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Command {
+	opts := &EditOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	var requiredApprovals int
+
+	cmd := &cobra.Command{
+		Use:   "edit <branch>",
+		Short: "Edit the protection rule of a branch",
+		Long: heredoc.Docf(`
+			Edit the protection rule of a branch.
+
+			Flag edits are merged into the existing rule: only the settings named by flags
+			change, and a rule is created if the branch is not protected yet.
+
+			With %[1]s--from-file%[1]s, the YAML rule set replaces the existing rule entirely,
+			so the file can be kept in version control as the source of truth.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ gh repo protection edit main --required-approvals 2
+			$ gh repo protection edit main --required-check ci/test --required-check lint
+			$ gh repo protection edit main --from-file rules.yml
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot edit: branch argument required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.Branch = args[0]
+
+			if cmd.Flags().NFlag() == 0 {
+				return cmdutil.FlagErrorf("at least one flag is required")
+			}
+			if opts.FromFile != "" && cmd.Flags().NFlag() > 1 {
+				return cmdutil.FlagErrorf("`--from-file` cannot be combined with other flags")
+			}
+			if cmd.Flags().Changed("required-approvals") {
+				opts.RequiredApprovals = &requiredApprovals
+			}
+			opts.checksChanged = cmd.Flags().Changed("required-check")
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return editRun(opts)
+		},
+	}
+
+	cmdutil.NilBoolFlag(cmd, &opts.EnforceAdmins, "enforce-admins", "", "Enforce the protection rule for repository administrators")
+	cmdutil.NilBoolFlag(cmd, &opts.RequiredLinearHistory, "required-linear-history", "", "Require a linear commit history")
+	cmdutil.NilBoolFlag(cmd, &opts.StrictStatusChecks, "strict-status-checks", "", "Require branches to be up to date before merging")
+	cmd.Flags().IntVar(&requiredApprovals, "required-approvals", 0, "Number of approving reviews required before merging")
+	cmd.Flags().StringSliceVar(&opts.RequiredChecks, "required-check", nil, "Status `check` required before merging (may be used multiple times; pass an empty value to clear)")
+	cmd.Flags().StringVar(&opts.FromFile, "from-file", "", "Replace the protection rule with the rule set in a YAML `file`")
+
+	return cmd
+}
+
+func editRun(opts *EditOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	var input shared.UpdateInput
+	if opts.FromFile != "" {
+		input, err = inputFromFile(opts.FromFile)
+		if err != nil {
+			return err
+		}
+	} else {
+		current, err := shared.FetchProtection(httpClient, repo, opts.Branch)
+		if err != nil {
+			var httpErr api.HTTPError
+			// a 404 means the branch is not protected yet; start from scratch
+			if !errors.As(err, &httpErr) || httpErr.StatusCode != 404 {
+				return err
+			}
+		}
+		input = applyFlagEdits(opts, shared.UpdateInputFromProtection(current))
+	}
+
+	opts.IO.StartProgressIndicator()
+	err = shared.UpdateProtection(httpClient, repo, opts.Branch, input)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Updated protection rule for %s:%s\n",
+			cs.SuccessIcon(), ghrepo.FullName(repo), opts.Branch)
+	}
+
+	return nil
+}
+
+func applyFlagEdits(opts *EditOptions, input shared.UpdateInput) shared.UpdateInput {
+	if opts.EnforceAdmins != nil {
+		input.EnforceAdmins = *opts.EnforceAdmins
+	}
+	if opts.RequiredLinearHistory != nil {
+		input.RequiredLinearHistory = *opts.RequiredLinearHistory
+	}
+	if opts.RequiredApprovals != nil {
+		if input.RequiredPullRequestReviews == nil {
+			input.RequiredPullRequestReviews = &shared.PullRequestReviews{}
+		}
+		input.RequiredPullRequestReviews.RequiredApprovingReviewCount = *opts.RequiredApprovals
+	}
+	if opts.checksChanged {
+		checks := opts.RequiredChecks
+		if len(checks) == 1 && checks[0] == "" {
+			checks = nil
+		}
+		if checks == nil {
+			input.RequiredStatusChecks = nil
+		} else {
+			if input.RequiredStatusChecks == nil {
+				input.RequiredStatusChecks = &shared.StatusChecks{}
+			}
+			input.RequiredStatusChecks.Contexts = checks
+		}
+	}
+	if opts.StrictStatusChecks != nil && input.RequiredStatusChecks != nil {
+		input.RequiredStatusChecks.Strict = *opts.StrictStatusChecks
+	}
+	return input
+}
+
+func inputFromFile(filename string) (shared.UpdateInput, error) {
+	input := shared.UpdateInputFromProtection(nil)
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return input, err
+	}
+	defer f.Close()
+
+	var rules fileRules
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(&rules); err != nil {
+		return input, fmt.Errorf("invalid rules file: %w", err)
+	}
+
+	if rules.RequiredStatusChecks != nil {
+		input.RequiredStatusChecks = &shared.StatusChecks{
+			Strict:   rules.RequiredStatusChecks.Strict,
+			Contexts: rules.RequiredStatusChecks.Contexts,
+		}
+	}
+	if rules.RequiredApprovingReviewCount > 0 {
+		input.RequiredPullRequestReviews = &shared.PullRequestReviews{
+			RequiredApprovingReviewCount: rules.RequiredApprovingReviewCount,
+		}
+	}
+	input.EnforceAdmins = rules.EnforceAdmins
+	input.RequiredLinearHistory = rules.RequiredLinearHistory
+
+	return input, nil
+}
@@ -0,0 +1,255 @@
+package edit
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdEdit(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantOpts func(*testing.T, *EditOptions)
+		wantErr  string
+	}{
+		{
+			name:  "enforce admins",
+			input: "main --enforce-admins",
+			wantOpts: func(t *testing.T, opts *EditOptions) {
+				assert.Equal(t, "main", opts.Branch)
+				if assert.NotNil(t, opts.EnforceAdmins) {
+					assert.True(t, *opts.EnforceAdmins)
+				}
+				assert.Nil(t, opts.RequiredApprovals)
+			},
+		},
+		{
+			name:  "required approvals",
+			input: "main --required-approvals 2",
+			wantOpts: func(t *testing.T, opts *EditOptions) {
+				if assert.NotNil(t, opts.RequiredApprovals) {
+					assert.Equal(t, 2, *opts.RequiredApprovals)
+				}
+			},
+		},
+		{
+			name:  "from file",
+			input: "main --from-file rules.yml",
+			wantOpts: func(t *testing.T, opts *EditOptions) {
+				assert.Equal(t, "rules.yml", opts.FromFile)
+			},
+		},
+		{
+			name:    "no flags",
+			input:   "main",
+			wantErr: "at least one flag is required",
+		},
+		{
+			name:    "from file with other flags",
+			input:   "main --from-file rules.yml --enforce-admins",
+			wantErr: "`--from-file` cannot be combined with other flags",
+		},
+		{
+			name:    "no argument",
+			input:   "--enforce-admins",
+			wantErr: "cannot edit: branch argument required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			var gotOpts *EditOptions
+			cmd := NewCmdEdit(f, func(opts *EditOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			tt.wantOpts(t, gotOpts)
+		})
+	}
+}
+
+func Test_editRun(t *testing.T) {
+	boolPtr := func(b bool) *bool { return &b }
+	intPtr := func(i int) *int { return &i }
+
+	tests := []struct {
+		name       string
+		tty        bool
+		opts       EditOptions
+		httpStubs  func(*testing.T, *httpmock.Registry)
+		wantStdout string
+	}{
+		{
+			name: "merge into existing rule",
+			tty:  true,
+			opts: EditOptions{
+				Branch:            "main",
+				RequiredApprovals: intPtr(2),
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/branches/main/protection"),
+					httpmock.StringResponse(`{
+						"required_status_checks": {"strict": true, "contexts": ["ci/test"]},
+						"enforce_admins": {"enabled": true}
+					}`))
+				reg.Register(
+					httpmock.REST("PUT", "repos/OWNER/REPO/branches/main/protection"),
+					httpmock.RESTPayload(200, `{}`, func(payload map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"strict":   true,
+							"contexts": []interface{}{"ci/test"},
+						}, payload["required_status_checks"])
+						assert.Equal(t, map[string]interface{}{
+							"required_approving_review_count": float64(2),
+						}, payload["required_pull_request_reviews"])
+						assert.Equal(t, true, payload["enforce_admins"])
+						assert.Equal(t, false, payload["required_linear_history"])
+						assert.Nil(t, payload["restrictions"])
+					}))
+			},
+			wantStdout: "✓ Updated protection rule for OWNER/REPO:main\n",
+		},
+		{
+			name: "unprotected branch",
+			opts: EditOptions{
+				Branch:         "main",
+				EnforceAdmins:  boolPtr(true),
+				RequiredChecks: []string{"ci/test"},
+				checksChanged:  true,
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/branches/main/protection"),
+					httpmock.StatusStringResponse(404, `{"message": "Branch not protected"}`))
+				reg.Register(
+					httpmock.REST("PUT", "repos/OWNER/REPO/branches/main/protection"),
+					httpmock.RESTPayload(200, `{}`, func(payload map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"strict":   false,
+							"contexts": []interface{}{"ci/test"},
+						}, payload["required_status_checks"])
+						assert.Equal(t, true, payload["enforce_admins"])
+					}))
+			},
+		},
+		{
+			name: "clear required checks",
+			opts: EditOptions{
+				Branch:         "main",
+				RequiredChecks: []string{""},
+				checksChanged:  true,
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/branches/main/protection"),
+					httpmock.StringResponse(`{
+						"required_status_checks": {"strict": true, "contexts": ["ci/test"]}
+					}`))
+				reg.Register(
+					httpmock.REST("PUT", "repos/OWNER/REPO/branches/main/protection"),
+					httpmock.RESTPayload(200, `{}`, func(payload map[string]interface{}) {
+						assert.Nil(t, payload["required_status_checks"])
+					}))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.httpStubs != nil {
+				tt.httpStubs(t, reg)
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.tty)
+
+			opts := tt.opts
+			opts.IO = io
+			opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+
+			err := editRun(&opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			reg.Verify(t)
+		})
+	}
+}
+
+func Test_editRun_fromFile(t *testing.T) {
+	rulesFile := filepath.Join(t.TempDir(), "rules.yml")
+	err := os.WriteFile(rulesFile, []byte(heredocRules), 0600)
+	assert.NoError(t, err)
+
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("PUT", "repos/OWNER/REPO/branches/main/protection"),
+		httpmock.RESTPayload(200, `{}`, func(payload map[string]interface{}) {
+			assert.Equal(t, map[string]interface{}{
+				"strict":   true,
+				"contexts": []interface{}{"ci/test", "lint"},
+			}, payload["required_status_checks"])
+			assert.Equal(t, map[string]interface{}{
+				"required_approving_review_count": float64(2),
+			}, payload["required_pull_request_reviews"])
+			assert.Equal(t, true, payload["enforce_admins"])
+			assert.Equal(t, true, payload["required_linear_history"])
+		}))
+
+	io, _, _, _ := iostreams.Test()
+
+	opts := &EditOptions{
+		IO:       io,
+		Branch:   "main",
+		FromFile: rulesFile,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err = editRun(opts)
+	assert.NoError(t, err)
+	reg.Verify(t)
+}
+
+const heredocRules = `required_status_checks:
+  strict: true
+  contexts:
+    - ci/test
+    - lint
+required_approving_review_count: 2
+enforce_admins: true
+required_linear_history: true
+`
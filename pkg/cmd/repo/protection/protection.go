@@ -0,0 +1,22 @@
+package protection
+
+import (
+	cmdEdit "github.com/cli/cli/v2/pkg/cmd/repo/protection/edit"
+	cmdView "github.com/cli/cli/v2/pkg/cmd/repo/protection/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdProtection(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protection <command>",
+		Short: "Manage branch protection rules in a repository",
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdEdit.NewCmdEdit(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,159 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// previewHeader opts into the API preview that exposes multiple approving
+// review counts and linear history on branch protection endpoints.
+const previewHeader = "application/vnd.github.luke-cage-preview+json"
+
+type StatusChecks struct {
+	Strict   bool     `json:"strict"`
+	Contexts []string `json:"contexts"`
+}
+
+type PullRequestReviews struct {
+	RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+}
+
+type EnabledSetting struct {
+	Enabled bool `json:"enabled"`
+}
+
+// Protection is the branch protection rule as returned by the REST API.
+type Protection struct {
+	RequiredStatusChecks       *StatusChecks       `json:"required_status_checks"`
+	RequiredPullRequestReviews *PullRequestReviews `json:"required_pull_request_reviews"`
+	EnforceAdmins              *EnabledSetting     `json:"enforce_admins"`
+	RequiredLinearHistory      *EnabledSetting     `json:"required_linear_history"`
+	Restrictions               json.RawMessage     `json:"restrictions"`
+}
+
+var ProtectionFields = []string{
+	"requiredStatusChecks",
+	"strictStatusChecks",
+	"requiredApprovingReviewCount",
+	"enforceAdmins",
+	"requiredLinearHistory",
+}
+
+func (p *Protection) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "requiredStatusChecks":
+			contexts := []string{}
+			if p.RequiredStatusChecks != nil {
+				contexts = p.RequiredStatusChecks.Contexts
+			}
+			data[f] = contexts
+		case "strictStatusChecks":
+			data[f] = p.RequiredStatusChecks != nil && p.RequiredStatusChecks.Strict
+		case "requiredApprovingReviewCount":
+			count := 0
+			if p.RequiredPullRequestReviews != nil {
+				count = p.RequiredPullRequestReviews.RequiredApprovingReviewCount
+			}
+			data[f] = count
+		case "enforceAdmins":
+			data[f] = p.EnforceAdmins != nil && p.EnforceAdmins.Enabled
+		case "requiredLinearHistory":
+			data[f] = p.RequiredLinearHistory != nil && p.RequiredLinearHistory.Enabled
+		}
+	}
+	return data
+}
+
+// UpdateInput is the full branch protection rule as accepted by the PUT
+// endpoint, which replaces the existing rule.
+type UpdateInput struct {
+	RequiredStatusChecks       *StatusChecks       `json:"required_status_checks"`
+	EnforceAdmins              bool                `json:"enforce_admins"`
+	RequiredPullRequestReviews *PullRequestReviews `json:"required_pull_request_reviews"`
+	Restrictions               json.RawMessage     `json:"restrictions"`
+	RequiredLinearHistory      bool                `json:"required_linear_history"`
+}
+
+// UpdateInputFromProtection converts a fetched protection rule into update
+// input that would reapply the rule unchanged.
+func UpdateInputFromProtection(p *Protection) UpdateInput {
+	input := UpdateInput{
+		Restrictions: json.RawMessage("null"),
+	}
+	if p == nil {
+		return input
+	}
+	input.RequiredStatusChecks = p.RequiredStatusChecks
+	input.RequiredPullRequestReviews = p.RequiredPullRequestReviews
+	input.EnforceAdmins = p.EnforceAdmins != nil && p.EnforceAdmins.Enabled
+	input.RequiredLinearHistory = p.RequiredLinearHistory != nil && p.RequiredLinearHistory.Enabled
+	if len(p.Restrictions) > 0 {
+		input.Restrictions = p.Restrictions
+	}
+	return input
+}
+
+func protectionURL(repo ghrepo.Interface, branch string) string {
+	return fmt.Sprintf("%srepos/%s/branches/%s/protection",
+		ghinstance.RESTPrefix(repo.RepoHost()),
+		ghrepo.FullName(repo),
+		branch)
+}
+
+func FetchProtection(client *http.Client, repo ghrepo.Interface, branch string) (*Protection, error) {
+	request, err := http.NewRequest("GET", protectionURL(repo, branch), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", previewHeader)
+
+	resp, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	var protection Protection
+	if err := json.NewDecoder(resp.Body).Decode(&protection); err != nil {
+		return nil, err
+	}
+	return &protection, nil
+}
+
+func UpdateProtection(client *http.Client, repo ghrepo.Interface, branch string, input UpdateInput) error {
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(input); err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest("PUT", protectionURL(repo, branch), body)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", previewHeader)
+	request.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/repo/protection/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Branch   string
+	Exporter cmdutil.Exporter
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <branch>",
+		Short: "View the protection rule of a branch",
+		Args:  cmdutil.ExactArgs(1, "cannot view: branch argument required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.Branch = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.ProtectionFields)
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	protection, err := shared.FetchProtection(httpClient, repo, opts.Branch)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, protection)
+	}
+
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(out, "Branch protection for %s:%s\n\n", ghrepo.FullName(repo), opts.Branch)
+	}
+
+	checks := "none"
+	if sc := protection.RequiredStatusChecks; sc != nil && len(sc.Contexts) > 0 {
+		checks = strings.Join(sc.Contexts, ", ")
+		if sc.Strict {
+			checks += " (strict)"
+		}
+	}
+	fmt.Fprintf(out, "%s %s\n", cs.Bold("Required status checks:"), checks)
+
+	reviewCount := 0
+	if protection.RequiredPullRequestReviews != nil {
+		reviewCount = protection.RequiredPullRequestReviews.RequiredApprovingReviewCount
+	}
+	fmt.Fprintf(out, "%s %d\n", cs.Bold("Required approving reviews:"), reviewCount)
+
+	fmt.Fprintf(out, "%s %s\n", cs.Bold("Enforce for administrators:"), enabledLabel(protection.EnforceAdmins))
+	fmt.Fprintf(out, "%s %s\n", cs.Bold("Require linear history:"), enabledLabel(protection.RequiredLinearHistory))
+
+	return nil
+}
+
+func enabledLabel(setting *shared.EnabledSetting) string {
+	if setting != nil && setting.Enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
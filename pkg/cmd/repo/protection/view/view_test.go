@@ -0,0 +1,129 @@
+package view
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdView(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantBranch string
+		wantErr    string
+	}{
+		{
+			name:       "branch argument",
+			input:      "main",
+			wantBranch: "main",
+		},
+		{
+			name:    "no argument",
+			input:   "",
+			wantErr: "cannot view: branch argument required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			var gotOpts *ViewOptions
+			cmd := NewCmdView(f, func(opts *ViewOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantBranch, gotOpts.Branch)
+		})
+	}
+}
+
+func Test_viewRun(t *testing.T) {
+	protectionBody := `{
+		"required_status_checks": {"strict": true, "contexts": ["ci/test", "lint"]},
+		"required_pull_request_reviews": {"required_approving_review_count": 2},
+		"enforce_admins": {"enabled": true},
+		"required_linear_history": {"enabled": false}
+	}`
+
+	tests := []struct {
+		name       string
+		tty        bool
+		httpStubs  func(*httpmock.Registry)
+		wantStdout string
+	}{
+		{
+			name: "tty",
+			tty:  true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/branches/main/protection"),
+					httpmock.StringResponse(protectionBody))
+			},
+			wantStdout: "Branch protection for OWNER/REPO:main\n\n" +
+				"Required status checks: ci/test, lint (strict)\n" +
+				"Required approving reviews: 2\n" +
+				"Enforce for administrators: enabled\n" +
+				"Require linear history: disabled\n",
+		},
+		{
+			name: "nontty",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/branches/main/protection"),
+					httpmock.StringResponse(`{}`))
+			},
+			wantStdout: "Required status checks: none\n" +
+				"Required approving reviews: 0\n" +
+				"Enforce for administrators: disabled\n" +
+				"Require linear history: disabled\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.tty)
+
+			opts := &ViewOptions{
+				IO:     io,
+				Branch: "main",
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.New("OWNER", "REPO"), nil
+				},
+			}
+
+			err := viewRun(opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			reg.Verify(t)
+		})
+	}
+}
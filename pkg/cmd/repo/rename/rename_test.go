@@ -117,8 +117,7 @@ func TestRenameRun(t *testing.T) {
 			name:    "none argument",
 			wantOut: "✓ Renamed repository OWNER/NEW_REPO\n✓ Updated the \"origin\" remote\n",
 			askStubs: func(q *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: q.StubOne is deprecated: use StubPrompt
-				q.StubOne("NEW_REPO")
+				q.StubPrompt("Rename OWNER/REPO to: ").AnswerWith("NEW_REPO")
 			},
 			httpStubs: func(reg *httpmock.Registry) {
 				reg.Register(
@@ -137,8 +136,7 @@ func TestRenameRun(t *testing.T) {
 			},
 			wantOut: "✓ Renamed repository OWNER/NEW_REPO\n",
 			askStubs: func(q *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: q.StubOne is deprecated: use StubPrompt
-				q.StubOne("NEW_REPO")
+				q.StubPrompt("Rename OWNER/REPO to: ").AnswerWith("NEW_REPO")
 			},
 			httpStubs: func(reg *httpmock.Registry) {
 				reg.Register(
@@ -186,8 +184,7 @@ func TestRenameRun(t *testing.T) {
 			},
 			wantOut: "✓ Renamed repository OWNER/NEW_REPO\n✓ Updated the \"origin\" remote\n",
 			askStubs: func(q *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: q.StubOne is deprecated: use StubPrompt
-				q.StubOne(true)
+				q.StubPrompt("Rename OWNER/REPO to NEW_REPO?").AnswerWith(true)
 			},
 			httpStubs: func(reg *httpmock.Registry) {
 				reg.Register(
@@ -207,17 +204,14 @@ func TestRenameRun(t *testing.T) {
 				DoConfirm:       true,
 			},
 			askStubs: func(q *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: q.StubOne is deprecated: use StubPrompt
-				q.StubOne(false)
+				q.StubPrompt("Rename OWNER/REPO to NEW_REPO?").AnswerWith(false)
 			},
 			wantOut: "",
 		},
 	}
 
 	for _, tt := range testCases {
-		//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-		q, teardown := prompt.InitAskStubber()
-		defer teardown()
+		q := prompt.NewAskStubber(t)
 		if tt.askStubs != nil {
 			tt.askStubs(q)
 		}
@@ -4,17 +4,24 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	repoArchiveCmd "github.com/cli/cli/v2/pkg/cmd/repo/archive"
 	repoCloneCmd "github.com/cli/cli/v2/pkg/cmd/repo/clone"
+	contributorsCmd "github.com/cli/cli/v2/pkg/cmd/repo/contributors"
 	repoCreateCmd "github.com/cli/cli/v2/pkg/cmd/repo/create"
 	creditsCmd "github.com/cli/cli/v2/pkg/cmd/repo/credits"
 	repoDeleteCmd "github.com/cli/cli/v2/pkg/cmd/repo/delete"
 	deployKeyCmd "github.com/cli/cli/v2/pkg/cmd/repo/deploy-key"
+	repoDownloadCmd "github.com/cli/cli/v2/pkg/cmd/repo/download"
 	repoEditCmd "github.com/cli/cli/v2/pkg/cmd/repo/edit"
 	repoForkCmd "github.com/cli/cli/v2/pkg/cmd/repo/fork"
 	gardenCmd "github.com/cli/cli/v2/pkg/cmd/repo/garden"
 	repoListCmd "github.com/cli/cli/v2/pkg/cmd/repo/list"
+	protectionCmd "github.com/cli/cli/v2/pkg/cmd/repo/protection"
 	repoRenameCmd "github.com/cli/cli/v2/pkg/cmd/repo/rename"
+	repoStarCmd "github.com/cli/cli/v2/pkg/cmd/repo/star"
 	repoSyncCmd "github.com/cli/cli/v2/pkg/cmd/repo/sync"
+	repoTransferCmd "github.com/cli/cli/v2/pkg/cmd/repo/transfer"
+	uploadFileCmd "github.com/cli/cli/v2/pkg/cmd/repo/upload-file"
 	repoViewCmd "github.com/cli/cli/v2/pkg/cmd/repo/view"
+	repoVisibilityCmd "github.com/cli/cli/v2/pkg/cmd/repo/visibility"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -46,12 +53,20 @@ func NewCmdRepo(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(repoEditCmd.NewCmdEdit(f, nil))
 	cmd.AddCommand(repoListCmd.NewCmdList(f, nil))
 	cmd.AddCommand(repoSyncCmd.NewCmdSync(f, nil))
+	cmd.AddCommand(contributorsCmd.NewCmdContributors(f, nil))
 	cmd.AddCommand(creditsCmd.NewCmdRepoCredits(f, nil))
 	cmd.AddCommand(gardenCmd.NewCmdGarden(f, nil))
 	cmd.AddCommand(deployKeyCmd.NewCmdDeployKey(f))
+	cmd.AddCommand(protectionCmd.NewCmdProtection(f))
 	cmd.AddCommand(repoRenameCmd.NewCmdRename(f, nil))
 	cmd.AddCommand(repoDeleteCmd.NewCmdDelete(f, nil))
 	cmd.AddCommand(repoArchiveCmd.NewCmdArchive(f, nil))
+	cmd.AddCommand(repoTransferCmd.NewCmdTransfer(f, nil))
+	cmd.AddCommand(repoVisibilityCmd.NewCmdVisibility(f, nil))
+	cmd.AddCommand(repoStarCmd.NewCmdStar(f, nil))
+	cmd.AddCommand(repoStarCmd.NewCmdUnstar(f, nil))
+	cmd.AddCommand(repoDownloadCmd.NewCmdDownload(f, nil))
+	cmd.AddCommand(uploadFileCmd.NewCmdUploadFile(f, nil))
 
 	return cmd
 }
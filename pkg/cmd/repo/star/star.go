@@ -0,0 +1,146 @@
+package star
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type StarOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	IO         *iostreams.IOStreams
+
+	RepoArg string
+	Unstar  bool
+}
+
+func NewCmdStar(f *cmdutil.Factory, runF func(*StarOptions) error) *cobra.Command {
+	opts := &StarOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "star [<repository>]",
+		Short: "Star a repository",
+		Long: heredoc.Doc(`Star a GitHub repository.
+
+With no argument, stars the current repository.`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return starRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func NewCmdUnstar(f *cmdutil.Factory, runF func(*StarOptions) error) *cobra.Command {
+	opts := &StarOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+		Unstar:     true,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "unstar [<repository>]",
+		Short: "Unstar a repository",
+		Long: heredoc.Doc(`Remove a star from a GitHub repository.
+
+With no argument, unstars the current repository.`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return starRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func starRun(opts *StarOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var toStar ghrepo.Interface
+	if opts.RepoArg == "" {
+		toStar, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	} else {
+		repoSelector := opts.RepoArg
+		if !strings.Contains(repoSelector, "/") {
+			cfg, err := opts.Config()
+			if err != nil {
+				return err
+			}
+
+			hostname, err := cfg.DefaultHost()
+			if err != nil {
+				return err
+			}
+
+			currentUser, err := api.CurrentLoginName(apiClient, hostname)
+			if err != nil {
+				return err
+			}
+			repoSelector = currentUser + "/" + repoSelector
+		}
+
+		toStar, err = ghrepo.FromFullName(repoSelector)
+		if err != nil {
+			return fmt.Errorf("argument error: %w", err)
+		}
+	}
+
+	method := "PUT"
+	if opts.Unstar {
+		method = "DELETE"
+	}
+	path := fmt.Sprintf("user/starred/%s", ghrepo.FullName(toStar))
+	if err := apiClient.REST(toStar.RepoHost(), method, path, nil, nil); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		verb := "Starred"
+		if opts.Unstar {
+			verb = "Unstarred"
+		}
+		fmt.Fprintf(opts.IO.Out, "%s %s %s\n", cs.SuccessIcon(), verb, ghrepo.FullName(toStar))
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package star
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStarRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       StarOptions
+		isTTY      bool
+		httpStubs  func(*httpmock.Registry)
+		wantStdout string
+	}{
+		{
+			name:  "star current repository",
+			opts:  StarOptions{},
+			isTTY: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("PUT", "user/starred/OWNER/REPO"),
+					httpmock.StatusStringResponse(204, "{}"))
+			},
+			wantStdout: "✓ Starred OWNER/REPO\n",
+		},
+		{
+			name: "star repository argument",
+			opts: StarOptions{
+				RepoArg: "cli/cli",
+			},
+			isTTY: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("PUT", "user/starred/cli/cli"),
+					httpmock.StatusStringResponse(204, "{}"))
+			},
+			wantStdout: "✓ Starred cli/cli\n",
+		},
+		{
+			name: "unstar repository",
+			opts: StarOptions{
+				RepoArg: "cli/cli",
+				Unstar:  true,
+			},
+			isTTY: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("DELETE", "user/starred/cli/cli"),
+					httpmock.StatusStringResponse(204, "{}"))
+			},
+			wantStdout: "✓ Unstarred cli/cli\n",
+		},
+		{
+			name: "no output when not a terminal",
+			opts: StarOptions{
+				RepoArg: "cli/cli",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("PUT", "user/starred/cli/cli"),
+					httpmock.StatusStringResponse(204, "{}"))
+			},
+			wantStdout: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+
+			opts := tt.opts
+			opts.IO = io
+			opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			err := starRun(&opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
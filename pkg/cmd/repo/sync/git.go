@@ -19,6 +19,7 @@ type gitClient interface {
 	IsDirty() (bool, error)
 	MergeFastForward(string) error
 	ResetHard(string) error
+	UpdateSubmodules() error
 }
 
 type gitExecuter struct {
@@ -116,6 +117,17 @@ func (g *gitExecuter) MergeFastForward(ref string) error {
 	return cmd.Run()
 }
 
+func (g *gitExecuter) UpdateSubmodules() error {
+	cmd, err := git.GitCommand("submodule", "update", "--init", "--recursive")
+	if err != nil {
+		return err
+	}
+	cmd.Stdin = g.io.In
+	cmd.Stdout = g.io.Out
+	cmd.Stderr = g.io.ErrOut
+	return cmd.Run()
+}
+
 func (g *gitExecuter) ResetHard(ref string) error {
 	args := []string{"reset", "--hard", ref}
 	cmd, err := git.GitCommand(args...)
@@ -29,6 +29,30 @@ func latestCommit(client *api.Client, repo ghrepo.Interface, branch string) (com
 	return response, err
 }
 
+type repoBranch struct {
+	Name      string `json:"name"`
+	Protected bool   `json:"protected"`
+}
+
+func listBranches(client *api.Client, repo ghrepo.Interface) ([]repoBranch, error) {
+	var branches []repoBranch
+	perPage := 100
+	page := 1
+	for {
+		var response []repoBranch
+		path := fmt.Sprintf("repos/%s/%s/branches?per_page=%d&page=%d", repo.RepoOwner(), repo.RepoName(), perPage, page)
+		if err := client.REST(repo.RepoHost(), "GET", path, nil, &response); err != nil {
+			return nil, err
+		}
+		branches = append(branches, response...)
+		if len(response) < perPage {
+			break
+		}
+		page++
+	}
+	return branches, nil
+}
+
 type upstreamMergeErr struct{ error }
 
 var upstreamMergeUnavailableErr = upstreamMergeErr{errors.New("upstream merge API is unavailable")}
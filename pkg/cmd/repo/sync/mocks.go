@@ -53,6 +53,11 @@ func (g *mockGitClient) MergeFastForward(a string) error {
 	return args.Error(0)
 }
 
+func (g *mockGitClient) UpdateSubmodules() error {
+	args := g.Called()
+	return args.Error(0)
+}
+
 func (g *mockGitClient) ResetHard(a string) error {
 	args := g.Called(a)
 	return args.Error(0)
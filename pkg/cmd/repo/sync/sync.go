@@ -14,19 +14,24 @@ import (
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
 	"github.com/spf13/cobra"
 )
 
 type SyncOptions struct {
-	HttpClient func() (*http.Client, error)
-	IO         *iostreams.IOStreams
-	BaseRepo   func() (ghrepo.Interface, error)
-	Remotes    func() (context.Remotes, error)
-	Git        gitClient
-	DestArg    string
-	SrcArg     string
-	Branch     string
-	Force      bool
+	HttpClient  func() (*http.Client, error)
+	IO          *iostreams.IOStreams
+	BaseRepo    func() (ghrepo.Interface, error)
+	Remotes     func() (context.Remotes, error)
+	Git         gitClient
+	DestArg     string
+	SrcArg      string
+	Branch      string
+	SrcBranch   string
+	Strategy    string
+	AllBranches bool
+	Force       bool
+	Submodules  bool
 }
 
 func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Command {
@@ -65,12 +70,44 @@ func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Comman
 
 			# Sync remote repository from another remote repository
 			$ gh repo sync owner/repo --source owner2/repo2
+
+			# Sync all branches shared between a remote fork and its parent
+			$ gh repo sync owner/cli-fork --all-branches
+
+			# Sync remote fork from its parent with a merge commit when fast-forward is impossible
+			$ gh repo sync owner/cli-fork --strategy merge
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				opts.DestArg = args[0]
 			}
+			if opts.AllBranches && opts.Branch != "" {
+				return cmdutil.FlagErrorf("specify only one of `--branch` or `--all-branches`")
+			}
+			if opts.AllBranches && opts.DestArg == "" {
+				return cmdutil.FlagErrorf("`--all-branches` requires a destination repository argument")
+			}
+			if opts.AllBranches && opts.SrcBranch != "" {
+				return cmdutil.FlagErrorf("specify only one of `--source-branch` or `--all-branches`")
+			}
+			if opts.Strategy != "" && opts.Strategy != "fast-forward" && opts.Strategy != "merge" {
+				return cmdutil.FlagErrorf("invalid strategy %q: valid values are {fast-forward|merge}", opts.Strategy)
+			}
+			if opts.Strategy == "merge" {
+				if opts.Force {
+					return cmdutil.FlagErrorf("specify only one of `--strategy merge` or `--force`")
+				}
+				if opts.DestArg == "" {
+					return cmdutil.FlagErrorf("the merge strategy is only supported when syncing a remote repository")
+				}
+				if opts.SrcBranch != "" {
+					return cmdutil.FlagErrorf("`--source-branch` is not supported with the merge strategy")
+				}
+			}
+			if opts.Submodules && opts.DestArg != "" {
+				return cmdutil.FlagErrorf("`--submodules` is only supported when syncing the local repository")
+			}
 			if runF != nil {
 				return runF(&opts)
 			}
@@ -80,7 +117,11 @@ func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Comman
 
 	cmd.Flags().StringVarP(&opts.SrcArg, "source", "s", "", "Source repository")
 	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Branch to sync (default: main branch)")
+	cmd.Flags().StringVar(&opts.SrcBranch, "source-branch", "", "Branch of the source repository to sync from (default: same name as the destination branch)")
+	cmd.Flags().StringVar(&opts.Strategy, "strategy", "", "Update strategy when the destination branch cannot be fast-forwarded: {fast-forward|merge}")
+	cmd.Flags().BoolVar(&opts.AllBranches, "all-branches", false, "Sync all branches that exist in both the source and destination repositories")
 	cmd.Flags().BoolVarP(&opts.Force, "force", "", false, "Hard reset the branch of the destination repository to match the source repository")
+	cmd.Flags().BoolVar(&opts.Submodules, "submodules", false, "Update submodules after syncing the local repository")
 	return cmd
 }
 
@@ -135,8 +176,13 @@ func syncLocalRepo(opts *SyncOptions) error {
 		}
 	}
 
+	srcBranch := opts.SrcBranch
+	if srcBranch == "" {
+		srcBranch = opts.Branch
+	}
+
 	// Git fetch might require input from user, so do it before starting progress indicator.
-	if err := opts.Git.Fetch(remote, fmt.Sprintf("refs/heads/%s", opts.Branch)); err != nil {
+	if err := opts.Git.Fetch(remote, fmt.Sprintf("refs/heads/%s", srcBranch)); err != nil {
 		return err
 	}
 
@@ -153,6 +199,13 @@ func syncLocalRepo(opts *SyncOptions) error {
 		return err
 	}
 
+	// Submodule update might require input from user, so run it outside the progress indicator.
+	if opts.Submodules {
+		if err := opts.Git.UpdateSubmodules(); err != nil {
+			return err
+		}
+	}
+
 	if opts.IO.IsStdoutTTY() {
 		cs := opts.IO.ColorScheme()
 		fmt.Fprintf(opts.IO.Out, "%s Synced the \"%s\" branch from %s to local repository\n",
@@ -189,6 +242,10 @@ func syncRemoteRepo(opts *SyncOptions) error {
 		return fmt.Errorf("can't sync repositories from different hosts")
 	}
 
+	if opts.AllBranches {
+		return syncAllBranches(apiClient, destRepo, srcRepo, opts)
+	}
+
 	opts.IO.StartProgressIndicator()
 	baseBranchLabel, err := executeRemoteRepoSync(apiClient, destRepo, srcRepo, opts)
 	opts.IO.StopProgressIndicator()
@@ -290,11 +347,29 @@ func executeRemoteRepoSync(client *api.Client, destRepo, srcRepo ghrepo.Interfac
 		}
 	}
 
-	var apiErr upstreamMergeErr
-	if baseBranch, err := triggerUpstreamMerge(client, destRepo, branchName); err == nil {
-		return baseBranch, nil
-	} else if !errors.As(err, &apiErr) {
-		return "", err
+	srcBranchName := opts.SrcBranch
+	if srcBranchName == "" {
+		srcBranchName = branchName
+	}
+
+	// the upstream merge API can only sync a branch from its same-named counterpart
+	if srcBranchName == branchName {
+		var apiErr upstreamMergeErr
+		if baseBranch, err := triggerUpstreamMerge(client, destRepo, branchName); err == nil {
+			return baseBranch, nil
+		} else if opts.Strategy == "merge" {
+			// with the merge strategy there is no falling back to updating git refs,
+			// which can only ever fast-forward or hard reset the destination branch
+			if errors.Is(err, upstreamMergeUnavailableErr) {
+				return "", fmt.Errorf("can't use the merge strategy because %s does not support the upstream merge API", destRepo.RepoHost())
+			}
+			if errors.As(err, &apiErr) {
+				return "", fmt.Errorf("can't sync because of merge conflicts; resolve them in a pull request")
+			}
+			return "", err
+		} else if !errors.As(err, &apiErr) {
+			return "", err
+		}
 	}
 
 	if srcRepo == nil {
@@ -308,7 +383,7 @@ func executeRemoteRepoSync(client *api.Client, destRepo, srcRepo ghrepo.Interfac
 		}
 	}
 
-	commit, err := latestCommit(client, srcRepo, branchName)
+	commit, err := latestCommit(client, srcRepo, srcBranchName)
 	if err != nil {
 		return "", err
 	}
@@ -322,5 +397,80 @@ func executeRemoteRepoSync(client *api.Client, destRepo, srcRepo ghrepo.Interfac
 		return "", divergingError
 	}
 
-	return fmt.Sprintf("%s:%s", srcRepo.RepoOwner(), branchName), nil
+	return fmt.Sprintf("%s:%s", srcRepo.RepoOwner(), srcBranchName), nil
+}
+
+func syncAllBranches(client *api.Client, destRepo, srcRepo ghrepo.Interface, opts *SyncOptions) error {
+	if srcRepo == nil {
+		var err error
+		srcRepo, err = api.RepoParent(client, destRepo)
+		if err != nil {
+			return err
+		}
+		if srcRepo == nil {
+			return fmt.Errorf("can't determine source repository for %s because repository is not fork", ghrepo.FullName(destRepo))
+		}
+	}
+
+	opts.IO.StartProgressIndicator()
+	srcBranches, err := listBranches(client, srcRepo)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+	destBranches, err := listBranches(client, destRepo)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+
+	destProtected := map[string]bool{}
+	for _, b := range destBranches {
+		destProtected[b.Name] = b.Protected
+	}
+
+	type syncResult struct {
+		branch string
+		status string
+	}
+	var results []syncResult
+
+	for _, b := range srcBranches {
+		protected, exists := destProtected[b.Name]
+		if !exists {
+			continue
+		}
+		if opts.Force && protected {
+			results = append(results, syncResult{b.Name, "skipped (protected branch)"})
+			continue
+		}
+		commit, err := latestCommit(client, srcRepo, b.Name)
+		if err != nil {
+			results = append(results, syncResult{b.Name, fmt.Sprintf("error: %v", err)})
+			continue
+		}
+		err = syncFork(client, destRepo, b.Name, commit.Object.SHA, opts.Force)
+		var httpErr api.HTTPError
+		if err != nil && errors.As(err, &httpErr) && httpErr.Message == "Update is not a fast forward" {
+			results = append(results, syncResult{b.Name, "skipped (diverging changes)"})
+			continue
+		} else if err != nil {
+			results = append(results, syncResult{b.Name, fmt.Sprintf("error: %v", err)})
+			continue
+		}
+		results = append(results, syncResult{b.Name, "synced"})
+	}
+	opts.IO.StopProgressIndicator()
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "Syncing %s from %s\n\n", ghrepo.FullName(destRepo), ghrepo.FullName(srcRepo))
+	}
+
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, r := range results {
+		tp.AddField(r.branch, nil, nil)
+		tp.AddField(r.status, nil, nil)
+		tp.EndRow()
+	}
+	return tp.Render()
 }
@@ -63,6 +63,21 @@ func TestNewCmdSync(t *testing.T) {
 				Force: true,
 			},
 		},
+		{
+			name:  "submodules",
+			tty:   true,
+			input: "--submodules",
+			output: SyncOptions{
+				Submodules: true,
+			},
+		},
+		{
+			name:    "submodules with destination repo",
+			tty:     true,
+			input:   "cli/cli --submodules",
+			wantErr: true,
+			errMsg:  "`--submodules` is only supported when syncing the local repository",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -96,6 +111,7 @@ func TestNewCmdSync(t *testing.T) {
 			assert.Equal(t, tt.output.SrcArg, gotOpts.SrcArg)
 			assert.Equal(t, tt.output.Branch, gotOpts.Branch)
 			assert.Equal(t, tt.output.Force, gotOpts.Force)
+			assert.Equal(t, tt.output.Submodules, gotOpts.Submodules)
 		})
 	}
 }
@@ -149,6 +165,25 @@ func Test_SyncRun(t *testing.T) {
 			},
 			wantStdout: "",
 		},
+		{
+			name: "sync local repo with parent and update submodules",
+			tty:  false,
+			opts: &SyncOptions{
+				Branch:     "trunk",
+				Submodules: true,
+			},
+			gitStubs: func(mgc *mockGitClient) {
+				mgc.On("IsDirty").Return(false, nil).Once()
+				mgc.On("Fetch", "origin", "refs/heads/trunk").Return(nil).Once()
+				mgc.On("HasLocalBranch", "trunk").Return(true).Once()
+				mgc.On("BranchRemote", "trunk").Return("origin", nil).Once()
+				mgc.On("IsAncestor", "trunk", "FETCH_HEAD").Return(true, nil).Once()
+				mgc.On("CurrentBranch").Return("trunk", nil).Once()
+				mgc.On("MergeFastForward", "FETCH_HEAD").Return(nil).Once()
+				mgc.On("UpdateSubmodules").Return(nil).Once()
+			},
+			wantStdout: "",
+		},
 		{
 			name: "sync local repo with specified source repo",
 			tty:  true,
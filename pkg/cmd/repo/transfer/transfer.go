@@ -0,0 +1,149 @@
+package transfer
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+type TransferOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	IO         *iostreams.IOStreams
+
+	NewOwner  string
+	RepoArg   string
+	TeamIDs   []int64
+	Confirmed bool
+}
+
+func NewCmdTransfer(f *cmdutil.Factory, runF func(*TransferOptions) error) *cobra.Command {
+	opts := &TransferOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "transfer <new-owner> [<repository>]",
+		Short: "Transfer a repository to a new owner",
+		Long: heredoc.Doc(`Transfer ownership of a GitHub repository to another user or organization.
+
+			With only the new owner argument, transfers the current repository.
+
+			When transferring to an organization, team IDs may be supplied with '--team' to
+			grant those teams access to the repository.`),
+		Example: heredoc.Doc(`
+			$ gh repo transfer monalisa
+			$ gh repo transfer myorg OWNER/REPO --team 1234 --team 5678
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.NewOwner = args[0]
+			if len(args) > 1 {
+				opts.RepoArg = args[1]
+			}
+
+			if !opts.Confirmed && !opts.IO.CanPrompt() {
+				return cmdutil.FlagErrorf("--confirm required when not running interactively")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return transferRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Confirmed, "confirm", "y", false, "Skip the confirmation prompt")
+	cmd.Flags().Int64SliceVar(&opts.TeamIDs, "team", nil, "ID of the team to add to the repository (may be used multiple times)")
+	return cmd
+}
+
+func transferRun(opts *TransferOptions) error {
+	cs := opts.IO.ColorScheme()
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var toTransfer ghrepo.Interface
+
+	if opts.RepoArg == "" {
+		toTransfer, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	} else {
+		repoSelector := opts.RepoArg
+		if !strings.Contains(repoSelector, "/") {
+			cfg, err := opts.Config()
+			if err != nil {
+				return err
+			}
+
+			hostname, err := cfg.DefaultHost()
+			if err != nil {
+				return err
+			}
+
+			currentUser, err := api.CurrentLoginName(apiClient, hostname)
+			if err != nil {
+				return err
+			}
+			repoSelector = currentUser + "/" + repoSelector
+		}
+
+		toTransfer, err = ghrepo.FromFullName(repoSelector)
+		if err != nil {
+			return err
+		}
+	}
+
+	fullName := ghrepo.FullName(toTransfer)
+
+	if !opts.Confirmed {
+		var valid string
+		err := prompt.SurveyAskOne(
+			&survey.Input{Message: fmt.Sprintf("Type %s to confirm transfer to %s:", fullName, opts.NewOwner)},
+			&valid,
+			survey.WithValidator(
+				func(val interface{}) error {
+					if str := val.(string); !strings.EqualFold(str, fullName) {
+						return fmt.Errorf("You entered %s", str)
+					}
+					return nil
+				}))
+		if err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+	}
+
+	err = transferRepo(httpClient, toTransfer, opts.NewOwner, opts.TeamIDs)
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out,
+			"%s Requested transfer of repository %s to %s\n",
+			cs.SuccessIcon(),
+			fullName,
+			opts.NewOwner)
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package transfer
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdTransfer(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		tty     bool
+		output  TransferOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:   "confirm flag",
+			tty:    true,
+			input:  "newowner OWNER/REPO --confirm",
+			output: TransferOptions{NewOwner: "newowner", RepoArg: "OWNER/REPO", Confirmed: true},
+		},
+		{
+			name:    "no confirmation notty",
+			input:   "newowner OWNER/REPO",
+			output:  TransferOptions{NewOwner: "newowner", RepoArg: "OWNER/REPO"},
+			wantErr: true,
+			errMsg:  "--confirm required when not running interactively",
+		},
+		{
+			name:   "base repo resolution",
+			input:  "newowner",
+			tty:    true,
+			output: TransferOptions{NewOwner: "newowner"},
+		},
+		{
+			name:   "team ids",
+			input:  "neworg --confirm --team 12 --team 34",
+			tty:    true,
+			output: TransferOptions{NewOwner: "neworg", Confirmed: true, TeamIDs: []int64{12, 34}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.SetStdinTTY(tt.tty)
+			io.SetStdoutTTY(tt.tty)
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *TransferOptions
+			cmd := NewCmdTransfer(f, func(opts *TransferOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.NewOwner, gotOpts.NewOwner)
+			assert.Equal(t, tt.output.RepoArg, gotOpts.RepoArg)
+			assert.Equal(t, tt.output.TeamIDs, gotOpts.TeamIDs)
+		})
+	}
+}
+
+func Test_transferRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		tty        bool
+		opts       *TransferOptions
+		httpStubs  func(*httpmock.Registry)
+		askStubs   func(*prompt.AskStubber)
+		wantStdout string
+		wantErr    bool
+		errMsg     string
+	}{
+		{
+			name:       "prompting confirmation tty",
+			tty:        true,
+			opts:       &TransferOptions{NewOwner: "newowner", RepoArg: "OWNER/REPO"},
+			wantStdout: "✓ Requested transfer of repository OWNER/REPO to newowner\n",
+			askStubs: func(q *prompt.AskStubber) {
+				q.StubPrompt("Type OWNER/REPO to confirm transfer to newowner:").AnswerWith("OWNER/REPO")
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/transfer"),
+					httpmock.StatusStringResponse(202, "{}"))
+			},
+		},
+		{
+			name:       "infer base repo",
+			tty:        true,
+			opts:       &TransferOptions{NewOwner: "newowner"},
+			wantStdout: "✓ Requested transfer of repository OWNER/REPO to newowner\n",
+			askStubs: func(q *prompt.AskStubber) {
+				q.StubPrompt("Type OWNER/REPO to confirm transfer to newowner:").AnswerWith("OWNER/REPO")
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/transfer"),
+					httpmock.StatusStringResponse(202, "{}"))
+			},
+		},
+		{
+			name: "confirmation no tty",
+			opts: &TransferOptions{
+				NewOwner:  "newowner",
+				RepoArg:   "OWNER/REPO",
+				Confirmed: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/transfer"),
+					httpmock.StatusStringResponse(202, "{}"))
+			},
+		},
+		{
+			name: "team ids",
+			opts: &TransferOptions{
+				NewOwner:  "neworg",
+				RepoArg:   "OWNER/REPO",
+				TeamIDs:   []int64{12, 34},
+				Confirmed: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/transfer"),
+					httpmock.RESTPayload(202, "{}", func(payload map[string]interface{}) {
+						assert.Equal(t, "neworg", payload["new_owner"])
+						assert.Equal(t, []interface{}{float64(12), float64(34)}, payload["team_ids"])
+					}))
+			},
+		},
+		{
+			name:       "short repo name",
+			opts:       &TransferOptions{NewOwner: "newowner", RepoArg: "REPO"},
+			wantStdout: "✓ Requested transfer of repository OWNER/REPO to newowner\n",
+			tty:        true,
+			askStubs: func(q *prompt.AskStubber) {
+				q.StubPrompt("Type OWNER/REPO to confirm transfer to newowner:").AnswerWith("OWNER/REPO")
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"OWNER"}}}`))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/transfer"),
+					httpmock.StatusStringResponse(202, "{}"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		q := prompt.NewAskStubber(t)
+		if tt.askStubs != nil {
+			tt.askStubs(q)
+		}
+
+		tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		}
+		tt.opts.Config = func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		}
+
+		reg := &httpmock.Registry{}
+		if tt.httpStubs != nil {
+			tt.httpStubs(reg)
+		}
+		tt.opts.HttpClient = func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		}
+
+		io, _, stdout, _ := iostreams.Test()
+		io.SetStdinTTY(tt.tty)
+		io.SetStdoutTTY(tt.tty)
+		tt.opts.IO = io
+
+		t.Run(tt.name, func(t *testing.T) {
+			defer reg.Verify(t)
+			err := transferRun(tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package uploadfile
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type UploadFileOptions struct {
+	HttpClient func() (*http.Client, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	IO         *iostreams.IOStreams
+
+	SourceFile      string
+	DestinationPath string
+	Message         string
+	Branch          string
+}
+
+func NewCmdUploadFile(f *cmdutil.Factory, runF func(*UploadFileOptions) error) *cobra.Command {
+	opts := &UploadFileOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "upload-file <file> [<destination-path>]",
+		Short: "Create or update a file in a repository",
+		Long: heredoc.Doc(`
+			Commit a local file to a repository without cloning it.
+
+			The file is created on the target branch if it does not exist, and
+			updated in place otherwise. Without a destination path, the file is
+			placed at the root of the repository under its local name.
+		`),
+		Example: heredoc.Doc(`
+			# add a file to the root of the current repository
+			$ gh repo upload-file ci.yml
+
+			# update a workflow on a branch of another repository
+			$ gh repo upload-file ci.yml .github/workflows/ci.yml --repo OWNER/REPO --branch infra
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.SourceFile = args[0]
+			if len(args) > 1 {
+				opts.DestinationPath = args[1]
+			} else {
+				opts.DestinationPath = filepath.Base(opts.SourceFile)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return uploadFileRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Message, "message", "m", "", "The commit message")
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "The branch to commit to (default: the default branch)")
+
+	return cmd
+}
+
+func uploadFileRun(opts *UploadFileOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	content, err := ioutil.ReadFile(opts.SourceFile)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", opts.SourceFile, err)
+	}
+
+	existingSHA, err := fetchFileSHA(apiClient, baseRepo, opts.DestinationPath, opts.Branch)
+	if err != nil {
+		return err
+	}
+
+	message := opts.Message
+	if message == "" {
+		if existingSHA == "" {
+			message = fmt.Sprintf("Add %s", opts.DestinationPath)
+		} else {
+			message = fmt.Sprintf("Update %s", opts.DestinationPath)
+		}
+	}
+
+	body := map[string]interface{}{
+		"message": message,
+		"content": base64.StdEncoding.EncodeToString(content),
+	}
+	if opts.Branch != "" {
+		body["branch"] = opts.Branch
+	}
+	if existingSHA != "" {
+		body["sha"] = existingSHA
+	}
+
+	path := fmt.Sprintf("repos/%s/contents/%s", ghrepo.FullName(baseRepo), opts.DestinationPath)
+	var result struct {
+		Commit struct {
+			SHA string `json:"sha"`
+		} `json:"commit"`
+	}
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	if err := apiClient.REST(baseRepo.RepoHost(), "PUT", path, bytes.NewReader(bodyBytes), &result); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		verb := "Updated"
+		if existingSHA == "" {
+			verb = "Created"
+		}
+		fmt.Fprintf(opts.IO.Out, "%s %s %s in %s\n", cs.SuccessIcon(), verb, opts.DestinationPath, ghrepo.FullName(baseRepo))
+	}
+
+	return nil
+}
+
+// fetchFileSHA returns the blob SHA of the file at path on the given branch,
+// or the empty string if the file does not exist yet.
+func fetchFileSHA(apiClient *api.Client, repo ghrepo.Interface, path, branch string) (string, error) {
+	apiPath := fmt.Sprintf("repos/%s/contents/%s", ghrepo.FullName(repo), path)
+	if branch != "" {
+		apiPath += fmt.Sprintf("?ref=%s", branch)
+	}
+
+	var result struct {
+		SHA string `json:"sha"`
+	}
+	err := apiClient.REST(repo.RepoHost(), "GET", apiPath, nil, &result)
+	if err != nil {
+		var httpError api.HTTPError
+		if errors.As(err, &httpError) && httpError.StatusCode == 404 {
+			return "", nil
+		}
+		return "", err
+	}
+	return result.SHA, nil
+}
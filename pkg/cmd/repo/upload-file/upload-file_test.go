@@ -0,0 +1,167 @@
+package uploadfile
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdUploadFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    UploadFileOptions
+		wantsErr bool
+	}{
+		{
+			name: "file only",
+			cli:  "ci.yml",
+			wants: UploadFileOptions{
+				SourceFile:      "ci.yml",
+				DestinationPath: "ci.yml",
+			},
+		},
+		{
+			name: "destination path and flags",
+			cli:  "ci.yml .github/workflows/ci.yml -m 'Update CI' -b infra",
+			wants: UploadFileOptions{
+				SourceFile:      "ci.yml",
+				DestinationPath: ".github/workflows/ci.yml",
+				Message:         "Update CI",
+				Branch:          "infra",
+			},
+		},
+		{
+			name:     "no arguments",
+			cli:      "",
+			wantsErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *UploadFileOptions
+			cmd := NewCmdUploadFile(f, func(opts *UploadFileOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.SourceFile, gotOpts.SourceFile)
+			assert.Equal(t, tt.wants.DestinationPath, gotOpts.DestinationPath)
+			assert.Equal(t, tt.wants.Message, gotOpts.Message)
+			assert.Equal(t, tt.wants.Branch, gotOpts.Branch)
+		})
+	}
+}
+
+func TestUploadFileRun(t *testing.T) {
+	sourceFile := filepath.Join(t.TempDir(), "ci.yml")
+	assert.NoError(t, ioutil.WriteFile(sourceFile, []byte("on: push\n"), 0644))
+	encodedContent := base64.StdEncoding.EncodeToString([]byte("on: push\n"))
+
+	tests := []struct {
+		name       string
+		opts       UploadFileOptions
+		httpStubs  func(*testing.T, *httpmock.Registry)
+		wantStdout string
+	}{
+		{
+			name: "creates a new file",
+			opts: UploadFileOptions{
+				SourceFile:      sourceFile,
+				DestinationPath: "ci.yml",
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/ci.yml"),
+					httpmock.StatusStringResponse(404, `{"message": "Not Found"}`))
+				reg.Register(
+					httpmock.REST("PUT", "repos/OWNER/REPO/contents/ci.yml"),
+					httpmock.RESTPayload(201, `{"commit": {"sha": "deadbeef"}}`, func(payload map[string]interface{}) {
+						assert.Equal(t, 2, len(payload))
+						assert.Equal(t, "Add ci.yml", payload["message"])
+						assert.Equal(t, encodedContent, payload["content"])
+					}))
+			},
+			wantStdout: "✓ Created ci.yml in OWNER/REPO\n",
+		},
+		{
+			name: "updates an existing file on a branch",
+			opts: UploadFileOptions{
+				SourceFile:      sourceFile,
+				DestinationPath: "ci.yml",
+				Message:         "Sync CI config",
+				Branch:          "infra",
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/ci.yml"),
+					httpmock.StringResponse(`{"sha": "oldsha"}`))
+				reg.Register(
+					httpmock.REST("PUT", "repos/OWNER/REPO/contents/ci.yml"),
+					httpmock.RESTPayload(200, `{"commit": {"sha": "deadbeef"}}`, func(payload map[string]interface{}) {
+						assert.Equal(t, "Sync CI config", payload["message"])
+						assert.Equal(t, encodedContent, payload["content"])
+						assert.Equal(t, "infra", payload["branch"])
+						assert.Equal(t, "oldsha", payload["sha"])
+					}))
+			},
+			wantStdout: "✓ Updated ci.yml in OWNER/REPO\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(t, reg)
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(true)
+
+			opts := tt.opts
+			opts.IO = io
+			opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			err := uploadFileRun(&opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package visibility
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+func updateVisibility(client *http.Client, repo ghrepo.Interface, visibility string) error {
+	url := fmt.Sprintf("%srepos/%s",
+		ghinstance.RESTPrefix(repo.RepoHost()),
+		ghrepo.FullName(repo))
+
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(map[string]string{"visibility": visibility}); err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest("PATCH", url, body)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
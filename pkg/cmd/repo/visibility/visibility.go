@@ -0,0 +1,166 @@
+package visibility
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+type VisibilityOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	IO         *iostreams.IOStreams
+
+	Visibility string
+	RepoArg    string
+	Confirmed  bool
+}
+
+func NewCmdVisibility(f *cmdutil.Factory, runF func(*VisibilityOptions) error) *cobra.Command {
+	opts := &VisibilityOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "visibility {public | private | internal} [<repository>]",
+		Short: "Change the visibility of a repository",
+		Long: heredoc.Doc(`Change the visibility of a GitHub repository.
+
+			With no repository argument, changes the visibility of the current repository.
+
+			Changing visibility has permanent side effects:
+			- making a repository private permanently deletes its stars and watchers;
+			- making a repository private detaches its public forks into standalone repositories;
+			- making a repository public exposes its entire history, including past commits.
+
+			The 'internal' visibility is only available for repositories owned by organizations.`),
+		Example: heredoc.Doc(`
+			$ gh repo visibility private
+			$ gh repo visibility public OWNER/REPO --confirm
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Visibility = args[0]
+			if len(args) > 1 {
+				opts.RepoArg = args[1]
+			}
+
+			switch opts.Visibility {
+			case "public", "private", "internal":
+			default:
+				return cmdutil.FlagErrorf("invalid visibility: %q (valid values are: public, private, internal)", opts.Visibility)
+			}
+
+			if !opts.Confirmed && !opts.IO.CanPrompt() {
+				return cmdutil.FlagErrorf("--confirm required when not running interactively")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return visibilityRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Confirmed, "confirm", "y", false, "Skip the confirmation prompt")
+	return cmd
+}
+
+func visibilityRun(opts *VisibilityOptions) error {
+	cs := opts.IO.ColorScheme()
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var toUpdate ghrepo.Interface
+
+	if opts.RepoArg == "" {
+		toUpdate, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	} else {
+		repoSelector := opts.RepoArg
+		if !strings.Contains(repoSelector, "/") {
+			cfg, err := opts.Config()
+			if err != nil {
+				return err
+			}
+
+			hostname, err := cfg.DefaultHost()
+			if err != nil {
+				return err
+			}
+
+			currentUser, err := api.CurrentLoginName(apiClient, hostname)
+			if err != nil {
+				return err
+			}
+			repoSelector = currentUser + "/" + repoSelector
+		}
+
+		toUpdate, err = ghrepo.FromFullName(repoSelector)
+		if err != nil {
+			return err
+		}
+	}
+
+	fullName := ghrepo.FullName(toUpdate)
+
+	if !opts.Confirmed {
+		if opts.Visibility == "private" {
+			fmt.Fprintf(opts.IO.ErrOut,
+				"%s Making %s private will permanently delete its stars and watchers, and detach its public forks\n",
+				cs.WarningIcon(),
+				fullName)
+		} else if opts.Visibility == "public" {
+			fmt.Fprintf(opts.IO.ErrOut,
+				"%s Making %s public will expose its entire history\n",
+				cs.WarningIcon(),
+				fullName)
+		}
+
+		var confirmed bool
+		p := &survey.Confirm{
+			Message: fmt.Sprintf("Change the visibility of %s to %s?", fullName, opts.Visibility),
+			Default: false,
+		}
+		if err := prompt.SurveyAskOne(p, &confirmed); err != nil {
+			return fmt.Errorf("failed to prompt: %w", err)
+		}
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+	}
+
+	err = updateVisibility(httpClient, toUpdate, opts.Visibility)
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out,
+			"%s Changed visibility of repository %s to %s\n",
+			cs.SuccessIcon(),
+			fullName,
+			opts.Visibility)
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package visibility
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdVisibility(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		tty     bool
+		output  VisibilityOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:   "confirm flag",
+			tty:    true,
+			input:  "private OWNER/REPO --confirm",
+			output: VisibilityOptions{Visibility: "private", RepoArg: "OWNER/REPO", Confirmed: true},
+		},
+		{
+			name:    "no confirmation notty",
+			input:   "private OWNER/REPO",
+			output:  VisibilityOptions{Visibility: "private", RepoArg: "OWNER/REPO"},
+			wantErr: true,
+			errMsg:  "--confirm required when not running interactively",
+		},
+		{
+			name:    "invalid visibility",
+			tty:     true,
+			input:   "secret OWNER/REPO",
+			wantErr: true,
+			errMsg:  `invalid visibility: "secret" (valid values are: public, private, internal)`,
+		},
+		{
+			name:   "base repo resolution",
+			input:  "public",
+			tty:    true,
+			output: VisibilityOptions{Visibility: "public"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.SetStdinTTY(tt.tty)
+			io.SetStdoutTTY(tt.tty)
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *VisibilityOptions
+			cmd := NewCmdVisibility(f, func(opts *VisibilityOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.Visibility, gotOpts.Visibility)
+			assert.Equal(t, tt.output.RepoArg, gotOpts.RepoArg)
+		})
+	}
+}
+
+func Test_visibilityRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		tty        bool
+		opts       *VisibilityOptions
+		httpStubs  func(*httpmock.Registry)
+		askStubs   func(*prompt.AskStubber)
+		wantStdout string
+		wantStderr string
+		wantErr    bool
+		errMsg     string
+	}{
+		{
+			name:       "prompting confirmation tty",
+			tty:        true,
+			opts:       &VisibilityOptions{Visibility: "private", RepoArg: "OWNER/REPO"},
+			wantStdout: "✓ Changed visibility of repository OWNER/REPO to private\n",
+			wantStderr: "! Making OWNER/REPO private will permanently delete its stars and watchers, and detach its public forks\n",
+			askStubs: func(q *prompt.AskStubber) {
+				q.StubPrompt("Change the visibility of OWNER/REPO to private?").AnswerWith(true)
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("PATCH", "repos/OWNER/REPO"),
+					httpmock.RESTPayload(200, "{}", func(payload map[string]interface{}) {
+						assert.Equal(t, "private", payload["visibility"])
+					}))
+			},
+		},
+		{
+			name:       "decline confirmation",
+			tty:        true,
+			opts:       &VisibilityOptions{Visibility: "public", RepoArg: "OWNER/REPO"},
+			wantStderr: "! Making OWNER/REPO public will expose its entire history\n",
+			askStubs: func(q *prompt.AskStubber) {
+				q.StubPrompt("Change the visibility of OWNER/REPO to public?").AnswerWith(false)
+			},
+			wantErr: true,
+			errMsg:  "CancelError",
+		},
+		{
+			name: "confirmation no tty",
+			opts: &VisibilityOptions{
+				Visibility: "internal",
+				RepoArg:    "OWNER/REPO",
+				Confirmed:  true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("PATCH", "repos/OWNER/REPO"),
+					httpmock.StatusStringResponse(200, "{}"))
+			},
+		},
+		{
+			name:       "infer base repo",
+			tty:        true,
+			opts:       &VisibilityOptions{Visibility: "private", Confirmed: true},
+			wantStdout: "✓ Changed visibility of repository OWNER/REPO to private\n",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("PATCH", "repos/OWNER/REPO"),
+					httpmock.StatusStringResponse(200, "{}"))
+			},
+		},
+		{
+			name:       "short repo name",
+			opts:       &VisibilityOptions{Visibility: "private", RepoArg: "REPO", Confirmed: true},
+			wantStdout: "✓ Changed visibility of repository OWNER/REPO to private\n",
+			tty:        true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"OWNER"}}}`))
+				reg.Register(
+					httpmock.REST("PATCH", "repos/OWNER/REPO"),
+					httpmock.StatusStringResponse(200, "{}"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		q := prompt.NewAskStubber(t)
+		if tt.askStubs != nil {
+			tt.askStubs(q)
+		}
+
+		tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		}
+		tt.opts.Config = func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		}
+
+		reg := &httpmock.Registry{}
+		if tt.httpStubs != nil {
+			tt.httpStubs(reg)
+		}
+		tt.opts.HttpClient = func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		}
+
+		io, _, stdout, stderr := iostreams.Test()
+		io.SetStdinTTY(tt.tty)
+		io.SetStdoutTTY(tt.tty)
+		tt.opts.IO = io
+
+		t.Run(tt.name, func(t *testing.T) {
+			defer reg.Verify(t)
+			err := visibilityRun(tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
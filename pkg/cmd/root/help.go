@@ -82,6 +82,11 @@ func isRootCmd(command *cobra.Command) bool {
 func rootHelpFunc(f *cmdutil.Factory, command *cobra.Command, args []string) {
 	cs := f.IOStreams.ColorScheme()
 
+	if showExamples, _ := command.Flags().GetBool("examples"); showExamples {
+		examplesHelpFunc(f, command)
+		return
+	}
+
 	if isRootCmd(command.Parent()) && len(args) >= 2 && args[1] != "--help" && args[1] != "-h" {
 		nestedSuggestFunc(command, args[1])
 		hasFailed = true
@@ -192,10 +197,25 @@ Read the manual at https://cli.github.com/manual`})
 	}
 }
 
+// examplesHelpFunc prints just the examples section of a command's help, so
+// that usage samples can be browsed without scrolling past the full help text.
+func examplesHelpFunc(f *cmdutil.Factory, command *cobra.Command) {
+	cs := f.IOStreams.ColorScheme()
+	out := command.OutOrStdout()
+
+	if command.Example == "" {
+		fmt.Fprintf(out, "no examples available for %q\n", command.CommandPath())
+		fmt.Fprintf(out, "Use '%s --help' for more information about the command.\n", command.CommandPath())
+		return
+	}
+
+	fmt.Fprintln(out, cs.Bold("EXAMPLES"))
+	fmt.Fprintln(out, text.Indent(strings.Trim(command.Example, "\r\n"), "  "))
+}
+
 // rpad adds padding to the right of a string.
 func rpad(s string, padding int) string {
-	template := fmt.Sprintf("%%-%ds ", padding)
-	return fmt.Sprintf(template, s)
+	return text.PadRight(padding, s) + " "
 }
 
 func dedent(s string) string {
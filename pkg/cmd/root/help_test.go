@@ -1,9 +1,52 @@
 package root
 
 import (
+	"strings"
 	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
 )
 
+func TestRootHelpFunc_examples(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+	f := &cmdutil.Factory{IOStreams: io}
+
+	cmd := &cobra.Command{
+		Use:     "frob",
+		Example: "$ gh frob --all\n",
+	}
+	cmd.Flags().Bool("examples", true, "")
+	cmd.SetOut(io.Out)
+
+	rootHelpFunc(f, cmd, []string{"frob", "--examples"})
+
+	out := stdout.String()
+	if !strings.Contains(out, "EXAMPLES") || !strings.Contains(out, "$ gh frob --all") {
+		t.Errorf("expected examples section, got: %q", out)
+	}
+	if strings.Contains(out, "USAGE") {
+		t.Errorf("expected full help to be omitted, got: %q", out)
+	}
+}
+
+func TestRootHelpFunc_noExamples(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+	f := &cmdutil.Factory{IOStreams: io}
+
+	cmd := &cobra.Command{Use: "frob"}
+	cmd.Flags().Bool("examples", true, "")
+	cmd.SetOut(io.Out)
+
+	rootHelpFunc(f, cmd, []string{"frob", "--examples"})
+
+	out := stdout.String()
+	if !strings.Contains(out, `no examples available for "frob"`) {
+		t.Errorf("expected no-examples message, got: %q", out)
+	}
+}
+
 func TestDedent(t *testing.T) {
 	type c struct {
 		input    string
@@ -74,6 +74,9 @@ var HelpTopics = map[string]map[string]string{
 
 			GH_CONFIG_DIR: the directory where gh will store configuration files. Default:
 			"$XDG_CONFIG_HOME/gh" or "$HOME/.config/gh".
+
+			GH_LANG, LC_ALL, LANG (in order of precedence): the language to use for translated
+			output. Messages without a translation are printed in English.
 		`),
 	},
 	"reference": {
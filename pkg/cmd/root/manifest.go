@@ -0,0 +1,77 @@
+package root
+
+import (
+	"encoding/json"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type commandManifest struct {
+	Name     string            `json:"name"`
+	Path     string            `json:"path"`
+	Short    string            `json:"short,omitempty"`
+	Aliases  []string          `json:"aliases,omitempty"`
+	Flags    []flagManifest    `json:"flags,omitempty"`
+	Commands []commandManifest `json:"commands,omitempty"`
+}
+
+type flagManifest struct {
+	Name      string `json:"name"`
+	Shorthand string `json:"shorthand,omitempty"`
+	Type      string `json:"type"`
+	Default   string `json:"default,omitempty"`
+	Usage     string `json:"usage,omitempty"`
+}
+
+// newManifestCmd returns a hidden command that serializes the full command and
+// flag tree as JSON, so that external tools such as IDE plugins and docs
+// generators can stay in sync with gh without parsing help output.
+func newManifestCmd(io *iostreams.IOStreams, rootCmd *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "__complete-manifest",
+		Short:  "Dump the command and flag tree as JSON",
+		Hidden: true,
+		Args:   cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			enc := json.NewEncoder(io.Out)
+			enc.SetIndent("", "  ")
+			return enc.Encode(manifestForCommand(rootCmd))
+		},
+	}
+	cmdutil.DisableAuthCheck(cmd)
+	return cmd
+}
+
+func manifestForCommand(cmd *cobra.Command) commandManifest {
+	m := commandManifest{
+		Name:    cmd.Name(),
+		Path:    cmd.CommandPath(),
+		Short:   cmd.Short,
+		Aliases: cmd.Aliases,
+	}
+
+	cmd.LocalFlags().VisitAll(func(f *pflag.Flag) {
+		if f.Hidden || f.Name == "help" {
+			return
+		}
+		m.Flags = append(m.Flags, flagManifest{
+			Name:      f.Name,
+			Shorthand: f.Shorthand,
+			Type:      f.Value.Type(),
+			Default:   f.DefValue,
+			Usage:     f.Usage,
+		})
+	})
+
+	for _, c := range cmd.Commands() {
+		if c.Hidden {
+			continue
+		}
+		m.Commands = append(m.Commands, manifestForCommand(c))
+	}
+
+	return m
+}
@@ -0,0 +1,49 @@
+package root
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestCmd(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "gh"}
+
+	childCmd := &cobra.Command{Use: "frob", Short: "Frob a widget", Aliases: []string{"fr"}}
+	childCmd.Flags().StringP("color", "c", "red", "Paint `color`")
+	childCmd.Flags().Bool("dry-run", false, "Do not actually frob")
+	rootCmd.AddCommand(childCmd)
+
+	hiddenCmd := &cobra.Command{Use: "secret", Hidden: true}
+	rootCmd.AddCommand(hiddenCmd)
+
+	io, _, stdout, _ := iostreams.Test()
+	cmd := newManifestCmd(io, rootCmd)
+
+	require.NoError(t, cmd.RunE(cmd, nil))
+
+	var m commandManifest
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &m))
+
+	assert.Equal(t, "gh", m.Name)
+	require.Len(t, m.Commands, 1)
+
+	frob := m.Commands[0]
+	assert.Equal(t, "frob", frob.Name)
+	assert.Equal(t, "gh frob", frob.Path)
+	assert.Equal(t, "Frob a widget", frob.Short)
+	assert.Equal(t, []string{"fr"}, frob.Aliases)
+	require.Len(t, frob.Flags, 2)
+	assert.Equal(t, flagManifest{
+		Name:      "color",
+		Shorthand: "c",
+		Type:      "string",
+		Default:   "red",
+		Usage:     "Paint `color`",
+	}, frob.Flags[0])
+	assert.Equal(t, "bool", frob.Flags[1].Type)
+}
@@ -7,27 +7,40 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	codespacesAPI "github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/internal/i18n"
 	actionsCmd "github.com/cli/cli/v2/pkg/cmd/actions"
 	aliasCmd "github.com/cli/cli/v2/pkg/cmd/alias"
 	apiCmd "github.com/cli/cli/v2/pkg/cmd/api"
 	authCmd "github.com/cli/cli/v2/pkg/cmd/auth"
+	branchCmd "github.com/cli/cli/v2/pkg/cmd/branch"
 	browseCmd "github.com/cli/cli/v2/pkg/cmd/browse"
+	cacheCmd "github.com/cli/cli/v2/pkg/cmd/cache"
 	codespaceCmd "github.com/cli/cli/v2/pkg/cmd/codespace"
+	commitCmd "github.com/cli/cli/v2/pkg/cmd/commit"
 	completionCmd "github.com/cli/cli/v2/pkg/cmd/completion"
 	configCmd "github.com/cli/cli/v2/pkg/cmd/config"
+	dependabotCmd "github.com/cli/cli/v2/pkg/cmd/dependabot"
+	discussionCmd "github.com/cli/cli/v2/pkg/cmd/discussion"
+	doctorCmd "github.com/cli/cli/v2/pkg/cmd/doctor"
 	extensionCmd "github.com/cli/cli/v2/pkg/cmd/extension"
 	"github.com/cli/cli/v2/pkg/cmd/factory"
 	gistCmd "github.com/cli/cli/v2/pkg/cmd/gist"
 	gpgKeyCmd "github.com/cli/cli/v2/pkg/cmd/gpg-key"
 	issueCmd "github.com/cli/cli/v2/pkg/cmd/issue"
+	orgCmd "github.com/cli/cli/v2/pkg/cmd/org"
 	prCmd "github.com/cli/cli/v2/pkg/cmd/pr"
+	rateLimitCmd "github.com/cli/cli/v2/pkg/cmd/ratelimit"
 	releaseCmd "github.com/cli/cli/v2/pkg/cmd/release"
 	repoCmd "github.com/cli/cli/v2/pkg/cmd/repo"
 	creditsCmd "github.com/cli/cli/v2/pkg/cmd/repo/credits"
 	runCmd "github.com/cli/cli/v2/pkg/cmd/run"
 	secretCmd "github.com/cli/cli/v2/pkg/cmd/secret"
 	sshKeyCmd "github.com/cli/cli/v2/pkg/cmd/ssh-key"
+	statsCmd "github.com/cli/cli/v2/pkg/cmd/stats"
+	tagCmd "github.com/cli/cli/v2/pkg/cmd/tag"
+	teamCmd "github.com/cli/cli/v2/pkg/cmd/team"
 	versionCmd "github.com/cli/cli/v2/pkg/cmd/version"
+	webhookCmd "github.com/cli/cli/v2/pkg/cmd/webhook"
 	workflowCmd "github.com/cli/cli/v2/pkg/cmd/workflow"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -37,7 +50,7 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "gh <command> <subcommand> [flags]",
 		Short: "GitHub CLI",
-		Long:  `Work seamlessly with GitHub from the command line.`,
+		Long:  i18n.T("Work seamlessly with GitHub from the command line."),
 
 		SilenceErrors: true,
 		SilenceUsage:  true,
@@ -60,6 +73,8 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) *cobra.Command {
 	cmd.SetErr(f.IOStreams.ErrOut)
 
 	cmd.PersistentFlags().Bool("help", false, "Show help for command")
+	cmd.PersistentFlags().Bool("examples", false, "Show only the examples for command")
+	cmd.PersistentFlags().Bool("json-errors", false, "Emit errors as JSON on standard error when not writing to a terminal")
 	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
 		rootHelpFunc(f, cmd, args)
 	})
@@ -78,12 +93,22 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) *cobra.Command {
 	cmd.AddCommand(authCmd.NewCmdAuth(f))
 	cmd.AddCommand(configCmd.NewCmdConfig(f))
 	cmd.AddCommand(creditsCmd.NewCmdCredits(f, nil))
+	cmd.AddCommand(doctorCmd.NewCmdDoctor(f, nil))
 	cmd.AddCommand(gistCmd.NewCmdGist(f))
 	cmd.AddCommand(gpgKeyCmd.NewCmdGPGKey(f))
+	cmd.AddCommand(orgCmd.NewCmdOrg(f))
+	cmd.AddCommand(rateLimitCmd.NewCmdRateLimit(f, nil))
 	cmd.AddCommand(completionCmd.NewCmdCompletion(f.IOStreams))
 	cmd.AddCommand(extensionCmd.NewCmdExtension(f))
 	cmd.AddCommand(secretCmd.NewCmdSecret(f))
 	cmd.AddCommand(sshKeyCmd.NewCmdSSHKey(f))
+	cmd.AddCommand(statsCmd.NewCmdStats(f, nil))
+	cmd.AddCommand(commitCmd.NewCmdCommit(f))
+	cmd.AddCommand(tagCmd.NewCmdTag(f))
+	cmd.AddCommand(teamCmd.NewCmdTeam(f))
+	cmd.AddCommand(branchCmd.NewCmdBranch(f))
+	cmd.AddCommand(dependabotCmd.NewCmdDependabot(f))
+	cmd.AddCommand(webhookCmd.NewCmdWebhook(f))
 	cmd.AddCommand(newCodespaceCmd(f))
 
 	// the `api` command should not inherit any extra HTTP headers
@@ -99,9 +124,11 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) *cobra.Command {
 	cmd.AddCommand(browseCmd.NewCmdBrowse(&repoResolvingCmdFactory, nil))
 	cmd.AddCommand(prCmd.NewCmdPR(&repoResolvingCmdFactory))
 	cmd.AddCommand(issueCmd.NewCmdIssue(&repoResolvingCmdFactory))
+	cmd.AddCommand(discussionCmd.NewCmdDiscussion(&repoResolvingCmdFactory))
 	cmd.AddCommand(releaseCmd.NewCmdRelease(&repoResolvingCmdFactory))
 	cmd.AddCommand(repoCmd.NewCmdRepo(&repoResolvingCmdFactory))
 	cmd.AddCommand(runCmd.NewCmdRun(&repoResolvingCmdFactory))
+	cmd.AddCommand(cacheCmd.NewCmdCache(&repoResolvingCmdFactory))
 	cmd.AddCommand(workflowCmd.NewCmdWorkflow(&repoResolvingCmdFactory))
 
 	// Help topics
@@ -112,6 +139,8 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) *cobra.Command {
 	referenceCmd.SetHelpFunc(referenceHelpFn(f.IOStreams))
 	cmd.AddCommand(referenceCmd)
 
+	cmd.AddCommand(newManifestCmd(f.IOStreams, cmd))
+
 	cmdutil.DisableAuthCheck(cmd)
 
 	// this needs to appear last:
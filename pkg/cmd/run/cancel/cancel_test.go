@@ -174,8 +174,7 @@ func TestRunCancel(t *testing.T) {
 					httpmock.StatusStringResponse(202, "{}"))
 			},
 			askStubs: func(as *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(0)
+				as.StubPrompt("Select a workflow run").AnswerWith(0)
 			},
 			wantOut: "✓ Request to cancel workflow submitted.\n",
 		},
@@ -196,9 +195,7 @@ func TestRunCancel(t *testing.T) {
 			return ghrepo.FromFullName("OWNER/REPO")
 		}
 
-		//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-		as, teardown := prompt.InitAskStubber()
-		defer teardown()
+		as := prompt.NewAskStubber(t)
 		if tt.askStubs != nil {
 			tt.askStubs(as)
 		}
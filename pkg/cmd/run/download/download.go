@@ -13,8 +13,11 @@ import (
 	"github.com/cli/cli/v2/pkg/prompt"
 	"github.com/cli/cli/v2/pkg/set"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
+const maxConcurrentDownloads = 5
+
 type DownloadOptions struct {
 	IO       *iostreams.IOStreams
 	Platform platform
@@ -24,6 +27,7 @@ type DownloadOptions struct {
 	RunID          string
 	DestinationDir string
 	Names          []string
+	FilePatterns   []string
 }
 
 type platform interface {
@@ -60,13 +64,18 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 		  # Download specific artifacts across all runs in a repository
 		  $ gh run download -n <name1> -n <name2>
 
+		  # Download artifacts matching a glob pattern
+		  $ gh run download <run-id> -p <pattern1> -p <pattern2>
+
 		  # Select artifacts to download interactively
 		  $ gh run download
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				opts.RunID = args[0]
-			} else if len(opts.Names) == 0 && opts.IO.CanPrompt() {
+			} else if len(opts.Names) == 0 &&
+				len(opts.FilePatterns) == 0 &&
+				opts.IO.CanPrompt() {
 				opts.DoPrompt = true
 			}
 
@@ -94,6 +103,7 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 
 	cmd.Flags().StringVarP(&opts.DestinationDir, "dir", "D", ".", "The directory to download artifacts into")
 	cmd.Flags().StringArrayVarP(&opts.Names, "name", "n", nil, "Only download artifacts that match any of the given names")
+	cmd.Flags().StringArrayVarP(&opts.FilePatterns, "pattern", "p", nil, "Only download artifacts that match a glob pattern")
 
 	return cmd
 }
@@ -118,6 +128,7 @@ func runDownload(opts *DownloadOptions) error {
 	}
 
 	wantNames := opts.Names
+	wantPatterns := opts.FilePatterns
 	if opts.DoPrompt {
 		artifactNames := set.NewStringSet()
 		for _, a := range artifacts {
@@ -141,8 +152,9 @@ func runDownload(opts *DownloadOptions) error {
 	opts.IO.StartProgressIndicator()
 	defer opts.IO.StopProgressIndicator()
 
-	// track downloaded artifacts and avoid re-downloading any of the same name
+	// determine the set of artifacts to download, avoiding repeats of the same name
 	downloaded := set.NewStringSet()
+	var toDownload []shared.Artifact
 	for _, a := range artifacts {
 		if a.Expired {
 			continue
@@ -150,30 +162,52 @@ func runDownload(opts *DownloadOptions) error {
 		if downloaded.Contains(a.Name) {
 			continue
 		}
-		if len(wantNames) > 0 && !matchAny(wantNames, a.Name) {
-			continue
-		}
-		destDir := opts.DestinationDir
-		if len(wantNames) != 1 {
-			destDir = filepath.Join(destDir, a.Name)
-		}
-		err := opts.Platform.Download(a.DownloadURL, destDir)
-		if err != nil {
-			return fmt.Errorf("error downloading %s: %w", a.Name, err)
+		if len(wantNames) > 0 || len(wantPatterns) > 0 {
+			if !matchAnyName(wantNames, a.Name) && !matchAnyPattern(wantPatterns, a.Name) {
+				continue
+			}
 		}
 		downloaded.Add(a.Name)
+		toDownload = append(toDownload, a)
 	}
 
-	if downloaded.Len() == 0 {
-		return errors.New("no artifact matches any of the names provided")
+	if len(toDownload) == 0 {
+		return errors.New("no artifact matches any of the names or patterns provided")
 	}
 
-	return nil
+	var wg errgroup.Group
+	sem := make(chan struct{}, maxConcurrentDownloads)
+	for _, a := range toDownload {
+		a := a
+		wg.Go(func() error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			destDir := opts.DestinationDir
+			if len(wantNames) != 1 || len(wantPatterns) > 0 {
+				destDir = filepath.Join(destDir, a.Name)
+			}
+			if err := opts.Platform.Download(a.DownloadURL, destDir); err != nil {
+				return fmt.Errorf("error downloading %s: %w", a.Name, err)
+			}
+			return nil
+		})
+	}
+
+	return wg.Wait()
+}
+
+func matchAnyName(names []string, name string) bool {
+	for _, n := range names {
+		if name == n {
+			return true
+		}
+	}
+	return false
 }
 
-func matchAny(patterns []string, name string) bool {
+func matchAnyPattern(patterns []string, name string) bool {
 	for _, p := range patterns {
-		if name == p {
+		if isMatch, err := filepath.Match(p, name); err == nil && isMatch {
 			return true
 		}
 	}
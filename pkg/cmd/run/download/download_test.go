@@ -69,6 +69,29 @@ func Test_NewCmdDownload(t *testing.T) {
 				DestinationDir: ".",
 			},
 		},
+		{
+			name:  "repo level with patterns",
+			args:  "-p o*e -p tw*",
+			isTTY: true,
+			want: DownloadOptions{
+				RunID:          "",
+				DoPrompt:       false,
+				FilePatterns:   []string{"o*e", "tw*"},
+				DestinationDir: ".",
+			},
+		},
+		{
+			name:  "repo level with names and patterns",
+			args:  "-p o*e -n two",
+			isTTY: true,
+			want: DownloadOptions{
+				RunID:          "",
+				DoPrompt:       false,
+				Names:          []string{"two"},
+				FilePatterns:   []string{"o*e"},
+				DestinationDir: ".",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -112,6 +135,7 @@ func Test_NewCmdDownload(t *testing.T) {
 
 			assert.Equal(t, tt.want.RunID, opts.RunID)
 			assert.Equal(t, tt.want.Names, opts.Names)
+			assert.Equal(t, tt.want.FilePatterns, opts.FilePatterns)
 			assert.Equal(t, tt.want.DestinationDir, opts.DestinationDir)
 			assert.Equal(t, tt.want.DoPrompt, opts.DoPrompt)
 		})
@@ -155,6 +179,35 @@ func Test_runDownload(t *testing.T) {
 				p.On("Download", "http://download.com/artifact2.zip", filepath.FromSlash("tmp/artifact-2")).Return(nil)
 			},
 		},
+		{
+			name: "download artifacts matching pattern into destination dir",
+			opts: DownloadOptions{
+				RunID:          "2345",
+				DestinationDir: "./tmp",
+				FilePatterns:   []string{"test-*"},
+			},
+			mockAPI: func(p *mockPlatform) {
+				p.On("List", "2345").Return([]shared.Artifact{
+					{
+						Name:        "test-artifact-1",
+						DownloadURL: "http://download.com/artifact1.zip",
+						Expired:     false,
+					},
+					{
+						Name:        "artifact-2",
+						DownloadURL: "http://download.com/artifact2.zip",
+						Expired:     false,
+					},
+					{
+						Name:        "test-artifact-3",
+						DownloadURL: "http://download.com/artifact3.zip",
+						Expired:     false,
+					},
+				}, nil)
+				p.On("Download", "http://download.com/artifact1.zip", filepath.FromSlash("tmp/test-artifact-1")).Return(nil)
+				p.On("Download", "http://download.com/artifact3.zip", filepath.FromSlash("tmp/test-artifact-3")).Return(nil)
+			},
+		},
 		{
 			name: "no valid artifacts",
 			opts: DownloadOptions{
@@ -199,7 +252,7 @@ func Test_runDownload(t *testing.T) {
 					},
 				}, nil)
 			},
-			wantErr: "no artifact matches any of the names provided",
+			wantErr: "no artifact matches any of the names or patterns provided",
 		},
 		{
 			name: "prompt to select artifact",
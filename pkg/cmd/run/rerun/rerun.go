@@ -18,7 +18,9 @@ type RerunOptions struct {
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
 
-	RunID string
+	RunID      string
+	OnlyFailed bool
+	JobID      string
 
 	Prompt bool
 }
@@ -39,12 +41,21 @@ func NewCmdRerun(f *cmdutil.Factory, runF func(*RerunOptions) error) *cobra.Comm
 
 			if len(args) > 0 {
 				opts.RunID = args[0]
-			} else if !opts.IO.CanPrompt() {
-				return cmdutil.FlagErrorf("run ID required when not running interactively")
-			} else {
+			} else if opts.JobID == "" {
+				if !opts.IO.CanPrompt() {
+					return cmdutil.FlagErrorf("run ID required when not running interactively")
+				}
 				opts.Prompt = true
 			}
 
+			if opts.RunID != "" && opts.JobID != "" {
+				return cmdutil.FlagErrorf("specify only one of `<run-id>` argument or `--job` flag")
+			}
+
+			if opts.OnlyFailed && opts.JobID != "" {
+				return cmdutil.FlagErrorf("`--failed` is not supported with `--job`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -52,6 +63,11 @@ func NewCmdRerun(f *cmdutil.Factory, runF func(*RerunOptions) error) *cobra.Comm
 		},
 	}
 
+	cmd.Flags().BoolVar(&opts.OnlyFailed, "failed", false, "Rerun only the failed jobs, including dependencies")
+	cmd.Flags().StringVarP(&opts.JobID, "job", "j", "", "Rerun a specific `job` from a run, including dependencies")
+
+	cmdutil.RequireScopes(cmd, "workflow")
+
 	return cmd
 }
 
@@ -67,6 +83,35 @@ func runRerun(opts *RerunOptions) error {
 		return fmt.Errorf("failed to determine base repo: %w", err)
 	}
 
+	if opts.JobID != "" {
+		opts.IO.StartProgressIndicator()
+		job, err := shared.GetJob(client, repo, opts.JobID)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return fmt.Errorf("failed to get job: %w", err)
+		}
+
+		path := fmt.Sprintf("repos/%s/actions/jobs/%d/rerun", ghrepo.FullName(repo), job.ID)
+		err = client.REST(repo.RepoHost(), "POST", path, nil, nil)
+		if err != nil {
+			var httpError api.HTTPError
+			if errors.As(err, &httpError) && httpError.StatusCode == 403 {
+				return fmt.Errorf("job %d cannot be rerun; ensure your token has the `workflow` scope and the run has completed", job.ID)
+			}
+			return fmt.Errorf("failed to rerun job: %w", err)
+		}
+
+		if opts.IO.CanPrompt() {
+			cs := opts.IO.ColorScheme()
+			fmt.Fprintf(opts.IO.Out, "%s Requested rerun of job %s on run %s\n",
+				cs.SuccessIcon(),
+				cs.Cyanf("%d", job.ID),
+				cs.Cyanf("%d", job.RunID))
+		}
+
+		return nil
+	}
+
 	runID := opts.RunID
 
 	if opts.Prompt {
@@ -98,21 +143,31 @@ func runRerun(opts *RerunOptions) error {
 		return fmt.Errorf("failed to get run: %w", err)
 	}
 
-	path := fmt.Sprintf("repos/%s/actions/runs/%d/rerun", ghrepo.FullName(repo), run.ID)
+	runVerb := "rerun"
+	if opts.OnlyFailed {
+		runVerb = "rerun-failed-jobs"
+	}
+
+	path := fmt.Sprintf("repos/%s/actions/runs/%d/%s", ghrepo.FullName(repo), run.ID, runVerb)
 
 	err = client.REST(repo.RepoHost(), "POST", path, nil, nil)
 	if err != nil {
 		var httpError api.HTTPError
 		if errors.As(err, &httpError) && httpError.StatusCode == 403 {
-			return fmt.Errorf("run %d cannot be rerun; its workflow file may be broken.", run.ID)
+			return fmt.Errorf("run %d cannot be rerun; its workflow file may be broken or your token may lack the `workflow` scope", run.ID)
 		}
 		return fmt.Errorf("failed to rerun: %w", err)
 	}
 
 	if opts.IO.CanPrompt() {
 		cs := opts.IO.ColorScheme()
-		fmt.Fprintf(opts.IO.Out, "%s Requested rerun of run %s\n",
+		onlyFailedNote := ""
+		if opts.OnlyFailed {
+			onlyFailedNote = "(failed jobs) "
+		}
+		fmt.Fprintf(opts.IO.Out, "%s Requested rerun %sof run %s\n",
 			cs.SuccessIcon(),
+			onlyFailedNote,
 			cs.Cyanf("%d", run.ID))
 	}
 
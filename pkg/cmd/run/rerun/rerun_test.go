@@ -50,6 +50,45 @@ func TestNewCmdRerun(t *testing.T) {
 				RunID: "1234",
 			},
 		},
+		{
+			name: "failed arg nontty",
+			cli:  "4321 --failed",
+			wants: RerunOptions{
+				RunID:      "4321",
+				OnlyFailed: true,
+			},
+		},
+		{
+			name: "failed arg",
+			tty:  true,
+			cli:  "--failed",
+			wants: RerunOptions{
+				Prompt:     true,
+				OnlyFailed: true,
+			},
+		},
+		{
+			name: "with job",
+			cli:  "--job 1234",
+			wants: RerunOptions{
+				JobID: "1234",
+			},
+		},
+		{
+			name:     "with args jobID and runID fails",
+			cli:      "1234 --job 5678",
+			wantsErr: true,
+		},
+		{
+			name:     "with arg job with no ID fails",
+			cli:      "--job",
+			wantsErr: true,
+		},
+		{
+			name:     "with job and failed fails",
+			cli:      "--failed --job 5678",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -85,6 +124,8 @@ func TestNewCmdRerun(t *testing.T) {
 
 			assert.Equal(t, tt.wants.RunID, gotOpts.RunID)
 			assert.Equal(t, tt.wants.Prompt, gotOpts.Prompt)
+			assert.Equal(t, tt.wants.OnlyFailed, gotOpts.OnlyFailed)
+			assert.Equal(t, tt.wants.JobID, gotOpts.JobID)
 		})
 	}
 
@@ -117,6 +158,39 @@ func TestRerun(t *testing.T) {
 			},
 			wantOut: "✓ Requested rerun of run 1234\n",
 		},
+		{
+			name: "arg including onlyFailed",
+			tty:  true,
+			opts: &RerunOptions{
+				RunID:      "1234",
+				OnlyFailed: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(shared.FailedRun))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/rerun-failed-jobs"),
+					httpmock.StringResponse("{}"))
+			},
+			wantOut: "✓ Requested rerun (failed jobs) of run 1234\n",
+		},
+		{
+			name: "arg including a specific job",
+			tty:  true,
+			opts: &RerunOptions{
+				JobID: "20",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/jobs/20"),
+					httpmock.JSONResponse(shared.FailedJob))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/jobs/20/rerun"),
+					httpmock.StringResponse("{}"))
+			},
+			wantOut: "✓ Requested rerun of job 20 on run 1234\n",
+		},
 		{
 			name: "prompt",
 			tty:  true,
@@ -137,8 +211,7 @@ func TestRerun(t *testing.T) {
 					httpmock.StringResponse("{}"))
 			},
 			askStubs: func(as *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(2)
+				as.StubPrompt("Select a workflow run").AnswerWith(2)
 			},
 			wantOut: "✓ Requested rerun of run 1234\n",
 		},
@@ -175,7 +248,7 @@ func TestRerun(t *testing.T) {
 					httpmock.StatusStringResponse(403, "no"))
 			},
 			wantErr: true,
-			errOut:  "run 3 cannot be rerun; its workflow file may be broken.",
+			errOut:  "run 3 cannot be rerun; its workflow file may be broken or your token may lack the `workflow` scope",
 		},
 	}
 
@@ -194,9 +267,7 @@ func TestRerun(t *testing.T) {
 			return ghrepo.FromFullName("OWNER/REPO")
 		}
 
-		//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-		as, teardown := prompt.InitAskStubber()
-		defer teardown()
+		as := prompt.NewAskStubber(t)
 		if tt.askStubs != nil {
 			tt.askStubs(as)
 		}
@@ -375,8 +375,7 @@ func TestViewRun(t *testing.T) {
 					httpmock.JSONResponse([]shared.Annotation{}))
 			},
 			askStubs: func(as *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(2)
+				as.StubPrompt("Select a workflow run").AnswerWith(2)
 			},
 			opts: &ViewOptions{
 				Prompt: true,
@@ -412,10 +411,8 @@ func TestViewRun(t *testing.T) {
 					httpmock.FileResponse("./fixtures/run_log.zip"))
 			},
 			askStubs: func(as *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(2)
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(1)
+				as.StubPrompt("Select a workflow run").AnswerWith(2)
+				as.StubPrompt("View a specific job in this run?").AnswerWith(1)
 			},
 			wantOut: coolJobRunLogOutput,
 		},
@@ -467,10 +464,8 @@ func TestViewRun(t *testing.T) {
 					httpmock.FileResponse("./fixtures/run_log.zip"))
 			},
 			askStubs: func(as *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(2)
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(0)
+				as.StubPrompt("Select a workflow run").AnswerWith(2)
+				as.StubPrompt("View a specific job in this run?").AnswerWith(0)
 			},
 			wantOut: expectedRunLogOutput,
 		},
@@ -528,10 +523,8 @@ func TestViewRun(t *testing.T) {
 					httpmock.FileResponse("./fixtures/run_log.zip"))
 			},
 			askStubs: func(as *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(4)
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(2)
+				as.StubPrompt("Select a workflow run").AnswerWith(4)
+				as.StubPrompt("View a specific job in this run?").AnswerWith(2)
 			},
 			wantOut: quuxTheBarfLogOutput,
 		},
@@ -583,10 +576,8 @@ func TestViewRun(t *testing.T) {
 					httpmock.FileResponse("./fixtures/run_log.zip"))
 			},
 			askStubs: func(as *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(4)
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(0)
+				as.StubPrompt("Select a workflow run").AnswerWith(4)
+				as.StubPrompt("View a specific job in this run?").AnswerWith(0)
 			},
 			wantOut: quuxTheBarfLogOutput,
 		},
@@ -709,10 +700,8 @@ func TestViewRun(t *testing.T) {
 					httpmock.JSONResponse(shared.FailedJobAnnotations))
 			},
 			askStubs: func(as *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(2)
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(0)
+				as.StubPrompt("Select a workflow run").AnswerWith(2)
+				as.StubPrompt("View a specific job in this run?").AnswerWith(0)
 			},
 			wantOut: "\n✓ trunk successful · 3\nTriggered via push about 59 minutes ago\n\nJOBS\n✓ cool job in 4m34s (ID 10)\nX sad job in 4m34s (ID 20)\n  ✓ barf the quux\n  X quux the barf\n\nANNOTATIONS\nX the job is sad\nsad job: blaze.py#420\n\n\nFor more information about a job, try: gh run view --job=<job-id>\nView this run on GitHub: https://github.com/runs/3\n",
 		},
@@ -744,10 +733,8 @@ func TestViewRun(t *testing.T) {
 					httpmock.JSONResponse([]shared.Annotation{}))
 			},
 			askStubs: func(as *prompt.AskStubber) {
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(2)
-				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(1)
+				as.StubPrompt("Select a workflow run").AnswerWith(2)
+				as.StubPrompt("View a specific job in this run?").AnswerWith(1)
 			},
 			wantOut: "\n✓ trunk successful · 3\nTriggered via push about 59 minutes ago\n\n✓ cool job in 4m34s (ID 10)\n  ✓ fob the barz\n  ✓ barz the fob\n\nTo see the full job log, try: gh run view --log --job=10\nView this run on GitHub: https://github.com/runs/3\n",
 		},
@@ -843,9 +830,7 @@ func TestViewRun(t *testing.T) {
 			return ghrepo.FromFullName("OWNER/REPO")
 		}
 
-		//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
-		as, teardown := prompt.InitAskStubber()
-		defer teardown()
+		as := prompt.NewAskStubber(t)
 		if tt.askStubs != nil {
 			tt.askStubs(as)
 		}
@@ -1,17 +1,21 @@
 package watch
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmd/run/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/logstream"
 	"github.com/cli/cli/v2/utils"
 	"github.com/spf13/cobra"
 )
@@ -26,6 +30,7 @@ type WatchOptions struct {
 	RunID      string
 	Interval   int
 	ExitStatus bool
+	Log        bool
 
 	Prompt bool
 
@@ -65,16 +70,17 @@ func NewCmdWatch(f *cmdutil.Factory, runF func(*WatchOptions) error) *cobra.Comm
 				return runF(opts)
 			}
 
-			return watchRun(opts)
+			return watchRun(cmd.Context(), opts)
 		},
 	}
 	cmd.Flags().BoolVar(&opts.ExitStatus, "exit-status", false, "Exit with non-zero status if run fails")
 	cmd.Flags().IntVarP(&opts.Interval, "interval", "i", defaultInterval, "Refresh interval in seconds")
+	cmd.Flags().BoolVar(&opts.Log, "log", false, "Tail live job logs instead of rendering run progress")
 
 	return cmd
 }
 
-func watchRun(opts *WatchOptions) error {
+func watchRun(ctx context.Context, opts *WatchOptions) error {
 	c, err := opts.HttpClient()
 	if err != nil {
 		return fmt.Errorf("failed to create http client: %w", err)
@@ -134,24 +140,47 @@ func watchRun(opts *WatchOptions) error {
 		prNumber = fmt.Sprintf(" #%d", number)
 	}
 
-	if err := opts.IO.EnableVirtualTerminalProcessing(); err == nil {
-		// clear entire screen
-		fmt.Fprintf(out, "\x1b[2J")
-	}
-
-	annotationCache := map[int64][]shared.Annotation{}
-
 	duration, err := time.ParseDuration(fmt.Sprintf("%ds", opts.Interval))
 	if err != nil {
 		return fmt.Errorf("could not parse interval: %w", err)
 	}
 
-	for run.Status != shared.Completed {
-		run, err = renderRun(*opts, client, repo, run, prNumber, annotationCache)
+	if opts.Log {
+		run, err = tailRunLogs(ctx, opts, client, c, repo, run, duration)
 		if err != nil {
 			return err
 		}
-		time.Sleep(duration)
+	} else {
+		if err := opts.IO.EnableVirtualTerminalProcessing(); err == nil {
+			// clear entire screen
+			fmt.Fprintf(out, "\x1b[2J")
+		}
+
+		annotationCache := map[int64][]shared.Annotation{}
+
+		// redraw immediately when the terminal is resized instead of waiting
+		// out the rest of the refresh interval
+		resized := make(chan struct{}, 1)
+		stopResizeListener := opts.IO.StartResizeListener(func() {
+			select {
+			case resized <- struct{}{}:
+			default:
+			}
+		})
+		defer stopResizeListener()
+
+		for run.Status != shared.Completed {
+			run, err = renderRun(*opts, client, repo, run, prNumber, annotationCache)
+			if err != nil {
+				return err
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-resized:
+			case <-time.After(duration):
+			}
+		}
 	}
 
 	symbol, symbolColor := shared.Symbol(cs, run.Status, run.Conclusion)
@@ -169,6 +198,73 @@ func watchRun(opts *WatchOptions) error {
 	return nil
 }
 
+// tailRunLogs streams the logs of the run's jobs to stdout as they execute,
+// subscribing to the live log stream of each job as soon as it starts.
+func tailRunLogs(ctx context.Context, opts *WatchOptions, client *api.Client, httpClient *http.Client, repo ghrepo.Interface, run *shared.Run, interval time.Duration) (*shared.Run, error) {
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+	streamer := logstream.NewClient(httpClient)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	tailed := map[int64]bool{}
+
+	for run.Status != shared.Completed {
+		var err error
+		run, err = shared.GetRun(client, repo, fmt.Sprintf("%d", run.ID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get run: %w", err)
+		}
+
+		jobs, err := shared.GetJobs(client, repo, *run)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get jobs: %w", err)
+		}
+
+		for _, job := range jobs {
+			if tailed[job.ID] || job.Status != shared.InProgress {
+				continue
+			}
+			tailed[job.ID] = true
+			job := job
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				streamURL := fmt.Sprintf("%srepos/%s/actions/jobs/%d/logs/stream",
+					ghinstance.RESTPrefix(repo.RepoHost()), ghrepo.FullName(repo), job.ID)
+				err := streamer.Subscribe(ctx, streamURL, func(ev logstream.Event) {
+					if ev.Name != "" {
+						return
+					}
+					mu.Lock()
+					defer mu.Unlock()
+					fmt.Fprintf(out, "%s %s\n", cs.Bold(job.Name), ev.Data)
+				})
+				if err != nil {
+					mu.Lock()
+					defer mu.Unlock()
+					fmt.Fprintf(opts.IO.ErrOut, "%s failed to stream logs for %s: %v\n", cs.WarningIcon(), job.Name, err)
+				}
+			}()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+
+	// the run is complete; wind down any streams that have not seen a done event
+	cancel()
+	wg.Wait()
+
+	return run, nil
+}
+
 func renderRun(opts WatchOptions, client *api.Client, repo ghrepo.Interface, run *shared.Run, prNumber string, annotationCache map[int64][]shared.Annotation) (*shared.Run, error) {
 	out := opts.IO.Out
 	cs := opts.IO.ColorScheme()
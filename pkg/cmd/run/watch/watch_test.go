@@ -2,6 +2,7 @@ package watch
 
 import (
 	"bytes"
+	"context"
 	"io/ioutil"
 	"net/http"
 	"runtime"
@@ -56,6 +57,15 @@ func TestNewCmdWatch(t *testing.T) {
 				ExitStatus: true,
 			},
 		},
+		{
+			name: "log",
+			cli:  "1234 --log",
+			wants: WatchOptions{
+				Interval: defaultInterval,
+				RunID:    "1234",
+				Log:      true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -93,6 +103,7 @@ func TestNewCmdWatch(t *testing.T) {
 			assert.Equal(t, tt.wants.Prompt, gotOpts.Prompt)
 			assert.Equal(t, tt.wants.ExitStatus, gotOpts.ExitStatus)
 			assert.Equal(t, tt.wants.Interval, gotOpts.Interval)
+			assert.Equal(t, tt.wants.Log, gotOpts.Log)
 		})
 	}
 }
@@ -327,7 +338,7 @@ func TestWatchRun(t *testing.T) {
 				tt.askStubs(as)
 			}
 
-			err := watchRun(tt.opts)
+			err := watchRun(context.Background(), tt.opts)
 			if tt.wantErr {
 				assert.EqualError(t, err, tt.errMsg)
 			} else {
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"regexp"
 	"strings"
 	"time"
 
@@ -16,6 +15,7 @@ import (
 	"github.com/cli/cli/v2/pkg/cmd/secret/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/pagination"
 	"github.com/cli/cli/v2/utils"
 	"github.com/spf13/cobra"
 )
@@ -264,18 +264,8 @@ func apiGet(client httpClient, url string, data interface{}) (string, error) {
 		return "", err
 	}
 
-	return findNextPage(resp.Header.Get("Link")), nil
-}
-
-var linkRE = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
-
-func findNextPage(link string) string {
-	for _, m := range linkRE.FindAllStringSubmatch(link, -1) {
-		if len(m) > 2 && m[2] == "next" {
-			return m[1]
-		}
-	}
-	return ""
+	nextPage, _ := pagination.FindNextPage(resp)
+	return nextPage, nil
 }
 
 func getSelectedRepositoryInformation(client httpClient, secrets []*Secret) error {
@@ -0,0 +1,100 @@
+package stats
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/telemetry"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type StatsOptions struct {
+	IO     *iostreams.IOStreams
+	Config func() (config.Config, error)
+
+	StateDir func() string
+}
+
+func NewCmdStats(f *cmdutil.Factory, runF func(*StatsOptions) error) *cobra.Command {
+	opts := &StatsOptions{
+		IO:       f.IOStreams,
+		Config:   f.Config,
+		StateDir: config.StateDir,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show local command usage statistics",
+		Long: heredoc.Doc(`
+			Summarize how often gh commands run on this machine and how long they take,
+			which can help identify slow commands.
+
+			Statistics are only collected while the telemetry setting is enabled:
+
+			  gh config set telemetry enabled
+
+			All measurements are stored locally and never leave this machine.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return statsRun(opts)
+		},
+	}
+
+	cmdutil.DisableAuthCheck(cmd)
+
+	return cmd
+}
+
+func statsRun(opts *StatsOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	enabled, _ := cfg.Get("", "telemetry")
+
+	entries, err := telemetry.Read(opts.StateDir())
+	if err != nil {
+		return err
+	}
+
+	if len(entries) == 0 {
+		fmt.Fprintln(opts.IO.Out, "No command statistics recorded.")
+		if enabled != "enabled" {
+			fmt.Fprintln(opts.IO.Out, "To start collecting statistics, run:  gh config set telemetry enabled")
+		}
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, stats := range telemetry.Summarize(entries) {
+		tp.AddField(stats.Command, nil, cs.Bold)
+		tp.AddField(strconv.Itoa(stats.Runs), nil, nil)
+		tp.AddField(stats.AverageDuration().Round(time.Millisecond).String(), nil, nil)
+		errColor := cs.Green
+		if stats.Errors > 0 {
+			errColor = cs.Red
+		}
+		tp.AddField(strconv.Itoa(stats.Errors), nil, errColor)
+		tp.EndRow()
+	}
+	if err := tp.Render(); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() && enabled != "enabled" {
+		fmt.Fprintln(opts.IO.ErrOut, "Telemetry is currently disabled; statistics shown are from earlier sessions.")
+	}
+
+	return nil
+}
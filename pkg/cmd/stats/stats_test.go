@@ -0,0 +1,49 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/telemetry"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_statsRun(t *testing.T) {
+	stateDir := t.TempDir()
+	require.NoError(t, telemetry.Record(stateDir, "pr list", 1200*time.Millisecond, "ok"))
+	require.NoError(t, telemetry.Record(stateDir, "pr list", 800*time.Millisecond, "error"))
+	require.NoError(t, telemetry.Record(stateDir, "api", 100*time.Millisecond, "ok"))
+
+	io, _, stdout, stderr := iostreams.Test()
+	opts := &StatsOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.ConfigStub{"telemetry": "enabled"}, nil
+		},
+		StateDir: func() string { return stateDir },
+	}
+
+	err := statsRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "pr list\t2\t1s\t1\napi\t1\t100ms\t0\n", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
+func Test_statsRun_noEntries(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	opts := &StatsOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.ConfigStub{}, nil
+		},
+		StateDir: func() string { return t.TempDir() },
+	}
+
+	err := statsRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "No command statistics recorded.\nTo start collecting statistics, run:  gh config set telemetry enabled\n", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
@@ -0,0 +1,103 @@
+package list
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/shurcooL/githubv4"
+)
+
+type Tag struct {
+	Name      string
+	CreatedAt time.Time
+	Annotated bool
+	Signed    bool
+}
+
+func fetchTags(httpClient *http.Client, repo ghrepo.Interface, limit int) ([]Tag, error) {
+	type responseData struct {
+		Repository struct {
+			Refs struct {
+				Nodes []struct {
+					Name   string
+					Target struct {
+						Typename string `graphql:"__typename"`
+						Commit   struct {
+							CommittedDate time.Time
+							Signature     struct {
+								IsValid bool
+							}
+						} `graphql:"... on Commit"`
+						Tag struct {
+							Tagger struct {
+								Date time.Time
+							}
+							Target struct {
+								Commit struct {
+									Signature struct {
+										IsValid bool
+									}
+								} `graphql:"... on Commit"`
+							}
+						} `graphql:"... on Tag"`
+					}
+				}
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"refs(refPrefix: \"refs/tags/\", first: $perPage, after: $endCursor, orderBy: {field: TAG_COMMIT_DATE, direction: DESC})"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	perPage := limit
+	if limit > 100 {
+		perPage = 100
+	}
+
+	variables := map[string]interface{}{
+		"owner":     githubv4.String(repo.RepoOwner()),
+		"name":      githubv4.String(repo.RepoName()),
+		"perPage":   githubv4.Int(perPage),
+		"endCursor": (*githubv4.String)(nil),
+	}
+
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), httpClient)
+
+	var tags []Tag
+loop:
+	for {
+		var query responseData
+		err := gql.QueryNamed(context.Background(), "RepositoryTagList", &query, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range query.Repository.Refs.Nodes {
+			tag := Tag{Name: node.Name}
+			if node.Target.Typename == "Tag" {
+				tag.Annotated = true
+				tag.CreatedAt = node.Target.Tag.Tagger.Date
+				tag.Signed = node.Target.Tag.Target.Commit.Signature.IsValid
+			} else {
+				tag.CreatedAt = node.Target.Commit.CommittedDate
+				tag.Signed = node.Target.Commit.Signature.IsValid
+			}
+			tags = append(tags, tag)
+			if len(tags) == limit {
+				break loop
+			}
+		}
+
+		if !query.Repository.Refs.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = githubv4.String(query.Repository.Refs.PageInfo.EndCursor)
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,91 @@
+package list
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	LimitResults int
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List tags in a repository",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.LimitResults < 1 {
+				return cmdutil.FlagErrorf("invalid value for --limit: %v", opts.LimitResults)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.LimitResults, "limit", "L", 30, "Maximum number of items to fetch")
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	tags, err := fetchTags(httpClient, baseRepo, opts.LimitResults)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	table := utils.NewTablePrinter(opts.IO)
+	cs := opts.IO.ColorScheme()
+	for _, tag := range tags {
+		table.AddField(tag.Name, nil, nil)
+
+		badge := ""
+		var badgeColor func(string) string
+		if tag.Signed {
+			badge = "Signed"
+			badgeColor = cs.Green
+		}
+		table.AddField(badge, nil, badgeColor)
+
+		createdAt := tag.CreatedAt.Format(time.RFC3339)
+		if table.IsTTY() {
+			createdAt = utils.FuzzyAgo(now.Sub(tag.CreatedAt))
+		}
+		table.AddField(createdAt, nil, cs.Gray)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
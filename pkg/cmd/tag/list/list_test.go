@@ -0,0 +1,61 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_listRun(t *testing.T) {
+	fakeHTTP := &httpmock.Registry{}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.Register(httpmock.GraphQL(`\bRepositoryTagList\(`), httpmock.StringResponse(`
+	{ "data": { "repository": { "refs": {
+		"nodes": [
+			{
+				"name": "v1.1.0",
+				"target": {
+					"__typename": "Tag",
+					"tagger": { "date": "2020-08-31T15:44:24+02:00" },
+					"target": { "signature": { "isValid": true } }
+				}
+			},
+			{
+				"name": "v1.0.0",
+				"target": {
+					"__typename": "Commit",
+					"committedDate": "2020-02-28T18:26:12+02:00",
+					"signature": { "isValid": false }
+				}
+			}
+		],
+		"pageInfo": { "hasNextPage": false }
+	} } } }`))
+
+	io, _, stdout, stderr := iostreams.Test()
+	opts := ListOptions{
+		IO:           io,
+		LimitResults: 30,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	err := listRun(&opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		v1.1.0	Signed	2020-08-31T15:44:24+02:00
+		v1.0.0		2020-02-28T18:26:12+02:00
+	`), stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
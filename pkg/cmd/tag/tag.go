@@ -0,0 +1,21 @@
+package tag
+
+import (
+	cmdList "github.com/cli/cli/v2/pkg/cmd/tag/list"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdTag(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tag <command>",
+		Short: "Manage tags",
+		Long:  "Work with git tags in a GitHub repository.",
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+
+	return cmd
+}
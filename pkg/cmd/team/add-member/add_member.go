@@ -0,0 +1,107 @@
+package addmember
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmd/team/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type AddMemberOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Selector string
+	Username string
+	Role     string
+}
+
+func NewCmdAddMember(f *cmdutil.Factory, runF func(*AddMemberOptions) error) *cobra.Command {
+	opts := &AddMemberOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add-member <organization>/<team-slug> <username>",
+		Short: "Add or invite a user to a team",
+		Args:  cmdutil.ExactArgs(2, "cannot add member: team and username arguments required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Selector = args[0]
+			opts.Username = args[1]
+
+			if opts.Role != "member" && opts.Role != "maintainer" {
+				return cmdutil.FlagErrorf("invalid role %q: must be one of member, maintainer", opts.Role)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return addMemberRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Role, "role", "r", "member", "Role for the user: {member|maintainer}")
+
+	return cmd
+}
+
+func addMemberRun(opts *AddMemberOptions) error {
+	org, slug, err := shared.ParseTeamArg(opts.Selector)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(map[string]string{"role": opts.Role}); err != nil {
+		return err
+	}
+
+	var membership struct {
+		State string `json:"state"`
+		Role  string `json:"role"`
+	}
+	path := fmt.Sprintf("orgs/%s/teams/%s/memberships/%s", org, slug, opts.Username)
+	if err := client.REST(host, "PUT", path, body, &membership); err != nil {
+		return fmt.Errorf("failed to add %s to %s: %w", opts.Username, opts.Selector, err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		if membership.State == "pending" {
+			fmt.Fprintf(opts.IO.Out, "%s Invited %s to %s/%s; membership is pending their acceptance\n",
+				cs.SuccessIcon(), opts.Username, org, slug)
+		} else {
+			fmt.Fprintf(opts.IO.Out, "%s Added %s to %s/%s as %s\n",
+				cs.SuccessIcon(), opts.Username, org, slug, membership.Role)
+		}
+	}
+
+	return nil
+}
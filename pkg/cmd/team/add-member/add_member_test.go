@@ -0,0 +1,151 @@
+package addmember
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdAddMember(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    AddMemberOptions
+		wantsErr string
+	}{
+		{
+			name:     "no arguments",
+			wantsErr: "cannot add member: team and username arguments required",
+		},
+		{
+			name: "team and username",
+			cli:  "acme-inc/frontend monalisa",
+			wants: AddMemberOptions{
+				Selector: "acme-inc/frontend",
+				Username: "monalisa",
+				Role:     "member",
+			},
+		},
+		{
+			name: "maintainer role",
+			cli:  "acme-inc/frontend monalisa --role maintainer",
+			wants: AddMemberOptions{
+				Selector: "acme-inc/frontend",
+				Username: "monalisa",
+				Role:     "maintainer",
+			},
+		},
+		{
+			name:     "bad role",
+			cli:      "acme-inc/frontend monalisa --role admin",
+			wantsErr: `invalid role "admin": must be one of member, maintainer`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *AddMemberOptions
+			cmd := NewCmdAddMember(f, func(opts *AddMemberOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Selector, gotOpts.Selector)
+			assert.Equal(t, tt.wants.Username, gotOpts.Username)
+			assert.Equal(t, tt.wants.Role, gotOpts.Role)
+		})
+	}
+}
+
+func TestAddMemberRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      AddMemberOptions
+		httpStubs func(*testing.T, *httpmock.Registry)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "adds member",
+			opts: AddMemberOptions{Selector: "acme-inc/frontend", Username: "monalisa", Role: "member"},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("PUT", "orgs/acme-inc/teams/frontend/memberships/monalisa"),
+					httpmock.RESTPayload(200, `{"state": "active", "role": "member"}`, func(payload map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{"role": "member"}, payload)
+					}))
+			},
+			wantOut: "✓ Added monalisa to acme-inc/frontend as member\n",
+		},
+		{
+			name: "invites member",
+			opts: AddMemberOptions{Selector: "acme-inc/frontend", Username: "monalisa", Role: "maintainer"},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("PUT", "orgs/acme-inc/teams/frontend/memberships/monalisa"),
+					httpmock.RESTPayload(200, `{"state": "pending", "role": "maintainer"}`, func(payload map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{"role": "maintainer"}, payload)
+					}))
+			},
+			wantOut: "✓ Invited monalisa to acme-inc/frontend; membership is pending their acceptance\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(t, reg)
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(true)
+			io.SetStdinTTY(true)
+
+			opts := tt.opts
+			opts.IO = io
+			opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.Config = func() (config.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+
+			err := addMemberRun(&opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+var teamFields = []string{
+	"id",
+	"name",
+	"slug",
+	"description",
+	"privacy",
+	"membersCount",
+}
+
+type ListOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Organization string
+	Limit        int
+	Exporter     cmdutil.Exporter
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list --org <organization>",
+		Short: "List the teams in an organization",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Organization == "" {
+				return cmdutil.FlagErrorf("an organization is required: use `--org`")
+			}
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Organization, "org", "o", "", "List teams in the organization")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of teams to list")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, teamFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	teams, err := api.OrganizationTeams(client, ghrepo.NewWithHost(opts.Organization, "", host))
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	if len(teams) > opts.Limit {
+		teams = teams[:opts.Limit]
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, teams)
+	}
+
+	if len(teams) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "No teams found in %s\n", opts.Organization)
+		}
+		return nil
+	}
+
+	if err := opts.IO.StartPager(); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "starting pager failed: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, team := range teams {
+		tp.AddField(team.Slug, nil, cs.Cyan)
+		tp.AddField(team.Name, nil, nil)
+		tp.AddField(team.Description, nil, nil)
+		tp.AddField(strings.ToLower(team.Privacy), nil, cs.Gray)
+		tp.AddField(fmt.Sprintf("%d", team.Members.TotalCount), nil, nil)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
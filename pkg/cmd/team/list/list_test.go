@@ -0,0 +1,183 @@
+package list
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdList(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    ListOptions
+		wantsErr string
+	}{
+		{
+			name:     "no organization",
+			wantsErr: "an organization is required: use `--org`",
+		},
+		{
+			name: "organization",
+			cli:  "--org acme-inc",
+			wants: ListOptions{
+				Organization: "acme-inc",
+				Limit:        30,
+			},
+		},
+		{
+			name: "limit",
+			cli:  "--org acme-inc --limit 100",
+			wants: ListOptions{
+				Organization: "acme-inc",
+				Limit:        100,
+			},
+		},
+		{
+			name:     "bad limit",
+			cli:      "--org acme-inc --limit 0",
+			wantsErr: "invalid limit: 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *ListOptions
+			cmd := NewCmdList(f, func(opts *ListOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Organization, gotOpts.Organization)
+			assert.Equal(t, tt.wants.Limit, gotOpts.Limit)
+		})
+	}
+}
+
+func TestListRun(t *testing.T) {
+	teamsPayload := `{ "data": { "organization": { "teams": {
+		"nodes": [
+			{
+				"id": "T_1",
+				"slug": "frontend",
+				"name": "Frontend",
+				"description": "The UI folks",
+				"privacy": "VISIBLE",
+				"members": { "totalCount": 3 }
+			},
+			{
+				"id": "T_2",
+				"slug": "security",
+				"name": "Security",
+				"description": "",
+				"privacy": "SECRET",
+				"members": { "totalCount": 1 }
+			}
+		],
+		"pageInfo": { "hasNextPage": false, "endCursor": "" }
+	} } } }`
+
+	tests := []struct {
+		name      string
+		opts      ListOptions
+		tty       bool
+		httpStubs func(*httpmock.Registry)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "lists teams",
+			tty:  true,
+			opts: ListOptions{Organization: "acme-inc", Limit: 30},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query OrganizationTeamList\b`),
+					httpmock.StringResponse(teamsPayload))
+			},
+			wantOut: "frontend  Frontend  The UI folks  visible  3\nsecurity  Security                secret   1\n",
+		},
+		{
+			name: "respects limit",
+			tty:  true,
+			opts: ListOptions{Organization: "acme-inc", Limit: 1},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query OrganizationTeamList\b`),
+					httpmock.StringResponse(teamsPayload))
+			},
+			wantOut: "frontend  Frontend  The UI folks  visible  3\n",
+		},
+		{
+			name: "no teams",
+			tty:  true,
+			opts: ListOptions{Organization: "acme-inc", Limit: 30},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query OrganizationTeamList\b`),
+					httpmock.StringResponse(`{ "data": { "organization": { "teams": {
+						"nodes": [],
+						"pageInfo": { "hasNextPage": false, "endCursor": "" }
+					} } } }`))
+			},
+			wantOut: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.tty)
+			io.SetStdinTTY(tt.tty)
+
+			opts := tt.opts
+			opts.IO = io
+			opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.Config = func() (config.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+
+			err := listRun(&opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
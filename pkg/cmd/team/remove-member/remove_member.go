@@ -0,0 +1,83 @@
+package removemember
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmd/team/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RemoveMemberOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Selector string
+	Username string
+}
+
+func NewCmdRemoveMember(f *cmdutil.Factory, runF func(*RemoveMemberOptions) error) *cobra.Command {
+	opts := &RemoveMemberOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remove-member <organization>/<team-slug> <username>",
+		Short: "Remove a user from a team",
+		Args:  cmdutil.ExactArgs(2, "cannot remove member: team and username arguments required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Selector = args[0]
+			opts.Username = args[1]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return removeMemberRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func removeMemberRun(opts *RemoveMemberOptions) error {
+	org, slug, err := shared.ParseTeamArg(opts.Selector)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("orgs/%s/teams/%s/memberships/%s", org, slug, opts.Username)
+	if err := client.REST(host, "DELETE", path, nil, nil); err != nil {
+		return fmt.Errorf("failed to remove %s from %s: %w", opts.Username, opts.Selector, err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Removed %s from %s/%s\n", cs.SuccessIcon(), opts.Username, org, slug)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package removemember
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdRemoveMember(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    RemoveMemberOptions
+		wantsErr string
+	}{
+		{
+			name:     "no arguments",
+			wantsErr: "cannot remove member: team and username arguments required",
+		},
+		{
+			name: "team and username",
+			cli:  "acme-inc/frontend monalisa",
+			wants: RemoveMemberOptions{
+				Selector: "acme-inc/frontend",
+				Username: "monalisa",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *RemoveMemberOptions
+			cmd := NewCmdRemoveMember(f, func(opts *RemoveMemberOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Selector, gotOpts.Selector)
+			assert.Equal(t, tt.wants.Username, gotOpts.Username)
+		})
+	}
+}
+
+func TestRemoveMemberRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("DELETE", "orgs/acme-inc/teams/frontend/memberships/monalisa"),
+		httpmock.StatusStringResponse(204, ""))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+
+	opts := &RemoveMemberOptions{
+		IO:       io,
+		Selector: "acme-inc/frontend",
+		Username: "monalisa",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+	}
+
+	err := removeMemberRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Removed monalisa from acme-inc/frontend\n", stdout.String())
+}
@@ -0,0 +1,58 @@
+package shared
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+)
+
+// Team represents an organization team as returned by the REST API.
+type Team struct {
+	Name         string `json:"name"`
+	Slug         string `json:"slug"`
+	Description  string `json:"description"`
+	Privacy      string `json:"privacy"`
+	Permission   string `json:"permission"`
+	MembersCount int    `json:"members_count"`
+	ReposCount   int    `json:"repos_count"`
+	URL          string `json:"html_url"`
+}
+
+func (t *Team) ExportData(fields []string) map[string]interface{} {
+	v := reflect.ValueOf(t).Elem()
+	fieldByName := func(v reflect.Value, field string) reflect.Value {
+		return v.FieldByNameFunc(func(s string) bool {
+			return strings.EqualFold(field, s)
+		})
+	}
+	data := map[string]interface{}{}
+
+	for _, f := range fields {
+		sf := fieldByName(v, f)
+		data[f] = sf.Interface()
+	}
+
+	return data
+}
+
+// ParseTeamArg splits an "ORG/TEAM-SLUG" argument into its organization and
+// team slug parts.
+func ParseTeamArg(arg string) (string, string, error) {
+	parts := strings.SplitN(arg, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected the \"ORG/TEAM-SLUG\" format, got %q", arg)
+	}
+	return parts[0], parts[1], nil
+}
+
+// GetTeam looks up an organization team by its slug.
+func GetTeam(client *api.Client, hostname, org, slug string) (*Team, error) {
+	var team Team
+	path := fmt.Sprintf("orgs/%s/teams/%s", org, slug)
+	if err := client.REST(hostname, "GET", path, nil, &team); err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
@@ -0,0 +1,24 @@
+package team
+
+import (
+	addMemberCmd "github.com/cli/cli/v2/pkg/cmd/team/add-member"
+	listCmd "github.com/cli/cli/v2/pkg/cmd/team/list"
+	removeMemberCmd "github.com/cli/cli/v2/pkg/cmd/team/remove-member"
+	viewCmd "github.com/cli/cli/v2/pkg/cmd/team/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdTeam(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "team <command>",
+		Short: "Manage organization teams",
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f, nil))
+	cmd.AddCommand(viewCmd.NewCmdView(f, nil))
+	cmd.AddCommand(addMemberCmd.NewCmdAddMember(f, nil))
+	cmd.AddCommand(removeMemberCmd.NewCmdRemoveMember(f, nil))
+
+	return cmd
+}
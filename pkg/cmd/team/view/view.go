@@ -0,0 +1,113 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmd/team/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+var teamFields = []string{
+	"name",
+	"slug",
+	"description",
+	"privacy",
+	"permission",
+	"membersCount",
+	"reposCount",
+	"url",
+}
+
+type ViewOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Selector string
+	Exporter cmdutil.Exporter
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <organization>/<team-slug>",
+		Short: "View an organization team",
+		Args:  cmdutil.ExactArgs(1, "cannot view team: team argument required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Selector = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return viewRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, teamFields)
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	org, slug, err := shared.ParseTeamArg(opts.Selector)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	team, err := shared.GetTeam(client, host, org, slug)
+	if err != nil {
+		return fmt.Errorf("failed to get team: %w", err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, team)
+	}
+
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	fmt.Fprintf(out, "%s\n", cs.Bold(team.Name))
+	fmt.Fprintf(out, "%s/%s\n", org, team.Slug)
+	if team.Description != "" {
+		fmt.Fprintf(out, "\n%s\n", team.Description)
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "Privacy: %s\n", strings.ToLower(team.Privacy))
+	fmt.Fprintf(out, "Members: %d\n", team.MembersCount)
+	fmt.Fprintf(out, "Repositories: %d\n", team.ReposCount)
+
+	if opts.IO.IsStdoutTTY() && team.URL != "" {
+		fmt.Fprintln(out)
+		fmt.Fprintf(out, "View this team on GitHub: %s\n", team.URL)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package view
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdView(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    ViewOptions
+		wantsErr string
+	}{
+		{
+			name:     "no arguments",
+			wantsErr: "cannot view team: team argument required",
+		},
+		{
+			name: "team",
+			cli:  "acme-inc/frontend",
+			wants: ViewOptions{
+				Selector: "acme-inc/frontend",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *ViewOptions
+			cmd := NewCmdView(f, func(opts *ViewOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Selector, gotOpts.Selector)
+		})
+	}
+}
+
+func TestViewRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      ViewOptions
+		tty       bool
+		httpStubs func(*httpmock.Registry)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "displays team",
+			tty:  true,
+			opts: ViewOptions{Selector: "acme-inc/frontend"},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/acme-inc/teams/frontend"),
+					httpmock.StringResponse(`{
+						"name": "Frontend",
+						"slug": "frontend",
+						"description": "The UI folks",
+						"privacy": "closed",
+						"permission": "pull",
+						"members_count": 3,
+						"repos_count": 2,
+						"html_url": "https://github.com/orgs/acme-inc/teams/frontend"
+					}`))
+			},
+			wantOut: heredoc.Doc(`
+				Frontend
+				acme-inc/frontend
+
+				The UI folks
+
+				Privacy: closed
+				Members: 3
+				Repositories: 2
+
+				View this team on GitHub: https://github.com/orgs/acme-inc/teams/frontend
+			`),
+		},
+		{
+			name:    "invalid selector",
+			opts:    ViewOptions{Selector: "frontend"},
+			wantErr: `expected the "ORG/TEAM-SLUG" format, got "frontend"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.tty)
+			io.SetStdinTTY(tt.tty)
+
+			opts := tt.opts
+			opts.IO = io
+			opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.Config = func() (config.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+
+			err := viewRun(&opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
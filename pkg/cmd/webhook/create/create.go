@@ -0,0 +1,150 @@
+package create
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/webhook/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	OrgName     string
+	URL         string
+	Secret      string
+	Events      []string
+	ContentType string
+	InsecureSSL bool
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create --url <url>",
+		Short: "Create a webhook",
+		Long: heredoc.Doc(`
+			Create a webhook for a repository or organization.
+
+			The webhook is delivered for push events unless a different set of
+			events is selected with --event. A signing secret for validating
+			payloads can be set with --secret.
+		`),
+		Example: heredoc.Doc(`
+			$ gh webhook create --url https://example.com/hook
+			$ gh webhook create --url https://example.com/hook --event issues --event pull_request
+			$ gh webhook create --org myorg --url https://example.com/hook --secret s3cret
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.ContentType != "json" && opts.ContentType != "form" {
+				return cmdutil.FlagErrorf("invalid value for --content-type: %q", opts.ContentType)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.OrgName, "org", "o", "", "Create a webhook for an organization")
+	cmd.Flags().StringVarP(&opts.URL, "url", "u", "", "Payload `URL` the webhook will deliver to")
+	cmd.Flags().StringVarP(&opts.Secret, "secret", "s", "", "Webhook secret for validating payload signatures")
+	cmd.Flags().StringSliceVarP(&opts.Events, "event", "e", []string{"push"}, "Event `types` to deliver, or \"*\" for all events")
+	cmd.Flags().StringVar(&opts.ContentType, "content-type", "json", "Payload content type: {json|form}")
+	cmd.Flags().BoolVar(&opts.InsecureSSL, "insecure-ssl", false, "Skip TLS verification when delivering payloads")
+	_ = cmd.MarkFlagRequired("url")
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var baseRepo ghrepo.Interface
+	host := ""
+	if opts.OrgName == "" {
+		baseRepo, err = opts.BaseRepo()
+		if err != nil {
+			return fmt.Errorf("could not determine base repo: %w", err)
+		}
+		host = baseRepo.RepoHost()
+	} else {
+		cfg, err := opts.Config()
+		if err != nil {
+			return err
+		}
+		host, err = cfg.DefaultHost()
+		if err != nil {
+			return err
+		}
+	}
+
+	hook, err := createHook(apiClient, host, shared.HookPath(opts.OrgName, baseRepo), opts)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Created webhook %d on %s\n", cs.SuccessIcon(), hook.ID, shared.HookTarget(opts.OrgName, baseRepo))
+	}
+	return nil
+}
+
+func createHook(apiClient *api.Client, host, path string, opts *CreateOptions) (*shared.Hook, error) {
+	insecureSSL := "0"
+	if opts.InsecureSSL {
+		insecureSSL = "1"
+	}
+
+	params := map[string]interface{}{
+		"name":   "web",
+		"active": true,
+		"events": opts.Events,
+		"config": map[string]string{
+			"url":          opts.URL,
+			"content_type": opts.ContentType,
+			"secret":       opts.Secret,
+			"insecure_ssl": insecureSSL,
+		},
+	}
+
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var hook shared.Hook
+	err = apiClient.REST(host, "POST", path, bytes.NewReader(body), &hook)
+	if err != nil {
+		return nil, err
+	}
+	return &hook, nil
+}
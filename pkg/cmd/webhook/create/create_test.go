@@ -0,0 +1,170 @@
+package create
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewCmdCreate(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    CreateOptions
+		wantsErr bool
+	}{
+		{
+			name:     "no url",
+			cli:      "",
+			wantsErr: true,
+		},
+		{
+			name: "defaults",
+			cli:  "--url https://example.com/hook",
+			wants: CreateOptions{
+				URL:         "https://example.com/hook",
+				Events:      []string{"push"},
+				ContentType: "json",
+			},
+		},
+		{
+			name: "events and secret",
+			cli:  "--url https://example.com/hook -e issues -e pull_request -s shhh",
+			wants: CreateOptions{
+				URL:         "https://example.com/hook",
+				Events:      []string{"issues", "pull_request"},
+				Secret:      "shhh",
+				ContentType: "json",
+			},
+		},
+		{
+			name: "org",
+			cli:  "--org UmbrellaCorporation --url https://example.com/hook",
+			wants: CreateOptions{
+				OrgName:     "UmbrellaCorporation",
+				URL:         "https://example.com/hook",
+				Events:      []string{"push"},
+				ContentType: "json",
+			},
+		},
+		{
+			name:     "invalid content type",
+			cli:      "--url https://example.com/hook --content-type xml",
+			wantsErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *CreateOptions
+			cmd := NewCmdCreate(f, func(opts *CreateOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.OrgName, gotOpts.OrgName)
+			assert.Equal(t, tt.wants.URL, gotOpts.URL)
+			assert.Equal(t, tt.wants.Secret, gotOpts.Secret)
+			assert.Equal(t, tt.wants.Events, gotOpts.Events)
+			assert.Equal(t, tt.wants.ContentType, gotOpts.ContentType)
+		})
+	}
+}
+
+func Test_createRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/hooks"),
+		httpmock.RESTPayload(201, `{"id": 42}`, func(payload map[string]interface{}) {
+			assert.Equal(t, "web", payload["name"])
+			assert.Equal(t, true, payload["active"])
+			assert.Equal(t, []interface{}{"issues"}, payload["events"])
+			assert.Equal(t, map[string]interface{}{
+				"url":          "https://example.com/hook",
+				"content_type": "json",
+				"secret":       "shhh",
+				"insecure_ssl": "0",
+			}, payload["config"])
+		}))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	opts := &CreateOptions{
+		IO: io,
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		URL:         "https://example.com/hook",
+		Secret:      "shhh",
+		Events:      []string{"issues"},
+		ContentType: "json",
+	}
+
+	err := createRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Created webhook 42 on OWNER/REPO\n", stdout.String())
+}
+
+func Test_createRun_org(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("POST", "orgs/UmbrellaCorporation/hooks"),
+		httpmock.StringResponse(`{"id": 7}`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	opts := &CreateOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		OrgName:     "UmbrellaCorporation",
+		URL:         "https://example.com/hook",
+		Events:      []string{"push"},
+		ContentType: "json",
+	}
+
+	err := createRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Created webhook 7 on UmbrellaCorporation\n", stdout.String())
+}
@@ -0,0 +1,111 @@
+package delete
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewCmdDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    DeleteOptions
+		wantsErr bool
+	}{
+		{
+			name:     "no arguments",
+			cli:      "",
+			wantsErr: true,
+		},
+		{
+			name:     "non-numeric ID",
+			cli:      "abc",
+			wantsErr: true,
+		},
+		{
+			name: "repo hook",
+			cli:  "123",
+			wants: DeleteOptions{
+				HookID: 123,
+			},
+		},
+		{
+			name: "org hook",
+			cli:  "123 --org UmbrellaCorporation",
+			wants: DeleteOptions{
+				HookID:  123,
+				OrgName: "UmbrellaCorporation",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *DeleteOptions
+			cmd := NewCmdDelete(f, func(opts *DeleteOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.HookID, gotOpts.HookID)
+			assert.Equal(t, tt.wants.OrgName, gotOpts.OrgName)
+		})
+	}
+}
+
+func Test_deleteRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("DELETE", "repos/OWNER/REPO/hooks/123"),
+		httpmock.StatusStringResponse(204, ""))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	opts := &DeleteOptions{
+		IO: io,
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HookID: 123,
+	}
+
+	err := deleteRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Deleted webhook 123 from OWNER/REPO\n", stdout.String())
+}
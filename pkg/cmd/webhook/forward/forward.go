@@ -0,0 +1,233 @@
+package forward
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/webhook/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+const pollInterval = 3 * time.Second
+
+type ForwardOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	OrgName string
+	Port    int
+	Events  []string
+	Secret  string
+}
+
+func NewCmdForward(f *cmdutil.Factory, runF func(*ForwardOptions) error) *cobra.Command {
+	opts := &ForwardOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "forward --port <port>",
+		Short: "Forward webhook deliveries to a local port",
+		Long: heredoc.Doc(`
+			Create a temporary webhook and replay its deliveries against a server
+			running on a local port, for developing webhook integrations without
+			exposing the machine to the internet.
+
+			Deliveries are fetched through the webhook deliveries API and replayed
+			locally, so no tunnel or publicly reachable endpoint is needed. The
+			temporary webhook is removed when the command exits.
+		`),
+		Example: heredoc.Doc(`
+			$ gh webhook forward --port 3000
+			$ gh webhook forward --port 8080 --event issues --event pull_request
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Port < 1 || opts.Port > 65535 {
+				return cmdutil.FlagErrorf("invalid value for --port: %v", opts.Port)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return forwardRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.OrgName, "org", "o", "", "Forward webhooks for an organization")
+	cmd.Flags().IntVarP(&opts.Port, "port", "p", 0, "Local `port` to deliver payloads to")
+	cmd.Flags().StringSliceVarP(&opts.Events, "event", "e", []string{"*"}, "Event `types` to forward")
+	cmd.Flags().StringVarP(&opts.Secret, "secret", "s", "", "Webhook secret for validating payload signatures")
+	_ = cmd.MarkFlagRequired("port")
+
+	return cmd
+}
+
+func forwardRun(opts *ForwardOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var baseRepo ghrepo.Interface
+	host := ""
+	if opts.OrgName == "" {
+		baseRepo, err = opts.BaseRepo()
+		if err != nil {
+			return fmt.Errorf("could not determine base repo: %w", err)
+		}
+		host = baseRepo.RepoHost()
+	} else {
+		cfg, err := opts.Config()
+		if err != nil {
+			return err
+		}
+		host, err = cfg.DefaultHost()
+		if err != nil {
+			return err
+		}
+	}
+
+	localURL := fmt.Sprintf("http://localhost:%d/", opts.Port)
+	hooksPath := shared.HookPath(opts.OrgName, baseRepo)
+
+	// The hook URL only serves as a label: GitHub cannot reach localhost, but
+	// failed deliveries are still recorded and replayed from the deliveries API.
+	params := map[string]interface{}{
+		"name":   "web",
+		"active": true,
+		"events": opts.Events,
+		"config": map[string]string{
+			"url":          localURL,
+			"content_type": "json",
+			"secret":       opts.Secret,
+			"insecure_ssl": "0",
+		},
+	}
+	body, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	var hook shared.Hook
+	if err := apiClient.REST(host, "POST", hooksPath, bytes.NewReader(body), &hook); err != nil {
+		return fmt.Errorf("failed to create temporary webhook: %w", err)
+	}
+	defer func() {
+		deletePath := fmt.Sprintf("%s/%d", hooksPath, hook.ID)
+		if err := apiClient.REST(host, "DELETE", deletePath, nil, nil); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "warning: failed to delete temporary webhook %d: %v\n", hook.ID, err)
+		}
+	}()
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.ErrOut, "%s Created temporary webhook %d on %s\n", cs.SuccessIcon(), hook.ID, shared.HookTarget(opts.OrgName, baseRepo))
+	fmt.Fprintf(opts.IO.ErrOut, "Forwarding deliveries to %s; press Ctrl+C to stop\n", localURL)
+
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupted)
+
+	seen := map[int64]struct{}{}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-interrupted:
+			fmt.Fprintln(opts.IO.ErrOut, "Stopping forwarding")
+			return nil
+		case <-ticker.C:
+			if err := forwardNewDeliveries(opts, apiClient, host, hooksPath, hook.ID, localURL, seen); err != nil {
+				fmt.Fprintf(opts.IO.ErrOut, "warning: %v\n", err)
+			}
+		}
+	}
+}
+
+type hookDelivery struct {
+	ID    int64  `json:"id"`
+	GUID  string `json:"guid"`
+	Event string `json:"event"`
+}
+
+type hookDeliveryDetail struct {
+	hookDelivery
+	Request struct {
+		Headers map[string]string `json:"headers"`
+		Payload json.RawMessage   `json:"payload"`
+	} `json:"request"`
+}
+
+func forwardNewDeliveries(opts *ForwardOptions, apiClient *api.Client, host, hooksPath string, hookID int64, localURL string, seen map[int64]struct{}) error {
+	var deliveries []hookDelivery
+	listPath := fmt.Sprintf("%s/%d/deliveries?per_page=50", hooksPath, hookID)
+	if err := apiClient.REST(host, "GET", listPath, nil, &deliveries); err != nil {
+		return fmt.Errorf("failed to list deliveries: %w", err)
+	}
+
+	// deliveries are returned newest first; replay in chronological order
+	for i := len(deliveries) - 1; i >= 0; i-- {
+		d := deliveries[i]
+		if _, ok := seen[d.ID]; ok {
+			continue
+		}
+		seen[d.ID] = struct{}{}
+
+		var detail hookDeliveryDetail
+		detailPath := fmt.Sprintf("%s/%d/deliveries/%d", hooksPath, hookID, d.ID)
+		if err := apiClient.REST(host, "GET", detailPath, nil, &detail); err != nil {
+			return fmt.Errorf("failed to fetch delivery %s: %w", d.GUID, err)
+		}
+
+		status, err := replayDelivery(localURL, &detail)
+		if err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "warning: failed to forward %s delivery %s: %v\n", d.Event, d.GUID, err)
+			continue
+		}
+		fmt.Fprintf(opts.IO.Out, "Forwarded %s delivery %s (HTTP %d)\n", d.Event, d.GUID, status)
+	}
+
+	return nil
+}
+
+func replayDelivery(localURL string, detail *hookDeliveryDetail) (int, error) {
+	req, err := http.NewRequest("POST", localURL, bytes.NewReader(detail.Request.Payload))
+	if err != nil {
+		return 0, err
+	}
+	for name, value := range detail.Request.Headers {
+		req.Header.Set(name, value)
+	}
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode, nil
+}
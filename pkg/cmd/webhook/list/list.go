@@ -0,0 +1,111 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/webhook/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	OrgName string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List webhooks",
+		Long:  "List webhooks for a repository or organization.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.OrgName, "org", "o", "", "List webhooks for an organization")
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var baseRepo ghrepo.Interface
+	host := ""
+	if opts.OrgName == "" {
+		baseRepo, err = opts.BaseRepo()
+		if err != nil {
+			return fmt.Errorf("could not determine base repo: %w", err)
+		}
+		host = baseRepo.RepoHost()
+	} else {
+		cfg, err := opts.Config()
+		if err != nil {
+			return err
+		}
+		host, err = cfg.DefaultHost()
+		if err != nil {
+			return err
+		}
+	}
+
+	var hooks []shared.Hook
+	err = apiClient.REST(host, "GET", shared.HookPath(opts.OrgName, baseRepo)+"?per_page=100", nil, &hooks)
+	if err != nil {
+		return fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	if len(hooks) == 0 && opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.ErrOut, "No webhooks found in %s\n", shared.HookTarget(opts.OrgName, baseRepo))
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, hook := range hooks {
+		tp.AddField(strconv.FormatInt(hook.ID, 10), nil, cs.Cyan)
+		tp.AddField(hook.Config.URL, nil, nil)
+		tp.AddField(strings.Join(hook.Events, ","), nil, nil)
+		status := "inactive"
+		statusColor := cs.Gray
+		if hook.Active {
+			status = "active"
+			statusColor = cs.Green
+		}
+		tp.AddField(status, nil, statusColor)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
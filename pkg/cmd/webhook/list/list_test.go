@@ -0,0 +1,128 @@
+package list
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewCmdList(t *testing.T) {
+	tests := []struct {
+		name  string
+		cli   string
+		wants ListOptions
+	}{
+		{
+			name:  "repo",
+			cli:   "",
+			wants: ListOptions{},
+		},
+		{
+			name: "org",
+			cli:  "-oUmbrellaCorporation",
+			wants: ListOptions{
+				OrgName: "UmbrellaCorporation",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *ListOptions
+			cmd := NewCmdList(f, func(opts *ListOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.OrgName, gotOpts.OrgName)
+		})
+	}
+}
+
+func Test_listRun(t *testing.T) {
+	hooksPayload := `[
+		{"id": 1, "name": "web", "active": true, "events": ["push", "issues"], "config": {"url": "https://example.com/hook"}},
+		{"id": 2, "name": "web", "active": false, "events": ["*"], "config": {"url": "https://example.com/all"}}
+	]`
+
+	tests := []struct {
+		name    string
+		opts    *ListOptions
+		path    string
+		wantOut []string
+	}{
+		{
+			name: "repo",
+			opts: &ListOptions{},
+			path: "repos/OWNER/REPO/hooks",
+			wantOut: []string{
+				"1\thttps://example.com/hook\tpush,issues\tactive",
+				`2	https://example\.com/all	\*	inactive`,
+			},
+		},
+		{
+			name: "org",
+			opts: &ListOptions{
+				OrgName: "UmbrellaCorporation",
+			},
+			path: "orgs/UmbrellaCorporation/hooks",
+			wantOut: []string{
+				"1\thttps://example.com/hook\tpush,issues\tactive",
+				`2	https://example\.com/all	\*	inactive`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			reg.Register(
+				httpmock.REST("GET", tt.path),
+				httpmock.StringResponse(hooksPayload))
+
+			io, _, stdout, _ := iostreams.Test()
+
+			tt.opts.IO = io
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.Config = func() (config.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+
+			err := listRun(tt.opts)
+			assert.NoError(t, err)
+
+			//nolint:staticcheck // prefer exact matchers over ExpectLines
+			test.ExpectLines(t, stdout.String(), tt.wantOut...)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package ping
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/webhook/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type PingOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	OrgName string
+	HookID  int64
+}
+
+func NewCmdPing(f *cmdutil.Factory, runF func(*PingOptions) error) *cobra.Command {
+	opts := &PingOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "ping <webhook-id>",
+		Short: "Send a ping event to a webhook",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			hookID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid webhook ID: %q", args[0])
+			}
+			opts.HookID = hookID
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return pingRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.OrgName, "org", "o", "", "Ping a webhook for an organization")
+
+	return cmd
+}
+
+func pingRun(opts *PingOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var baseRepo ghrepo.Interface
+	host := ""
+	if opts.OrgName == "" {
+		baseRepo, err = opts.BaseRepo()
+		if err != nil {
+			return fmt.Errorf("could not determine base repo: %w", err)
+		}
+		host = baseRepo.RepoHost()
+	} else {
+		cfg, err := opts.Config()
+		if err != nil {
+			return err
+		}
+		host, err = cfg.DefaultHost()
+		if err != nil {
+			return err
+		}
+	}
+
+	path := fmt.Sprintf("%s/%d/pings", shared.HookPath(opts.OrgName, baseRepo), opts.HookID)
+	err = apiClient.REST(host, "POST", path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to ping webhook: %w", err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Sent ping event to webhook %d\n", cs.SuccessIcon(), opts.HookID)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package ping
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewCmdPing(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    PingOptions
+		wantsErr bool
+	}{
+		{
+			name:     "no arguments",
+			cli:      "",
+			wantsErr: true,
+		},
+		{
+			name: "repo hook",
+			cli:  "123",
+			wants: PingOptions{
+				HookID: 123,
+			},
+		},
+		{
+			name: "org hook",
+			cli:  "123 --org UmbrellaCorporation",
+			wants: PingOptions{
+				HookID:  123,
+				OrgName: "UmbrellaCorporation",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *PingOptions
+			cmd := NewCmdPing(f, func(opts *PingOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.HookID, gotOpts.HookID)
+			assert.Equal(t, tt.wants.OrgName, gotOpts.OrgName)
+		})
+	}
+}
+
+func Test_pingRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/hooks/123/pings"),
+		httpmock.StatusStringResponse(204, ""))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	opts := &PingOptions{
+		IO: io,
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HookID: 123,
+	}
+
+	err := pingRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Sent ping event to webhook 123\n", stdout.String())
+}
@@ -0,0 +1,41 @@
+package shared
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// Hook represents a repository or organization webhook as returned by the
+// REST API.
+type Hook struct {
+	ID     int64    `json:"id"`
+	Name   string   `json:"name"`
+	Events []string `json:"events"`
+	Active bool     `json:"active"`
+	Config struct {
+		URL         string `json:"url"`
+		ContentType string `json:"content_type"`
+		InsecureSSL string `json:"insecure_ssl"`
+		Secret      string `json:"secret,omitempty"`
+	} `json:"config"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// HookPath returns the REST path for the hooks collection of an organization
+// when orgName is set, or of the repository otherwise.
+func HookPath(orgName string, repo ghrepo.Interface) string {
+	if orgName != "" {
+		return fmt.Sprintf("orgs/%s/hooks", orgName)
+	}
+	return fmt.Sprintf("repos/%s/hooks", ghrepo.FullName(repo))
+}
+
+// HookTarget names the entity that owns a webhook for display purposes.
+func HookTarget(orgName string, repo ghrepo.Interface) string {
+	if orgName != "" {
+		return orgName
+	}
+	return ghrepo.FullName(repo)
+}
@@ -0,0 +1,33 @@
+package webhook
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdCreate "github.com/cli/cli/v2/pkg/cmd/webhook/create"
+	cmdDelete "github.com/cli/cli/v2/pkg/cmd/webhook/delete"
+	cmdForward "github.com/cli/cli/v2/pkg/cmd/webhook/forward"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/webhook/list"
+	cmdPing "github.com/cli/cli/v2/pkg/cmd/webhook/ping"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdWebhook(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhook <command>",
+		Short: "Manage webhooks",
+		Long: heredoc.Doc(`
+			Webhooks deliver notifications about events on GitHub to an HTTP endpoint.
+			They can be managed at the repository (default) or organization level.
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+	cmd.AddCommand(cmdPing.NewCmdPing(f, nil))
+	cmd.AddCommand(cmdForward.NewCmdForward(f, nil))
+
+	return cmd
+}
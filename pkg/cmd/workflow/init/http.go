@@ -0,0 +1,103 @@
+package init
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+type workflowTemplate struct {
+	Name     string
+	FileName string
+	Source   string
+	Repo     ghrepo.Interface
+	Path     string
+}
+
+// fetchTemplates lists starter workflow templates for a repository: first any templates
+// published in the owner's .github repository, then the CI templates maintained in
+// actions/starter-workflows. Sources that do not exist are skipped.
+func fetchTemplates(client *api.Client, repo ghrepo.Interface) ([]workflowTemplate, error) {
+	var templates []workflowTemplate
+
+	ownerRepo := ghrepo.NewWithHost(repo.RepoOwner(), ".github", repo.RepoHost())
+	ownerTemplates, err := listTemplates(client, ownerRepo, "workflow-templates", ghrepo.FullName(ownerRepo))
+	if err != nil {
+		return nil, err
+	}
+	templates = append(templates, ownerTemplates...)
+
+	starterRepo := ghrepo.NewWithHost("actions", "starter-workflows", repo.RepoHost())
+	starterTemplates, err := listTemplates(client, starterRepo, "ci", ghrepo.FullName(starterRepo))
+	if err != nil {
+		return nil, err
+	}
+	templates = append(templates, starterTemplates...)
+
+	return templates, nil
+}
+
+func listTemplates(client *api.Client, repo ghrepo.Interface, dir, source string) ([]workflowTemplate, error) {
+	var entries []struct {
+		Name string
+		Path string
+		Type string
+	}
+
+	path := fmt.Sprintf("repos/%s/contents/%s", ghrepo.FullName(repo), dir)
+	err := client.REST(repo.RepoHost(), "GET", path, nil, &entries)
+	if err != nil {
+		var httpErr api.HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == 404 {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var templates []workflowTemplate
+	for _, entry := range entries {
+		if entry.Type != "file" {
+			continue
+		}
+		ext := ""
+		if strings.HasSuffix(entry.Name, ".yml") {
+			ext = ".yml"
+		} else if strings.HasSuffix(entry.Name, ".yaml") {
+			ext = ".yaml"
+		} else {
+			continue
+		}
+		templates = append(templates, workflowTemplate{
+			Name:     strings.TrimSuffix(entry.Name, ext),
+			FileName: entry.Name,
+			Source:   source,
+			Repo:     repo,
+			Path:     entry.Path,
+		})
+	}
+
+	return templates, nil
+}
+
+func fetchTemplateContent(client *api.Client, template *workflowTemplate) ([]byte, error) {
+	var result struct {
+		Content string
+	}
+
+	path := fmt.Sprintf("repos/%s/contents/%s", ghrepo.FullName(template.Repo), template.Path)
+	err := client.REST(template.Repo.RepoHost(), "GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(result.Content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode workflow template: %w", err)
+	}
+
+	return decoded, nil
+}
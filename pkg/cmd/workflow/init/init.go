@@ -0,0 +1,216 @@
+package init
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/markdown"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+type InitOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Selector string
+	Prompt   bool
+}
+
+func NewCmdInit(f *cmdutil.Factory, runF func(*InitOptions) error) *cobra.Command {
+	opts := &InitOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "init [<template>]",
+		Short: "Create a workflow file from a starter template",
+		Long: heredoc.Doc(`
+			Scaffold a GitHub Actions workflow file from a starter workflow template.
+
+			Templates come from the "workflow-templates" directory of the repository
+			owner's .github repository, if one exists, as well as the CI templates in
+			the actions/starter-workflows repository. Placeholders such as
+			$default-branch are filled in and the resulting file is written to
+			.github/workflows in the current directory.
+		`),
+		Example: heredoc.Doc(`
+			# Interactively select a starter workflow
+			$ gh workflow init
+
+			# Scaffold a specific starter workflow
+			$ gh workflow init go
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.Selector = args[0]
+			} else if !opts.IO.CanPrompt() {
+				return cmdutil.FlagErrorf("template argument required when not running interactively")
+			} else {
+				opts.Prompt = true
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return initRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func initRun(opts *InitOptions) error {
+	c, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not build http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(c)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("could not determine base repo: %w", err)
+	}
+
+	opts.IO.StartProgressIndicator()
+	templates, err := fetchTemplates(client, repo)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("could not fetch starter workflows: %w", err)
+	}
+	if len(templates) == 0 {
+		return fmt.Errorf("no starter workflows found for %s", ghrepo.FullName(repo))
+	}
+
+	var template *workflowTemplate
+	if opts.Prompt {
+		template, err = selectTemplate(templates)
+		if err != nil {
+			return err
+		}
+	} else {
+		template = findTemplate(templates, opts.Selector)
+		if template == nil {
+			return fmt.Errorf("could not find a starter workflow matching %q", opts.Selector)
+		}
+	}
+
+	opts.IO.StartProgressIndicator()
+	content, err := fetchTemplateContent(client, template)
+	if err == nil {
+		var defaultBranch string
+		defaultBranch, err = api.RepoDefaultBranch(client, repo)
+		if err == nil {
+			content = fillPlaceholders(content, defaultBranch)
+		}
+	}
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	destination := filepath.Join(".github", "workflows", template.FileName)
+	if _, err := os.Stat(destination); err == nil {
+		return fmt.Errorf("workflow file %s already exists", destination)
+	}
+
+	if opts.Prompt {
+		if err := previewTemplate(opts.IO, template, content); err != nil {
+			return err
+		}
+		confirmed := false
+		err := prompt.SurveyAskOne(&survey.Confirm{
+			Message: fmt.Sprintf("Write this workflow to %s?", destination),
+			Default: true,
+		}, &confirmed)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(destination), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(destination, content, 0644); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Created %s\n", cs.SuccessIcon(), destination)
+	}
+
+	return nil
+}
+
+func selectTemplate(templates []workflowTemplate) (*workflowTemplate, error) {
+	candidates := make([]string, len(templates))
+	for i, t := range templates {
+		candidates[i] = fmt.Sprintf("%s (%s)", t.Name, t.Source)
+	}
+
+	var selected int
+	err := prompt.SurveyAskOne(&survey.Select{
+		Message:  "Select a starter workflow",
+		Options:  candidates,
+		PageSize: 15,
+	}, &selected)
+	if err != nil {
+		return nil, err
+	}
+
+	return &templates[selected], nil
+}
+
+func findTemplate(templates []workflowTemplate, selector string) *workflowTemplate {
+	for i, t := range templates {
+		if strings.EqualFold(t.Name, selector) || strings.EqualFold(t.FileName, selector) {
+			return &templates[i]
+		}
+	}
+	return nil
+}
+
+func previewTemplate(io *iostreams.IOStreams, template *workflowTemplate, content []byte) error {
+	cs := io.ColorScheme()
+	fmt.Fprintf(io.Out, "%s - %s\n", cs.Bold(template.Name), cs.Gray(template.Source))
+
+	codeBlock := fmt.Sprintf("```yaml\n%s\n```", content)
+	rendered, err := markdown.Render(codeBlock, markdown.WithIO(io), markdown.WithoutIndentation(), markdown.WithWrap(0))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(io.Out, rendered)
+	return err
+}
+
+// fillPlaceholders substitutes the variables that github.com fills in when committing a
+// starter workflow through the web flow. The daily cron schedule is randomized to avoid
+// having every scaffolded workflow run at the same time.
+func fillPlaceholders(content []byte, defaultBranch string) []byte {
+	replacer := strings.NewReplacer(
+		"$default-branch", defaultBranch,
+		"$protected-branches", defaultBranch,
+		"$cron-daily", fmt.Sprintf("'%d %d * * *'", rand.Intn(60), rand.Intn(24)),
+	)
+	return []byte(replacer.Replace(string(content)))
+}
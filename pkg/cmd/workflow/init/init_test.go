@@ -0,0 +1,210 @@
+package init
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdInit(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		tty      bool
+		wants    InitOptions
+		wantsErr bool
+		errMsg   string
+	}{
+		{
+			name:     "blank nontty",
+			wantsErr: true,
+			errMsg:   "template argument required when not running interactively",
+		},
+		{
+			name: "blank tty",
+			tty:  true,
+			wants: InitOptions{
+				Prompt: true,
+			},
+		},
+		{
+			name: "template argument",
+			cli:  "go",
+			wants: InitOptions{
+				Selector: "go",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.SetStdinTTY(tt.tty)
+			io.SetStdoutTTY(tt.tty)
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *InitOptions
+			cmd := NewCmdInit(f, func(opts *InitOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.Selector, gotOpts.Selector)
+			assert.Equal(t, tt.wants.Prompt, gotOpts.Prompt)
+		})
+	}
+}
+
+func TestInitRun(t *testing.T) {
+	starterContents := `[
+		{"name": "go.yml", "path": "ci/go.yml", "type": "file"},
+		{"name": "node.js.yml", "path": "ci/node.js.yml", "type": "file"},
+		{"name": "README.md", "path": "ci/README.md", "type": "file"}
+	]`
+	goTemplate := "name: Go\non:\n  push:\n    branches: [ $default-branch ]\n"
+
+	tests := []struct {
+		name        string
+		opts        *InitOptions
+		httpStubs   func(*httpmock.Registry)
+		wantFile    string
+		wantContent string
+		wantErr     bool
+		errMsg      string
+	}{
+		{
+			name: "nontty with template",
+			opts: &InitOptions{
+				Selector: "go",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/.github/contents/workflow-templates"),
+					httpmock.StatusStringResponse(404, `{"message": "Not Found"}`))
+				reg.Register(
+					httpmock.REST("GET", "repos/actions/starter-workflows/contents/ci"),
+					httpmock.StringResponse(starterContents))
+				reg.Register(
+					httpmock.REST("GET", "repos/actions/starter-workflows/contents/ci/go.yml"),
+					httpmock.StringResponse(fmt.Sprintf(`{"content": %q}`,
+						base64.StdEncoding.EncodeToString([]byte(goTemplate)))))
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data": {"repository": {
+						"defaultBranchRef": {"name": "trunk"}
+					}}}`))
+			},
+			wantFile:    filepath.Join(".github", "workflows", "go.yml"),
+			wantContent: "name: Go\non:\n  push:\n    branches: [ trunk ]\n",
+		},
+		{
+			name: "owner templates take precedence",
+			opts: &InitOptions{
+				Selector: "go",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/.github/contents/workflow-templates"),
+					httpmock.StringResponse(`[
+						{"name": "go.yml", "path": "workflow-templates/go.yml", "type": "file"}
+					]`))
+				reg.Register(
+					httpmock.REST("GET", "repos/actions/starter-workflows/contents/ci"),
+					httpmock.StringResponse(starterContents))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/.github/contents/workflow-templates/go.yml"),
+					httpmock.StringResponse(fmt.Sprintf(`{"content": %q}`,
+						base64.StdEncoding.EncodeToString([]byte("name: Org Go\n")))))
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data": {"repository": {
+						"defaultBranchRef": {"name": "main"}
+					}}}`))
+			},
+			wantFile:    filepath.Join(".github", "workflows", "go.yml"),
+			wantContent: "name: Org Go\n",
+		},
+		{
+			name: "no matching template",
+			opts: &InitOptions{
+				Selector: "cobol",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/.github/contents/workflow-templates"),
+					httpmock.StatusStringResponse(404, `{"message": "Not Found"}`))
+				reg.Register(
+					httpmock.REST("GET", "repos/actions/starter-workflows/contents/ci"),
+					httpmock.StringResponse(starterContents))
+			},
+			wantErr: true,
+			errMsg:  `could not find a starter workflow matching "cobol"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			oldWd, err := os.Getwd()
+			assert.NoError(t, err)
+			assert.NoError(t, os.Chdir(dir))
+			defer func() {
+				_ = os.Chdir(oldWd)
+			}()
+
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			tt.httpStubs(reg)
+
+			io, _, _, _ := iostreams.Test()
+			tt.opts.IO = io
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+
+			err = initRun(tt.opts)
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+
+			written, err := os.ReadFile(tt.wantFile)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantContent, string(written))
+		})
+	}
+}
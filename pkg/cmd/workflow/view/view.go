@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
@@ -191,6 +192,36 @@ func viewWorkflowContent(opts *ViewOptions, client *api.Client, workflow *shared
 	return nil
 }
 
+// runStatsLine summarizes the success rate and average duration of the completed
+// runs among the recent ones, or returns an empty string if none have completed.
+func runStatsLine(runs []runShared.Run) string {
+	completed := 0
+	succeeded := 0
+	var totalElapsed time.Duration
+	for _, run := range runs {
+		if run.Status != runShared.Completed {
+			continue
+		}
+		completed++
+		if run.Conclusion == runShared.Success {
+			succeeded++
+		}
+		elapsed := run.UpdatedAt.Sub(run.CreatedAt)
+		if elapsed < 0 {
+			elapsed = 0
+		}
+		totalElapsed += elapsed
+	}
+
+	if completed == 0 {
+		return ""
+	}
+
+	successRate := 100 * succeeded / completed
+	averageElapsed := (totalElapsed / time.Duration(completed)).Round(time.Second)
+	return fmt.Sprintf("Of the last %d completed runs, %d%% succeeded with an average duration of %s", completed, successRate, averageElapsed)
+}
+
 func viewWorkflowInfo(opts *ViewOptions, client *api.Client, workflow *shared.Workflow) error {
 	repo, err := opts.BaseRepo()
 	if err != nil {
@@ -217,6 +248,9 @@ func viewWorkflowInfo(opts *ViewOptions, client *api.Client, workflow *shared.Wo
 	fmt.Fprintf(out, "Total runs %d\n", wr.Total)
 
 	if wr.Total != 0 {
+		if line := runStatsLine(wr.Runs); line != "" {
+			fmt.Fprintln(out, line)
+		}
 		fmt.Fprintln(out, "Recent runs")
 	}
 
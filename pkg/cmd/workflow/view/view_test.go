@@ -174,6 +174,7 @@ func TestViewRun(t *testing.T) {
 		ID: 123
 
 		Total runs 10
+		Of the last 3 completed runs, 33% succeeded with an average duration of 4m34s
 		Recent runs
 		X  cool commit  timed out    trunk  push  1
 		*  cool commit  in progress  trunk  push  2
@@ -3,6 +3,7 @@ package workflow
 import (
 	cmdDisable "github.com/cli/cli/v2/pkg/cmd/workflow/disable"
 	cmdEnable "github.com/cli/cli/v2/pkg/cmd/workflow/enable"
+	cmdInit "github.com/cli/cli/v2/pkg/cmd/workflow/init"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/workflow/list"
 	cmdRun "github.com/cli/cli/v2/pkg/cmd/workflow/run"
 	cmdView "github.com/cli/cli/v2/pkg/cmd/workflow/view"
@@ -25,6 +26,7 @@ func NewCmdWorkflow(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdEnable.NewCmdEnable(f, nil))
 	cmd.AddCommand(cmdDisable.NewCmdDisable(f, nil))
 	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdInit.NewCmdInit(f, nil))
 	cmd.AddCommand(cmdRun.NewCmdRun(f, nil))
 
 	return cmd
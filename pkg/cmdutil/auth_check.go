@@ -1,7 +1,13 @@
 package cmdutil
 
 import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
 	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/spf13/cobra"
 )
 
@@ -13,6 +19,74 @@ func DisableAuthCheck(cmd *cobra.Command) {
 	cmd.Annotations["skipAuthCheck"] = "true"
 }
 
+// RequireScopes declares OAuth scopes beyond the minimum set that a command
+// depends on, e.g. "workflow" or "admin:org". A preflight check uses this to
+// suggest `gh auth refresh` up front instead of letting the server respond
+// with a generic 403.
+func RequireScopes(cmd *cobra.Command, scopes ...string) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+
+	cmd.Annotations["requiredScopes"] = strings.Join(scopes, ",")
+}
+
+// RequiredScopes returns the OAuth scopes declared on a command or any of its
+// parents via RequireScopes.
+func RequiredScopes(cmd *cobra.Command) []string {
+	for c := cmd; c != nil; c = c.Parent() {
+		if c.Annotations != nil && c.Annotations["requiredScopes"] != "" {
+			return strings.Split(c.Annotations["requiredScopes"], ",")
+		}
+	}
+	return nil
+}
+
+// MissingScopes reports which of the wanted scopes the token for hostname does
+// not cover. Tokens that report no scopes at all, e.g. those issued to GitHub
+// App installations, disable the check.
+func MissingScopes(httpClient *http.Client, hostname string, wanted []string) ([]string, error) {
+	req, err := http.NewRequest("GET", ghinstance.RESTPrefix(hostname), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, res.Body)
+		res.Body.Close()
+	}()
+
+	scopesHeader := res.Header.Get("X-Oauth-Scopes")
+	if scopesHeader == "" {
+		return nil, nil
+	}
+
+	have := map[string]struct{}{}
+	for _, s := range strings.Split(scopesHeader, ",") {
+		s = strings.TrimSpace(s)
+		have[s] = struct{}{}
+		// a broader scope implies access covered by its narrower variants
+		if name := strings.TrimPrefix(s, "admin:"); name != s {
+			have["write:"+name] = struct{}{}
+			have["read:"+name] = struct{}{}
+		} else if name := strings.TrimPrefix(s, "write:"); name != s {
+			have["read:"+name] = struct{}{}
+		}
+	}
+
+	var missing []string
+	for _, s := range wanted {
+		if _, ok := have[s]; !ok {
+			missing = append(missing, s)
+		}
+	}
+	return missing, nil
+}
+
 func CheckAuth(cfg config.Config) bool {
 	if config.AuthTokenProvidedFromEnv() {
 		return true
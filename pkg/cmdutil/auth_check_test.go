@@ -1,10 +1,12 @@
 package cmdutil
 
 import (
+	"net/http"
 	"os"
 	"testing"
 
 	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -64,3 +66,51 @@ func Test_CheckAuth(t *testing.T) {
 		})
 	}
 }
+
+func Test_MissingScopes(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		wanted  []string
+		missing []string
+	}{
+		{
+			name:    "all scopes present",
+			header:  "repo, read:org, workflow",
+			wanted:  []string{"workflow"},
+			missing: nil,
+		},
+		{
+			name:    "missing scope",
+			header:  "repo, read:org",
+			wanted:  []string{"workflow"},
+			missing: []string{"workflow"},
+		},
+		{
+			name:    "broader scope implies narrower",
+			header:  "repo, admin:org",
+			wanted:  []string{"read:org", "write:org"},
+			missing: nil,
+		},
+		{
+			name:    "no scopes reported",
+			header:  "",
+			wanted:  []string{"workflow"},
+			missing: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			reg.Register(
+				httpmock.REST("GET", ""),
+				httpmock.ScopesResponder(tt.header))
+
+			missing, err := MissingScopes(&http.Client{Transport: reg}, "github.com", tt.wanted)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.missing, missing)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package cmdutil
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -38,7 +39,7 @@ var SilentError = errors.New("SilentError")
 var CancelError = errors.New("CancelError")
 
 func IsUserCancellation(err error) bool {
-	return errors.Is(err, CancelError) || errors.Is(err, terminal.InterruptErr)
+	return errors.Is(err, CancelError) || errors.Is(err, terminal.InterruptErr) || errors.Is(err, context.Canceled)
 }
 
 func MutuallyExclusive(message string, conditions ...bool) error {
@@ -9,9 +9,10 @@ import (
 type ExtTemplateType int
 
 const (
-	GitTemplateType      ExtTemplateType = 0
-	GoBinTemplateType    ExtTemplateType = 1
-	OtherBinTemplateType ExtTemplateType = 2
+	GitTemplateType       ExtTemplateType = 0
+	GoBinTemplateType     ExtTemplateType = 1
+	OtherBinTemplateType  ExtTemplateType = 2
+	GoReleaseTemplateType ExtTemplateType = 3
 )
 
 //go:generate moq -rm -out extension_mock.go . Extension
@@ -33,4 +34,5 @@ type ExtensionManager interface {
 	Remove(name string) error
 	Dispatch(args []string, stdin io.Reader, stdout, stderr io.Writer) (bool, error)
 	Create(name string, tmplType ExtTemplateType) error
+	EnableDryRunMode()
 }
@@ -25,6 +25,9 @@ var _ ExtensionManager = &ExtensionManagerMock{}
 // 			DispatchFunc: func(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) (bool, error) {
 // 				panic("mock out the Dispatch method")
 // 			},
+// 			EnableDryRunModeFunc: func()  {
+// 				panic("mock out the EnableDryRunMode method")
+// 			},
 // 			InstallFunc: func(interfaceMoqParam ghrepo.Interface) error {
 // 				panic("mock out the Install method")
 // 			},
@@ -53,6 +56,9 @@ type ExtensionManagerMock struct {
 	// DispatchFunc mocks the Dispatch method.
 	DispatchFunc func(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) (bool, error)
 
+	// EnableDryRunModeFunc mocks the EnableDryRunMode method.
+	EnableDryRunModeFunc func()
+
 	// InstallFunc mocks the Install method.
 	InstallFunc func(interfaceMoqParam ghrepo.Interface) error
 
@@ -88,6 +94,9 @@ type ExtensionManagerMock struct {
 			// Stderr is the stderr argument value.
 			Stderr io.Writer
 		}
+		// EnableDryRunMode holds details about calls to the EnableDryRunMode method.
+		EnableDryRunMode []struct {
+		}
 		// Install holds details about calls to the Install method.
 		Install []struct {
 			// InterfaceMoqParam is the interfaceMoqParam argument value.
@@ -116,13 +125,14 @@ type ExtensionManagerMock struct {
 			Force bool
 		}
 	}
-	lockCreate       sync.RWMutex
-	lockDispatch     sync.RWMutex
-	lockInstall      sync.RWMutex
-	lockInstallLocal sync.RWMutex
-	lockList         sync.RWMutex
-	lockRemove       sync.RWMutex
-	lockUpgrade      sync.RWMutex
+	lockCreate           sync.RWMutex
+	lockDispatch         sync.RWMutex
+	lockEnableDryRunMode sync.RWMutex
+	lockInstall          sync.RWMutex
+	lockInstallLocal     sync.RWMutex
+	lockList             sync.RWMutex
+	lockRemove           sync.RWMutex
+	lockUpgrade          sync.RWMutex
 }
 
 // Create calls CreateFunc.
@@ -203,6 +213,32 @@ func (mock *ExtensionManagerMock) DispatchCalls() []struct {
 	return calls
 }
 
+// EnableDryRunMode calls EnableDryRunModeFunc.
+func (mock *ExtensionManagerMock) EnableDryRunMode() {
+	if mock.EnableDryRunModeFunc == nil {
+		panic("ExtensionManagerMock.EnableDryRunModeFunc: method is nil but ExtensionManager.EnableDryRunMode was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockEnableDryRunMode.Lock()
+	mock.calls.EnableDryRunMode = append(mock.calls.EnableDryRunMode, callInfo)
+	mock.lockEnableDryRunMode.Unlock()
+	mock.EnableDryRunModeFunc()
+}
+
+// EnableDryRunModeCalls gets all the calls that were made to EnableDryRunMode.
+// Check the length with:
+//     len(mockedExtensionManager.EnableDryRunModeCalls())
+func (mock *ExtensionManagerMock) EnableDryRunModeCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockEnableDryRunMode.RLock()
+	calls = mock.calls.EnableDryRunMode
+	mock.lockEnableDryRunMode.RUnlock()
+	return calls
+}
+
 // Install calls InstallFunc.
 func (mock *ExtensionManagerMock) Install(interfaceMoqParam ghrepo.Interface) error {
 	if mock.InstallFunc == nil {
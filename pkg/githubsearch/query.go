@@ -49,6 +49,9 @@ type Query struct {
 	mentions   string
 	milestone  string
 
+	createdAfter  string
+	createdBefore string
+
 	language   string
 	topic      string
 	forkState  string
@@ -112,6 +115,14 @@ func (q *Query) InMilestone(title string) {
 	q.milestone = title
 }
 
+func (q *Query) CreatedAfter(date string) {
+	q.createdAfter = date
+}
+
+func (q *Query) CreatedBefore(date string) {
+	q.createdBefore = date
+}
+
 func (q *Query) AddLabel(name string) {
 	q.labels = append(q.labels, name)
 }
@@ -195,6 +206,13 @@ func (q *Query) String() string {
 	if q.milestone != "" {
 		qs += fmt.Sprintf("milestone:%s ", quote(q.milestone))
 	}
+	if q.createdAfter != "" && q.createdBefore != "" {
+		qs += fmt.Sprintf("created:%s..%s ", q.createdAfter, q.createdBefore)
+	} else if q.createdAfter != "" {
+		qs += fmt.Sprintf("created:>=%s ", q.createdAfter)
+	} else if q.createdBefore != "" {
+		qs += fmt.Sprintf("created:<=%s ", q.createdBefore)
+	}
 
 	// pull requests
 	if q.baseBranch != "" {
@@ -0,0 +1,202 @@
+package httpmock
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// RecordModeEnv selects whether a Recorder replays a cassette from disk or
+// records a fresh one against the live API.
+const RecordModeEnv = "GH_RECORD_MODE"
+
+// sensitiveHeaders are never written to cassettes
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type RecordedResponse struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body"`
+}
+
+// Interaction is a single request/response pair within a cassette.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// Recorder is an http.RoundTripper that either replays interactions from a
+// cassette file or, when RecordModeEnv is set to "record", forwards requests
+// to a real transport and captures the sanitized interactions for later
+// replay. Interactions are matched strictly in the order they were recorded.
+type Recorder struct {
+	// Path is the cassette file that interactions are replayed from or
+	// recorded to.
+	Path string
+	// Transport performs real requests while recording; http.DefaultTransport
+	// is used when nil.
+	Transport http.RoundTripper
+
+	recording    bool
+	mu           sync.Mutex
+	interactions []Interaction
+	replayIndex  int
+}
+
+// NewRecorder initializes a Recorder for the cassette file at path. In replay
+// mode the cassette must already exist.
+func NewRecorder(path string, transport http.RoundTripper) (*Recorder, error) {
+	r := &Recorder{
+		Path:      path,
+		Transport: transport,
+	}
+
+	if os.Getenv(RecordModeEnv) == "record" {
+		r.recording = true
+		return r, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &r.interactions); err != nil {
+		return nil, fmt.Errorf("invalid cassette %s: %w", path, err)
+	}
+	return r, nil
+}
+
+// RoundTrip satisfies http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.recording {
+		return r.record(req)
+	}
+	return r.replay(req)
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		if reqBody, err = readBody(req); err != nil {
+			return nil, err
+		}
+	}
+
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewBuffer(respBody))
+
+	headers := map[string]string{}
+	for name := range resp.Header {
+		if isSensitiveHeader(name) {
+			continue
+		}
+		headers[name] = resp.Header.Get(name)
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Body:   string(reqBody),
+		},
+		Response: RecordedResponse{
+			Status:  resp.StatusCode,
+			Headers: headers,
+			Body:    string(respBody),
+		},
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	if r.replayIndex >= len(r.interactions) {
+		r.mu.Unlock()
+		return nil, fmt.Errorf("cassette %s has no interaction left for %s %s", r.Path, req.Method, req.URL)
+	}
+	interaction := r.interactions[r.replayIndex]
+	r.replayIndex++
+	r.mu.Unlock()
+
+	if interaction.Request.Method != req.Method || interaction.Request.URL != req.URL.String() {
+		return nil, fmt.Errorf("cassette %s expected %s %s, got %s %s",
+			r.Path, interaction.Request.Method, interaction.Request.URL, req.Method, req.URL)
+	}
+
+	resp := httpResponse(interaction.Response.Status, req, bytes.NewBufferString(interaction.Response.Body))
+	for name, value := range interaction.Response.Headers {
+		resp.Header.Set(name, value)
+	}
+	return resp, nil
+}
+
+// Save writes the recorded cassette to disk; it is a no-op while replaying.
+func (r *Recorder) Save() error {
+	if !r.recording {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.Path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.Path, append(data, '\n'), 0644)
+}
+
+// Verify asserts that replaying consumed every interaction in the cassette.
+func (r *Recorder) Verify(t Testing) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.recording && r.replayIndex < len(r.interactions) {
+		t.Helper()
+		t.Errorf("%d unplayed cassette interactions", len(r.interactions)-r.replayIndex)
+	}
+}
+
+func isSensitiveHeader(name string) bool {
+	for _, h := range sensitiveHeaders {
+		if http.CanonicalHeaderKey(name) == h {
+			return true
+		}
+	}
+	return false
+}
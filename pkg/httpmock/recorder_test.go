@@ -0,0 +1,83 @@
+package httpmock
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorder_recordAndReplay(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+
+	transport := &Registry{}
+	transport.Register(
+		REST("GET", "repos/OWNER/REPO"),
+		WithHeader(StringResponse(`{"name":"REPO"}`), "Set-Cookie", "secret=hunter2"))
+
+	t.Setenv(RecordModeEnv, "record")
+	recorder, err := NewRecorder(cassette, transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := mustNewRequest(t, "GET", "https://api.github.com/repos/OWNER/REPO", "")
+	req.Header.Set("Authorization", "token hunter2")
+	if _, err := recorder.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "hunter2") {
+		t.Errorf("cassette leaked a sensitive header: %s", data)
+	}
+
+	t.Setenv(RecordModeEnv, "")
+	replayer, err := NewRecorder(cassette, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := replayer.RoundTrip(mustNewRequest(t, "GET", "https://api.github.com/repos/OWNER/REPO", ""))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body, _ := ioutil.ReadAll(resp.Body); string(body) != `{"name":"REPO"}` {
+		t.Errorf("got response %q", body)
+	}
+	replayer.Verify(t)
+}
+
+func TestRecorder_replayMismatch(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	err := ioutil.WriteFile(cassette, []byte(`[
+		{
+			"request": {"method": "GET", "url": "https://api.github.com/repos/OWNER/REPO"},
+			"response": {"status": 200, "body": "{}"}
+		}
+	]`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder, err := NewRecorder(cassette, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = recorder.RoundTrip(mustNewRequest(t, "GET", "https://api.github.com/repos/OWNER/OTHER", ""))
+	if err == nil {
+		t.Error("expected mismatched request to fail")
+	}
+
+	_, err = recorder.RoundTrip(mustNewRequest(t, "GET", "https://api.github.com/repos/OWNER/REPO", ""))
+	if err == nil {
+		t.Error("expected exhausted cassette to fail")
+	}
+}
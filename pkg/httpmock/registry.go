@@ -10,6 +10,10 @@ type Registry struct {
 	mu       sync.Mutex
 	stubs    []*Stub
 	Requests []*http.Request
+
+	// Ordered makes the registry enforce that requests are received in the same
+	// order that their stubs were registered in.
+	Ordered bool
 }
 
 func (r *Registry) Register(m Matcher, resp Responder) {
@@ -39,22 +43,54 @@ func (r *Registry) Verify(t Testing) {
 	}
 }
 
+// NthRequest returns the nth request, 1-based, that the registry has received.
+func (r *Registry) NthRequest(n int) *http.Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if n < 1 || n > len(r.Requests) {
+		panic(fmt.Sprintf("invalid request index %d; the registry received %d requests", n, len(r.Requests)))
+	}
+	return r.Requests[n-1]
+}
+
+// NthRequestBody returns the payload of the nth request, 1-based, preserving it
+// so that it can be read again.
+func (r *Registry) NthRequestBody(n int) ([]byte, error) {
+	return readBody(r.NthRequest(n))
+}
+
 // RoundTrip satisfies http.RoundTripper
 func (r *Registry) RoundTrip(req *http.Request) (*http.Response, error) {
 	var stub *Stub
 
 	r.mu.Lock()
-	for _, s := range r.stubs {
-		if s.matched || !s.Matcher(req) {
-			continue
+	if r.Ordered {
+		for _, s := range r.stubs {
+			if s.matched {
+				continue
+			}
+			if s.Matcher(req) {
+				stub = s
+			}
+			break
+		}
+		if stub == nil {
+			r.mu.Unlock()
+			return nil, fmt.Errorf("request did not match the next registered stub: %v", req)
+		}
+	} else {
+		for _, s := range r.stubs {
+			if s.matched || !s.Matcher(req) {
+				continue
+			}
+			// TODO: reinstate this check once the legacy layer has been cleaned up
+			// if stub != nil {
+			// 	r.mu.Unlock()
+			// 	return nil, fmt.Errorf("more than 1 stub matched %v", req)
+			// }
+			stub = s
+			break // TODO: remove
 		}
-		// TODO: reinstate this check once the legacy layer has been cleaned up
-		// if stub != nil {
-		// 	r.mu.Unlock()
-		// 	return nil, fmt.Errorf("more than 1 stub matched %v", req)
-		// }
-		stub = s
-		break // TODO: remove
 	}
 	if stub != nil {
 		stub.matched = true
@@ -0,0 +1,97 @@
+package httpmock
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func mustNewRequest(t *testing.T, method, url, body string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest(method, url, bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func TestRegistry_Ordered(t *testing.T) {
+	reg := Registry{Ordered: true}
+	reg.Register(REST("GET", "repos/OWNER/REPO"), StringResponse("first"))
+	reg.Register(REST("GET", "repos/OWNER/REPO/branches"), StringResponse("second"))
+
+	_, err := reg.RoundTrip(mustNewRequest(t, "GET", "https://api.github.com/repos/OWNER/REPO/branches", ""))
+	if err == nil {
+		t.Error("expected out-of-order request to fail")
+	}
+
+	resp, err := reg.RoundTrip(mustNewRequest(t, "GET", "https://api.github.com/repos/OWNER/REPO", ""))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body, _ := ioutil.ReadAll(resp.Body); string(body) != "first" {
+		t.Errorf("got response %q, want %q", body, "first")
+	}
+
+	if _, err := reg.RoundTrip(mustNewRequest(t, "GET", "https://api.github.com/repos/OWNER/REPO/branches", "")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	reg.Verify(t)
+}
+
+func TestRegistry_NthRequestBody(t *testing.T) {
+	reg := Registry{}
+	reg.Register(MatchAny, StringResponse("{}"))
+	reg.Register(MatchAny, StringResponse("{}"))
+
+	for _, body := range []string{`{"n":1}`, `{"n":2}`} {
+		if _, err := reg.RoundTrip(mustNewRequest(t, "POST", "https://api.github.com/graphql", body)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		// reading the same body twice must succeed
+		body, err := reg.NthRequestBody(2)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(body) != `{"n":2}` {
+			t.Errorf("got body %q", body)
+		}
+	}
+}
+
+func TestGraphQLVariables(t *testing.T) {
+	matcher := GraphQLVariables(`query RepositoryInfo\b`, map[string]interface{}{
+		"owner": "OWNER",
+		"limit": float64(30),
+	})
+
+	matching := `{"query":"query RepositoryInfo { viewer }","variables":{"owner":"OWNER","limit":30,"name":"REPO"}}`
+	if !matcher(mustNewRequest(t, "POST", "https://api.github.com/graphql", matching)) {
+		t.Error("expected request to match")
+	}
+
+	differentVariable := `{"query":"query RepositoryInfo { viewer }","variables":{"owner":"monalisa","limit":30}}`
+	if matcher(mustNewRequest(t, "POST", "https://api.github.com/graphql", differentVariable)) {
+		t.Error("expected request with different variables not to match")
+	}
+}
+
+func TestRESTBody(t *testing.T) {
+	matcher := RESTBody("PATCH", "repos/OWNER/REPO", map[string]interface{}{
+		"visibility": "private",
+	})
+
+	matching := `{"visibility":"private","has_issues":true}`
+	if !matcher(mustNewRequest(t, "PATCH", "https://api.github.com/repos/OWNER/REPO", matching)) {
+		t.Error("expected request to match")
+	}
+
+	differentField := `{"visibility":"public"}`
+	if matcher(mustNewRequest(t, "PATCH", "https://api.github.com/repos/OWNER/REPO", differentField)) {
+		t.Error("expected request with different payload not to match")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"reflect"
 	"regexp"
 	"strings"
 )
@@ -36,6 +37,26 @@ func REST(method, p string) Matcher {
 	}
 }
 
+// RESTBody matches like REST, but additionally requires that each of the given fields
+// appears with the given value in the JSON request payload. Note that numbers decoded
+// from JSON are float64.
+func RESTBody(method, p string, fields map[string]interface{}) Matcher {
+	matchRoute := REST(method, p)
+	return func(req *http.Request) bool {
+		if !matchRoute(req) {
+			return false
+		}
+		bodyData := map[string]interface{}{}
+		_ = decodeJSONBody(req, &bodyData)
+		for name, expected := range fields {
+			if !reflect.DeepEqual(bodyData[name], expected) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
 func GraphQL(q string) Matcher {
 	re := regexp.MustCompile(q)
 
@@ -56,6 +77,28 @@ func GraphQL(q string) Matcher {
 	}
 }
 
+// GraphQLVariables matches like GraphQL, but additionally requires that each of the given
+// variables appears with the given value in the request. Note that numbers decoded from
+// JSON are float64.
+func GraphQLVariables(q string, vars map[string]interface{}) Matcher {
+	matchQuery := GraphQL(q)
+	return func(req *http.Request) bool {
+		if !matchQuery(req) {
+			return false
+		}
+		var bodyData struct {
+			Variables map[string]interface{}
+		}
+		_ = decodeJSONBody(req, &bodyData)
+		for name, expected := range vars {
+			if !reflect.DeepEqual(bodyData.Variables[name], expected) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
 func readBody(req *http.Request) ([]byte, error) {
 	bodyCopy := &bytes.Buffer{}
 	r := io.TeeReader(req.Body, bodyCopy)
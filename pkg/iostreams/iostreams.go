@@ -53,6 +53,7 @@ type IOStreams struct {
 	pagerProcess *os.Process
 
 	neverPrompt bool
+	accessible  bool
 
 	TempFileOverride *os.File
 }
@@ -232,6 +233,16 @@ func (s *IOStreams) SetNeverPrompt(v bool) {
 	s.neverPrompt = v
 }
 
+// SetAccessible toggles screen-reader friendly output: no spinners and
+// plain-text progress reporting.
+func (s *IOStreams) SetAccessible(v bool) {
+	s.accessible = v
+}
+
+func (s *IOStreams) IsAccessible() bool {
+	return s.accessible
+}
+
 func (s *IOStreams) StartProgressIndicator() {
 	s.StartProgressIndicatorWithLabel("")
 }
@@ -241,6 +252,14 @@ func (s *IOStreams) StartProgressIndicatorWithLabel(label string) {
 		return
 	}
 
+	// animated spinners are noise for screen readers; report progress as plain text
+	if s.accessible {
+		if label != "" {
+			fmt.Fprintln(s.ErrOut, label)
+		}
+		return
+	}
+
 	s.progressIndicatorMu.Lock()
 	defer s.progressIndicatorMu.Unlock()
 
@@ -0,0 +1,98 @@
+// Package listview implements a reusable interactive list for terminal
+// output: the user navigates a list of items, picks actions to perform on
+// them, and can lazily load further pages.
+package listview
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/cli/cli/v2/pkg/prompt"
+)
+
+const (
+	loadMoreOption = "Load next page"
+	quitOption     = "Quit"
+	backOption     = "Back"
+)
+
+// An Action is an operation the user can perform on a selected list item.
+type Action struct {
+	Name string
+	// Run receives the index of the selected item.
+	Run func(index int) error
+}
+
+type List struct {
+	Message string
+	Items   []string
+	Actions []Action
+
+	// LoadMore returns the next page of items and whether more pages remain.
+	// Leave nil when all items are already loaded.
+	LoadMore func() ([]string, bool, error)
+}
+
+// Run presents the list until the user quits, an action fails, or loading a
+// page fails.
+func (l *List) Run() error {
+	for {
+		options := make([]string, 0, len(l.Items)+2)
+		options = append(options, l.Items...)
+		if l.LoadMore != nil {
+			options = append(options, loadMoreOption)
+		}
+		options = append(options, quitOption)
+
+		var selected int
+		err := prompt.SurveyAskOne(&survey.Select{
+			Message: l.Message,
+			Options: options,
+		}, &selected)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case selected == len(options)-1:
+			return nil
+		case l.LoadMore != nil && selected == len(l.Items):
+			items, hasMore, err := l.LoadMore()
+			if err != nil {
+				return err
+			}
+			l.Items = append(l.Items, items...)
+			if !hasMore {
+				l.LoadMore = nil
+			}
+		default:
+			if err := l.selectAction(selected); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (l *List) selectAction(index int) error {
+	if len(l.Actions) == 1 {
+		return l.Actions[0].Run(index)
+	}
+
+	options := make([]string, 0, len(l.Actions)+1)
+	for _, action := range l.Actions {
+		options = append(options, action.Name)
+	}
+	options = append(options, backOption)
+
+	var selected int
+	err := prompt.SurveyAskOne(&survey.Select{
+		Message: l.Items[index],
+		Options: options,
+	}, &selected)
+	if err != nil {
+		return err
+	}
+
+	if selected == len(options)-1 {
+		return nil
+	}
+	return l.Actions[selected].Run(index)
+}
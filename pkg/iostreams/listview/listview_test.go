@@ -0,0 +1,84 @@
+package listview
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList_Run(t *testing.T) {
+	as := prompt.NewAskStubber(t)
+
+	var opened []int
+	list := &List{
+		Message: "Pull requests",
+		Items:   []string{"#1 one", "#2 two"},
+		Actions: []Action{
+			{
+				Name: "Open in browser",
+				Run: func(index int) error {
+					opened = append(opened, index)
+					return nil
+				},
+			},
+		},
+		LoadMore: func() ([]string, bool, error) {
+			return []string{"#3 three"}, false, nil
+		},
+	}
+
+	as.StubPrompt("Pull requests").
+		AssertOptions([]string{"#1 one", "#2 two", "Load next page", "Quit"}).
+		AnswerWith("#2 two")
+	as.StubPrompt("Pull requests").
+		AnswerWith("Load next page")
+	as.StubPrompt("Pull requests").
+		AssertOptions([]string{"#1 one", "#2 two", "#3 three", "Quit"}).
+		AnswerWith("#3 three")
+	as.StubPrompt("Pull requests").
+		AnswerWith("Quit")
+
+	err := list.Run()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, opened)
+}
+
+func TestList_Run_actionMenu(t *testing.T) {
+	as := prompt.NewAskStubber(t)
+
+	var viewed, opened []int
+	list := &List{
+		Message: "Issues",
+		Items:   []string{"#1 one"},
+		Actions: []Action{
+			{
+				Name: "Open in browser",
+				Run: func(index int) error {
+					opened = append(opened, index)
+					return nil
+				},
+			},
+			{
+				Name: "View",
+				Run: func(index int) error {
+					viewed = append(viewed, index)
+					return nil
+				},
+			},
+		},
+	}
+
+	as.StubPrompt("Issues").AnswerWith("#1 one")
+	as.StubPrompt("#1 one").
+		AssertOptions([]string{"Open in browser", "View", "Back"}).
+		AnswerWith("View")
+	as.StubPrompt("Issues").AnswerWith("#1 one")
+	as.StubPrompt("#1 one").AnswerWith("Back")
+	as.StubPrompt("Issues").AnswerWith("Quit")
+
+	err := list.Run()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0}, viewed)
+	assert.Empty(t, opened)
+}
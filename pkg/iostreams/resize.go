@@ -0,0 +1,35 @@
+//go:build !windows
+// +build !windows
+
+package iostreams
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// StartResizeListener invokes fn from a goroutine every time the terminal
+// emulator reports that its window size has changed. The returned function
+// stops listening.
+func (s *IOStreams) StartResizeListener(fn func()) func() {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGWINCH)
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ch:
+				fn()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(ch)
+		close(done)
+	}
+}
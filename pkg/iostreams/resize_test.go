@@ -0,0 +1,33 @@
+//go:build !windows
+// +build !windows
+
+package iostreams
+
+import (
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestStartResizeListener(t *testing.T) {
+	io, _, _, _ := Test()
+
+	resized := make(chan struct{}, 1)
+	stop := io.StartResizeListener(func() {
+		select {
+		case resized <- struct{}{}:
+		default:
+		}
+	})
+	defer stop()
+
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGWINCH); err != nil {
+		t.Fatalf("failed to send SIGWINCH: %v", err)
+	}
+
+	select {
+	case <-resized:
+	case <-time.After(time.Second):
+		t.Error("expected resize callback to fire")
+	}
+}
@@ -0,0 +1,10 @@
+//go:build windows
+// +build windows
+
+package iostreams
+
+// StartResizeListener is a no-op on Windows, which has no SIGWINCH equivalent.
+// The returned function stops listening.
+func (s *IOStreams) StartResizeListener(fn func()) func() {
+	return func() {}
+}
@@ -0,0 +1,170 @@
+// Package logstream implements a client for subscribing to live log streams
+// published as server-sent events, such as the log output of in-progress
+// GitHub Actions jobs.
+package logstream
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// An Event is a single message received from a log stream.
+type Event struct {
+	// ID identifies the event's position in the stream and is echoed back to
+	// the server on reconnects so that no events are replayed or dropped.
+	ID string
+	// Name distinguishes kinds of events; log output has an empty name.
+	Name string
+	// Data carries the event payload. Multi-line payloads are joined with newlines.
+	Data string
+}
+
+type Client struct {
+	httpClient *http.Client
+
+	// retryDelay is the pause before reconnecting after a dropped connection.
+	retryDelay time.Duration
+}
+
+func NewClient(httpClient *http.Client) *Client {
+	return &Client{
+		httpClient: httpClient,
+		retryDelay: 2 * time.Second,
+	}
+}
+
+// DoneEvent is the name of the event that servers send to signal the end of
+// a stream. Dropped connections are retried; a done event is final.
+const DoneEvent = "done"
+
+// Subscribe connects to the stream at url and invokes handler for every event
+// received. It transparently reconnects when the connection drops, resuming
+// from the last seen event ID. Subscribe blocks until the server sends a done
+// event, ctx is cancelled, or the server responds with an error status.
+func (c *Client) Subscribe(ctx context.Context, url string, handler func(Event)) error {
+	var lastEventID string
+	var done bool
+
+	for {
+		err := c.subscribeOnce(ctx, url, lastEventID, func(ev Event) {
+			if ev.ID != "" {
+				lastEventID = ev.ID
+			}
+			if ev.Name == DoneEvent {
+				done = true
+			}
+			handler(ev)
+		})
+		if done || err == nil || errors.Is(err, context.Canceled) {
+			return nil
+		}
+		var retryErr *retryableError
+		if !errors.As(err, &retryErr) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(c.retryDelay):
+		}
+	}
+}
+
+// retryableError wraps connection errors that warrant a reconnect.
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string { return e.err.Error() }
+func (e *retryableError) Unwrap() error { return e.err }
+
+func (c *Client) subscribeOnce(ctx context.Context, url, lastEventID string, handler func(Event)) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Cache-Control", "no-cache")
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return &retryableError{err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return fmt.Errorf("stream returned HTTP %d", resp.StatusCode)
+	}
+
+	err = decode(resp.Body, handler)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err == nil {
+		// the server ended the stream without a done event; reconnect
+		err = io.EOF
+	}
+	return &retryableError{err}
+}
+
+// decode reads server-sent events from r and invokes handler for each one
+// until the stream ends.
+func decode(r io.Reader, handler func(Event)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var ev Event
+	var data []string
+	dispatch := func() {
+		if len(data) == 0 && ev.ID == "" && ev.Name == "" {
+			return
+		}
+		ev.Data = strings.Join(data, "\n")
+		handler(ev)
+		ev = Event{}
+		data = nil
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			dispatch()
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			// comment used as a keep-alive
+			continue
+		}
+
+		field, value := line, ""
+		if idx := strings.IndexRune(line, ':'); idx >= 0 {
+			field = line[:idx]
+			value = strings.TrimPrefix(line[idx+1:], " ")
+		}
+
+		switch field {
+		case "id":
+			ev.ID = value
+		case "event":
+			ev.Name = value
+		case "data":
+			data = append(data, value)
+		}
+	}
+	dispatch()
+
+	return scanner.Err()
+}
@@ -0,0 +1,100 @@
+package logstream
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_decode(t *testing.T) {
+	stream := strings.Join([]string{
+		": keep-alive",
+		"id: 1",
+		"data: line one",
+		"",
+		"id: 2",
+		"data: line two",
+		"data: continues here",
+		"",
+		"event: done",
+		"data:",
+		"",
+	}, "\n")
+
+	var events []Event
+	err := decode(strings.NewReader(stream), func(ev Event) {
+		events = append(events, ev)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []Event{
+		{ID: "1", Data: "line one"},
+		{ID: "2", Data: "line two\ncontinues here"},
+		{Name: "done", Data: ""},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d", len(events), len(want))
+	}
+	for i, ev := range events {
+		if ev != want[i] {
+			t.Errorf("event %d: got %+v, want %+v", i, ev, want[i])
+		}
+	}
+}
+
+func TestClient_Subscribe_reconnect(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch requests {
+		case 1:
+			if id := r.Header.Get("Last-Event-ID"); id != "" {
+				t.Errorf("first request sent Last-Event-ID %q", id)
+			}
+			fmt.Fprint(w, "id: 1\ndata: first\n\n")
+			// drop the connection without a terminating event
+		default:
+			if id := r.Header.Get("Last-Event-ID"); id != "1" {
+				t.Errorf("reconnect sent Last-Event-ID %q, want %q", id, "1")
+			}
+			fmt.Fprint(w, "id: 2\ndata: second\n\nevent: done\ndata:\n\n")
+		}
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.Client())
+	client.retryDelay = time.Millisecond
+
+	var lines []string
+	err := client.Subscribe(context.Background(), srv.URL, func(ev Event) {
+		if ev.Name == "" {
+			lines = append(lines, ev.Data)
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := strings.Join(lines, ","); got != "first,second" {
+		t.Errorf("got lines %q", got)
+	}
+}
+
+func TestClient_Subscribe_httpError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.Client())
+	err := client.Subscribe(context.Background(), srv.URL, func(Event) {})
+	if err == nil || err.Error() != "stream returned HTTP 404" {
+		t.Errorf("got error %v", err)
+	}
+}
@@ -0,0 +1,154 @@
+// Package pagination provides helpers for traversing paginated GitHub API
+// results, for use by gh itself and by extensions that make their own HTTP
+// requests. REST endpoints advertise further pages through the Link response
+// header; GraphQL connections do so through pageInfo fields. An extension can
+// iterate a REST collection by constructing a Pager with an authenticated
+// http.Client and the URL of the first page:
+//
+//	pager := pagination.New(httpClient, "https://api.github.com/repos/cli/cli/releases?per_page=100")
+//	for {
+//		resp, err := pager.Next(ctx)
+//		if errors.Is(err, pagination.ErrNoMorePages) {
+//			break
+//		}
+//		// check resp.StatusCode, decode and close resp.Body
+//	}
+package pagination
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"regexp"
+)
+
+// ErrNoMorePages is returned by Pager.Next after the final page has been
+// consumed.
+var ErrNoMorePages = errors.New("no more pages")
+
+// Pager iterates over the pages of a REST collection by following the Link
+// response headers.
+type Pager struct {
+	client  *http.Client
+	nextURL string
+}
+
+// New returns a Pager that starts iterating at url.
+func New(client *http.Client, url string) *Pager {
+	return &Pager{
+		client:  client,
+		nextURL: url,
+	}
+}
+
+// Next fetches the next page of results. The caller is responsible for
+// checking the response status and for closing the response body. Once the
+// last page has been fetched, Next returns ErrNoMorePages.
+func (p *Pager) Next(ctx context.Context) (*http.Response, error) {
+	if p.nextURL == "" {
+		return nil, ErrNoMorePages
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", p.nextURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if next, ok := FindNextPage(resp); ok {
+		p.nextURL = next
+	} else {
+		p.nextURL = ""
+	}
+	return resp, nil
+}
+
+var linkRE = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
+
+// FindNextPage inspects the Link header of a REST response and returns the
+// URL of the next page, if there is one.
+func FindNextPage(resp *http.Response) (string, bool) {
+	for _, m := range linkRE.FindAllStringSubmatch(resp.Header.Get("Link"), -1) {
+		if len(m) > 2 && m[2] == "next" {
+			return m[1], true
+		}
+	}
+	return "", false
+}
+
+// FindEndCursor scans a GraphQL response body for the first set of pageInfo
+// fields and returns the end cursor when further pages remain, or "" when the
+// final page has been reached.
+func FindEndCursor(r io.Reader) string {
+	dec := json.NewDecoder(r)
+
+	var idx int
+	var stack []json.Delim
+	var lastKey string
+	var contextKey string
+
+	var endCursor string
+	var hasNextPage bool
+	var foundEndCursor bool
+	var foundNextPage bool
+
+loop:
+	for {
+		t, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return ""
+		}
+
+		switch tt := t.(type) {
+		case json.Delim:
+			switch tt {
+			case '{', '[':
+				stack = append(stack, tt)
+				contextKey = lastKey
+				idx = 0
+			case '}', ']':
+				stack = stack[:len(stack)-1]
+				contextKey = ""
+				idx = 0
+			}
+		default:
+			isKey := len(stack) > 0 && stack[len(stack)-1] == '{' && idx%2 == 0
+			idx++
+
+			switch tt := t.(type) {
+			case string:
+				if isKey {
+					lastKey = tt
+				} else if contextKey == "pageInfo" && lastKey == "endCursor" {
+					endCursor = tt
+					foundEndCursor = true
+					if foundNextPage {
+						break loop
+					}
+				}
+			case bool:
+				if contextKey == "pageInfo" && lastKey == "hasNextPage" {
+					hasNextPage = tt
+					foundNextPage = true
+					if foundEndCursor {
+						break loop
+					}
+				}
+			}
+		}
+	}
+
+	if hasNextPage {
+		return endCursor
+	}
+	return ""
+}
@@ -0,0 +1,173 @@
+package pagination
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func Test_FindNextPage(t *testing.T) {
+	tests := []struct {
+		name  string
+		resp  *http.Response
+		want  string
+		want1 bool
+	}{
+		{
+			name:  "no Link header",
+			resp:  &http.Response{},
+			want:  "",
+			want1: false,
+		},
+		{
+			name: "no next page in Link",
+			resp: &http.Response{
+				Header: http.Header{
+					"Link": []string{`<https://api.github.com/issues?page=3>; rel="last"`},
+				},
+			},
+			want:  "",
+			want1: false,
+		},
+		{
+			name: "has next page",
+			resp: &http.Response{
+				Header: http.Header{
+					"Link": []string{`<https://api.github.com/issues?page=2>; rel="next", <https://api.github.com/issues?page=3>; rel="last"`},
+				},
+			},
+			want:  "https://api.github.com/issues?page=2",
+			want1: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := FindNextPage(tt.resp)
+			if got != tt.want {
+				t.Errorf("FindNextPage() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("FindNextPage() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func Test_FindEndCursor(t *testing.T) {
+	tests := []struct {
+		name string
+		json io.Reader
+		want string
+	}{
+		{
+			name: "blank",
+			json: bytes.NewBufferString(`{}`),
+			want: "",
+		},
+		{
+			name: "unrelated fields",
+			json: bytes.NewBufferString(`{
+				"hasNextPage": true,
+				"endCursor": "THE_END"
+			}`),
+			want: "",
+		},
+		{
+			name: "has next page",
+			json: bytes.NewBufferString(`{
+				"pageInfo": {
+					"hasNextPage": true,
+					"endCursor": "THE_END"
+				}
+			}`),
+			want: "THE_END",
+		},
+		{
+			name: "more pageInfo blocks",
+			json: bytes.NewBufferString(`{
+				"pageInfo": {
+					"hasNextPage": true,
+					"endCursor": "THE_END"
+				},
+				"pageInfo": {
+					"hasNextPage": true,
+					"endCursor": "NOT_THIS"
+				}
+			}`),
+			want: "THE_END",
+		},
+		{
+			name: "no next page",
+			json: bytes.NewBufferString(`{
+				"pageInfo": {
+					"hasNextPage": false,
+					"endCursor": "THE_END"
+				}
+			}`),
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FindEndCursor(tt.json); got != tt.want {
+				t.Errorf("FindEndCursor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type pagedTransport struct {
+	pages []*http.Response
+}
+
+func (t *pagedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := t.pages[0]
+	t.pages = t.pages[1:]
+	resp.Request = req
+	return resp, nil
+}
+
+func TestPager_Next(t *testing.T) {
+	transport := &pagedTransport{
+		pages: []*http.Response{
+			{
+				StatusCode: 200,
+				Header: http.Header{
+					"Link": []string{`<https://api.github.com/issues?page=2>; rel="next", <https://api.github.com/issues?page=2>; rel="last"`},
+				},
+				Body: io.NopCloser(bytes.NewBufferString(`[1]`)),
+			},
+			{
+				StatusCode: 200,
+				Body:       io.NopCloser(bytes.NewBufferString(`[2]`)),
+			},
+		},
+	}
+
+	pager := New(&http.Client{Transport: transport}, "https://api.github.com/issues")
+
+	var urls []string
+	for {
+		resp, err := pager.Next(context.Background())
+		if err == ErrNoMorePages {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		urls = append(urls, resp.Request.URL.String())
+		resp.Body.Close()
+	}
+
+	if len(urls) != 2 {
+		t.Fatalf("expected 2 pages, got %d", len(urls))
+	}
+	if urls[0] != "https://api.github.com/issues" {
+		t.Errorf("unexpected first page URL: %s", urls[0])
+	}
+	if urls[1] != "https://api.github.com/issues?page=2" {
+		t.Errorf("unexpected second page URL: %s", urls[1])
+	}
+}
@@ -0,0 +1,168 @@
+package prompt
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/core"
+)
+
+// EnableAccessible replaces the interactive survey prompts with plain-text
+// equivalents suited for screen readers: selections are answered by typing a
+// number instead of navigating a menu, and no cursor movement or redrawing
+// is used. Prompt types without a plain-text equivalent fall back to survey.
+// The returned function restores the interactive prompts.
+func EnableAccessible(in io.Reader, out io.Writer) func() {
+	origAskOne := SurveyAskOne
+	origAsk := SurveyAsk
+	origConfirm := Confirm
+
+	reader := bufio.NewReader(in)
+
+	askOne := func(p survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+		answer, handled, err := accessiblePrompt(p, reader, out)
+		if err != nil {
+			return err
+		}
+		if !handled {
+			return survey.AskOne(p, response, opts...)
+		}
+		return core.WriteAnswer(response, "", answer)
+	}
+
+	SurveyAskOne = askOne
+	SurveyAsk = func(qs []*survey.Question, response interface{}, opts ...survey.AskOpt) error {
+		for _, q := range qs {
+			answer, handled, err := accessiblePrompt(q.Prompt, reader, out)
+			if err != nil {
+				return err
+			}
+			if !handled {
+				if err := survey.Ask([]*survey.Question{q}, response, opts...); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := core.WriteAnswer(response, q.Name, answer); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	Confirm = func(message string, result *bool) error {
+		return askOne(&survey.Confirm{Message: message, Default: true}, result)
+	}
+
+	return func() {
+		SurveyAskOne = origAskOne
+		SurveyAsk = origAsk
+		Confirm = origConfirm
+	}
+}
+
+func accessiblePrompt(p survey.Prompt, in *bufio.Reader, out io.Writer) (interface{}, bool, error) {
+	switch pt := p.(type) {
+	case *survey.Select:
+		answer, err := selectNumbered(in, out, pt.Message, pt.Options, defaultIndex(pt.Options, pt.Default))
+		return answer, true, err
+	case *survey.Confirm:
+		answer, err := confirmPlain(in, out, pt.Message, pt.Default)
+		return answer, true, err
+	case *survey.Input:
+		answer, err := inputPlain(in, out, pt.Message, pt.Default)
+		return answer, true, err
+	default:
+		return nil, false, nil
+	}
+}
+
+func selectNumbered(in *bufio.Reader, out io.Writer, message string, options []string, defaultIdx int) (core.OptionAnswer, error) {
+	fmt.Fprintln(out, message)
+	for i, option := range options {
+		fmt.Fprintf(out, "%d. %s\n", i+1, option)
+	}
+
+	for {
+		fmt.Fprintf(out, "Type a number [%d]: ", defaultIdx+1)
+		line, err := readLine(in)
+		if err != nil {
+			return core.OptionAnswer{}, err
+		}
+		if line == "" {
+			return core.OptionAnswer{Value: options[defaultIdx], Index: defaultIdx}, nil
+		}
+		num, err := strconv.Atoi(line)
+		if err == nil && num >= 1 && num <= len(options) {
+			return core.OptionAnswer{Value: options[num-1], Index: num - 1}, nil
+		}
+		fmt.Fprintf(out, "Please type a number between 1 and %d\n", len(options))
+	}
+}
+
+func confirmPlain(in *bufio.Reader, out io.Writer, message string, defaultValue bool) (bool, error) {
+	hint := "y/N"
+	if defaultValue {
+		hint = "Y/n"
+	}
+
+	for {
+		fmt.Fprintf(out, "%s (%s) ", message, hint)
+		line, err := readLine(in)
+		if err != nil {
+			return false, err
+		}
+		switch strings.ToLower(line) {
+		case "":
+			return defaultValue, nil
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		fmt.Fprintln(out, `Please answer "yes" or "no"`)
+	}
+}
+
+func inputPlain(in *bufio.Reader, out io.Writer, message, defaultValue string) (string, error) {
+	if defaultValue != "" {
+		fmt.Fprintf(out, "%s [%s]: ", message, defaultValue)
+	} else {
+		fmt.Fprintf(out, "%s: ", message)
+	}
+	line, err := readLine(in)
+	if err != nil {
+		return "", err
+	}
+	if line == "" {
+		return defaultValue, nil
+	}
+	return line, nil
+}
+
+func defaultIndex(options []string, defaultValue interface{}) int {
+	switch d := defaultValue.(type) {
+	case string:
+		for i, option := range options {
+			if option == d {
+				return i
+			}
+		}
+	case int:
+		if d >= 0 && d < len(options) {
+			return d
+		}
+	}
+	return 0
+}
+
+func readLine(in *bufio.Reader) (string, error) {
+	line, err := in.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
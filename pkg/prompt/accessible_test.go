@@ -0,0 +1,118 @@
+package prompt_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessibleSelect(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantIndex int
+		wantOut   []string
+	}{
+		{
+			name:      "numbered answer",
+			input:     "2\n",
+			wantIndex: 1,
+			wantOut:   []string{"Pick one", "1. apple", "2. banana", "3. cherry", "Type a number [1]: "},
+		},
+		{
+			name:      "empty answer picks the default",
+			input:     "\n",
+			wantIndex: 0,
+		},
+		{
+			name:      "out of range answers are rejected",
+			input:     "9\nx\n3\n",
+			wantIndex: 2,
+			wantOut:   []string{"Please type a number between 1 and 3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			restore := prompt.EnableAccessible(strings.NewReader(tt.input), out)
+			defer restore()
+
+			var selected int
+			err := prompt.SurveyAskOne(&survey.Select{
+				Message: "Pick one",
+				Options: []string{"apple", "banana", "cherry"},
+			}, &selected)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantIndex, selected)
+			for _, want := range tt.wantOut {
+				assert.Contains(t, out.String(), want)
+			}
+		})
+	}
+}
+
+func TestAccessibleConfirm(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		def   bool
+		want  bool
+	}{
+		{name: "yes", input: "y\n", want: true},
+		{name: "no", input: "no\n", def: true, want: false},
+		{name: "empty uses default", input: "\n", def: true, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			restore := prompt.EnableAccessible(strings.NewReader(tt.input), out)
+			defer restore()
+
+			var result bool
+			err := prompt.SurveyAskOne(&survey.Confirm{Message: "Proceed?", Default: tt.def}, &result)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func TestAccessibleInput(t *testing.T) {
+	out := &bytes.Buffer{}
+	restore := prompt.EnableAccessible(strings.NewReader("shiny\n\n"), out)
+	defer restore()
+
+	var name string
+	err := prompt.SurveyAskOne(&survey.Input{Message: "Name"}, &name)
+	assert.NoError(t, err)
+	assert.Equal(t, "shiny", name)
+
+	var branch string
+	err = prompt.SurveyAskOne(&survey.Input{Message: "Branch", Default: "main"}, &branch)
+	assert.NoError(t, err)
+	assert.Equal(t, "main", branch)
+	assert.Contains(t, out.String(), "Branch [main]: ")
+}
+
+func TestAccessibleAsk(t *testing.T) {
+	out := &bytes.Buffer{}
+	restore := prompt.EnableAccessible(strings.NewReader("octocat\n1\n"), out)
+	defer restore()
+
+	answers := struct {
+		Login  string
+		Flavor int
+	}{}
+	err := prompt.SurveyAsk([]*survey.Question{
+		{Name: "login", Prompt: &survey.Input{Message: "Login"}},
+		{Name: "flavor", Prompt: &survey.Select{Message: "Flavor", Options: []string{"vanilla", "chocolate"}}},
+	}, &answers)
+	assert.NoError(t, err)
+	assert.Equal(t, "octocat", answers.Login)
+	assert.Equal(t, 0, answers.Flavor)
+}
@@ -1,7 +1,9 @@
 package prompt
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -10,7 +12,8 @@ import (
 )
 
 type AskStubber struct {
-	stubs []*QuestionStub
+	stubs    []*QuestionStub
+	recorder io.Writer
 }
 
 type testing interface {
@@ -116,6 +119,17 @@ func InitAskStubber() (*AskStubber, func()) {
 			}
 		}
 
+		if as.recorder != nil {
+			record := struct {
+				Message string      `json:"message"`
+				Options []string    `json:"options,omitempty"`
+				Answer  interface{} `json:"answer"`
+			}{Message: message, Options: options, Answer: userValue}
+			if err := json.NewEncoder(as.recorder).Encode(record); err != nil {
+				return fmt.Errorf("AskStubber failed recording the answer for %q: %w", message, err)
+			}
+		}
+
 		if err := core.WriteAnswer(response, fieldName, userValue); err != nil {
 			topic := fmt.Sprintf("field %q", fieldName)
 			if fieldName == "" {
@@ -174,14 +188,11 @@ func (s *QuestionStub) AnswerDefault() *QuestionStub {
 	return s
 }
 
-// Deprecated: use StubPrompt
-func (as *AskStubber) StubOne(value interface{}) {
-	as.Stub([]*QuestionStub{{Value: value}})
-}
-
-// Deprecated: use StubPrompt
-func (as *AskStubber) Stub(stubbedQuestions []*QuestionStub) {
-	as.stubs = append(as.stubs, stubbedQuestions...)
+// StartRecording makes the stubber write every prompt it answers to w as one JSON
+// object per line, so that transcripts of interactive flows can be reviewed or kept
+// as golden files.
+func (as *AskStubber) StartRecording(w io.Writer) {
+	as.recorder = w
 }
 
 // StubPrompt records a stub for an interactive prompt matched by its message.
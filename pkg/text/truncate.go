@@ -17,6 +17,15 @@ func DisplayWidth(s string) int {
 	return ansi.PrintableRuneWidth(s)
 }
 
+// PadRight returns a copy of the string s appended with spaces until it spans
+// at least the maximum display width
+func PadRight(maxWidth int, s string) string {
+	if padWidth := maxWidth - DisplayWidth(s); padWidth > 0 {
+		s += strings.Repeat(" ", padWidth)
+	}
+	return s
+}
+
 // Truncate shortens a string to fit the maximum display width
 func Truncate(maxWidth int, s string) string {
 	w := DisplayWidth(s)
@@ -188,6 +188,66 @@ func TestTruncateColumn(t *testing.T) {
 	}
 }
 
+func TestPadRight(t *testing.T) {
+	type args struct {
+		max int
+		s   string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Short enough",
+			args: args{
+				max: 3,
+				s:   "short",
+			},
+			want: "short",
+		},
+		{
+			name: "Needs padding",
+			args: args{
+				max: 6,
+				s:   "pad",
+			},
+			want: "pad   ",
+		},
+		{
+			name: "Japanese",
+			args: args{
+				max: 10,
+				s:   "テスト",
+			},
+			want: "テスト    ",
+		},
+		{
+			name: "Emoji",
+			args: args{
+				max: 6,
+				s:   "💡💡",
+			},
+			want: "💡💡  ",
+		},
+		{
+			name: "Color codes",
+			args: args{
+				max: 6,
+				s:   "\x1b[0;31mred\x1b[0m",
+			},
+			want: "\x1b[0;31mred\x1b[0m   ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PadRight(tt.args.max, tt.args.s); got != tt.want {
+				t.Errorf("PadRight() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestDisplayWidth(t *testing.T) {
 	tests := []struct {
 		name string
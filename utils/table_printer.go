@@ -105,9 +105,7 @@ func (t *ttyTablePrinter) Render() error {
 			truncVal := field.TruncateFunc(colWidths[col], field.Text)
 			if col < numCols-1 {
 				// pad value with spaces on the right
-				if padWidth := colWidths[col] - field.DisplayWidth(); padWidth > 0 {
-					truncVal += strings.Repeat(" ", padWidth)
-				}
+				truncVal = text.PadRight(colWidths[col], truncVal)
 			}
 			if field.ColorFunc != nil {
 				truncVal = field.ColorFunc(truncVal)